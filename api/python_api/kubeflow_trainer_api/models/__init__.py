@@ -374,16 +374,23 @@ from kubeflow_trainer_api.models.jobset_v1alpha2_success_policy import JobsetV1a
 from kubeflow_trainer_api.models.jobset_v1alpha2_volume_claim_policy import JobsetV1alpha2VolumeClaimPolicy
 from kubeflow_trainer_api.models.jobset_v1alpha2_volume_retention_policy import JobsetV1alpha2VolumeRetentionPolicy
 from kubeflow_trainer_api.models.scheduling_v1beta1_network_topology_spec import SchedulingV1beta1NetworkTopologySpec
+from kubeflow_trainer_api.models.trainer_v1alpha1_checkpoint_config import TrainerV1alpha1CheckpointConfig
 from kubeflow_trainer_api.models.trainer_v1alpha1_cluster_training_runtime import TrainerV1alpha1ClusterTrainingRuntime
 from kubeflow_trainer_api.models.trainer_v1alpha1_cluster_training_runtime_list import TrainerV1alpha1ClusterTrainingRuntimeList
 from kubeflow_trainer_api.models.trainer_v1alpha1_container_patch import TrainerV1alpha1ContainerPatch
 from kubeflow_trainer_api.models.trainer_v1alpha1_coscheduling_pod_group_policy_source import TrainerV1alpha1CoschedulingPodGroupPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_dataset_initializer import TrainerV1alpha1DatasetInitializer
+from kubeflow_trainer_api.models.trainer_v1alpha1_dataset_source import TrainerV1alpha1DatasetSource
+from kubeflow_trainer_api.models.trainer_v1alpha1_deep_speed_ml_policy_source import TrainerV1alpha1DeepSpeedMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_env_injection import TrainerV1alpha1EnvInjection
 from kubeflow_trainer_api.models.trainer_v1alpha1_env_injection_target import TrainerV1alpha1EnvInjectionTarget
 from kubeflow_trainer_api.models.trainer_v1alpha1_flux_ml_policy_source import TrainerV1alpha1FluxMLPolicySource
+from kubeflow_trainer_api.models.trainer_v1alpha1_gpu_topology import TrainerV1alpha1GPUTopology
+from kubeflow_trainer_api.models.trainer_v1alpha1_heartbeat import TrainerV1alpha1Heartbeat
+from kubeflow_trainer_api.models.trainer_v1alpha1_horovod_ml_policy_source import TrainerV1alpha1HorovodMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_initializer import TrainerV1alpha1Initializer
 from kubeflow_trainer_api.models.trainer_v1alpha1_job_set_spec_patch import TrainerV1alpha1JobSetSpecPatch
+from kubeflow_trainer_api.models.trainer_v1alpha1_job_set_status import TrainerV1alpha1JobSetStatus
 from kubeflow_trainer_api.models.trainer_v1alpha1_job_set_template_patch import TrainerV1alpha1JobSetTemplatePatch
 from kubeflow_trainer_api.models.trainer_v1alpha1_job_set_template_spec import TrainerV1alpha1JobSetTemplateSpec
 from kubeflow_trainer_api.models.trainer_v1alpha1_job_spec_patch import TrainerV1alpha1JobSpecPatch
@@ -394,13 +401,16 @@ from kubeflow_trainer_api.models.trainer_v1alpha1_ml_policy_source import Traine
 from kubeflow_trainer_api.models.trainer_v1alpha1_mpiml_policy_source import TrainerV1alpha1MPIMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_metric import TrainerV1alpha1Metric
 from kubeflow_trainer_api.models.trainer_v1alpha1_model_initializer import TrainerV1alpha1ModelInitializer
+from kubeflow_trainer_api.models.trainer_v1alpha1_named_port import TrainerV1alpha1NamedPort
 from kubeflow_trainer_api.models.trainer_v1alpha1_pod_group_policy import TrainerV1alpha1PodGroupPolicy
 from kubeflow_trainer_api.models.trainer_v1alpha1_pod_group_policy_source import TrainerV1alpha1PodGroupPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_pod_spec_patch import TrainerV1alpha1PodSpecPatch
 from kubeflow_trainer_api.models.trainer_v1alpha1_pod_template_patch import TrainerV1alpha1PodTemplatePatch
 from kubeflow_trainer_api.models.trainer_v1alpha1_replicated_job_patch import TrainerV1alpha1ReplicatedJobPatch
+from kubeflow_trainer_api.models.trainer_v1alpha1_run_policy import TrainerV1alpha1RunPolicy
 from kubeflow_trainer_api.models.trainer_v1alpha1_runtime_patch import TrainerV1alpha1RuntimePatch
 from kubeflow_trainer_api.models.trainer_v1alpha1_runtime_ref import TrainerV1alpha1RuntimeRef
+from kubeflow_trainer_api.models.trainer_v1alpha1_spot import TrainerV1alpha1Spot
 from kubeflow_trainer_api.models.trainer_v1alpha1_torch_ml_policy_source import TrainerV1alpha1TorchMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_train_job import TrainerV1alpha1TrainJob
 from kubeflow_trainer_api.models.trainer_v1alpha1_train_job_list import TrainerV1alpha1TrainJobList
@@ -414,3 +424,4 @@ from kubeflow_trainer_api.models.trainer_v1alpha1_training_runtime_spec import T
 from kubeflow_trainer_api.models.trainer_v1alpha1_training_runtime_spec_patch import TrainerV1alpha1TrainingRuntimeSpecPatch
 from kubeflow_trainer_api.models.trainer_v1alpha1_update_train_job_status_request import TrainerV1alpha1UpdateTrainJobStatusRequest
 from kubeflow_trainer_api.models.trainer_v1alpha1_volcano_pod_group_policy_source import TrainerV1alpha1VolcanoPodGroupPolicySource
+from kubeflow_trainer_api.models.trainer_v1alpha1_xg_boost_ml_policy_source import TrainerV1alpha1XGBoostMLPolicySource
@@ -45,9 +45,10 @@ class TrainerV1alpha1ContainerPatch(BaseModel):
     """ # noqa: E501
     env: Optional[List[IoK8sApiCoreV1EnvVar]] = Field(default=None, description="env is the list of environment variables to set in the container. These values will be merged with the Runtime's environments. These values can't be set for container with the name: `node`, `dataset-initializer`, or `model-initializer`. For those containers the envs can only be set via Trainer or Initializer APIs.")
     name: StrictStr = Field(description="name for the container. Runtime must have this container.")
+    restart_policy: Optional[StrictStr] = Field(default=None, description="restartPolicy marks the init container as a sidecar when set to Always, so it keeps running alongside the containers and is counted in the Pod's running resource sum. This value can only be set for the init containers. More info: https://kubernetes.io/docs/concepts/workloads/pods/sidecar-containers/", alias="restartPolicy")
     security_context: Optional[IoK8sApiCoreV1SecurityContext] = Field(default=None, description="securityContext patches the container's security context. More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/", alias="securityContext")
     volume_mounts: Optional[List[IoK8sApiCoreV1VolumeMount]] = Field(default=None, description="volumeMounts are the volumes to mount into the container's filesystem.", alias="volumeMounts")
-    __properties: ClassVar[List[str]] = ["env", "name", "securityContext", "volumeMounts"]
+    __properties: ClassVar[List[str]] = ["env", "name", "restartPolicy", "securityContext", "volumeMounts"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -119,6 +120,7 @@ class TrainerV1alpha1ContainerPatch(BaseModel):
         _obj = cls.model_validate({
             "env": [IoK8sApiCoreV1EnvVar.from_dict(_item) for _item in obj["env"]] if obj.get("env") is not None else None,
             "name": obj.get("name"),
+            "restartPolicy": obj.get("restartPolicy"),
             "securityContext": IoK8sApiCoreV1SecurityContext.from_dict(obj["securityContext"]) if obj.get("securityContext") is not None else None,
             "volumeMounts": [IoK8sApiCoreV1VolumeMount.from_dict(_item) for _item in obj["volumeMounts"]] if obj.get("volumeMounts") is not None else None
         })
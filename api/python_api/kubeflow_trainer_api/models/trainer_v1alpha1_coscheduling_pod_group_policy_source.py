@@ -31,7 +31,7 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictInt
+from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from typing import Optional, Set
 from typing_extensions import Self
@@ -40,8 +40,9 @@ class TrainerV1alpha1CoschedulingPodGroupPolicySource(BaseModel):
     """
     CoschedulingPodGroupPolicySource represents configuration for coscheduling plugin. The number of min members in the PodGroupSpec is always equal to the number of nodes.
     """ # noqa: E501
+    queue: Optional[StrictStr] = Field(default=None, description="queue is the name of the scheduler queue for the gang-scheduling. It is set as the scheduling.x-k8s.io/queue-name label on the PodGroup and the TrainJob Pods. Defaults to empty, which means that no queue label is set.")
     schedule_timeout_seconds: Optional[StrictInt] = Field(default=None, description="scheduleTimeoutSeconds is the maximum duration to schedule PodGroup for gang-scheduling. If the scheduling timeout is equal to 0, the default value is used. Defaults to 60 seconds.", alias="scheduleTimeoutSeconds")
-    __properties: ClassVar[List[str]] = ["scheduleTimeoutSeconds"]
+    __properties: ClassVar[List[str]] = ["queue", "scheduleTimeoutSeconds"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -94,6 +95,7 @@ class TrainerV1alpha1CoschedulingPodGroupPolicySource(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "queue": obj.get("queue"),
             "scheduleTimeoutSeconds": obj.get("scheduleTimeoutSeconds")
         })
         return _obj
@@ -31,10 +31,12 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictStr
+from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.io_k8s_api_core_v1_env_from_source import IoK8sApiCoreV1EnvFromSource
 from kubeflow_trainer_api.models.io_k8s_api_core_v1_env_var import IoK8sApiCoreV1EnvVar
 from kubeflow_trainer_api.models.io_k8s_api_core_v1_local_object_reference import IoK8sApiCoreV1LocalObjectReference
+from kubeflow_trainer_api.models.trainer_v1alpha1_dataset_source import TrainerV1alpha1DatasetSource
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -42,10 +44,13 @@ class TrainerV1alpha1DatasetInitializer(BaseModel):
     """
     DatasetInitializer represents the desired configuration to initialize and pre-process dataset. The DatasetInitializer spec will override the runtime Job template which contains this label: `trainer.kubeflow.org/trainjob-ancestor-step: dataset-initializer`
     """ # noqa: E501
+    datasets: Optional[List[TrainerV1alpha1DatasetSource]] = Field(default=None, description="datasets is the list of datasets to initialize, each mounted at its own path in the trainer Pods. A dataset initializer Job is created for every dataset from the runtime dataset initializer Job. This field is mutually exclusive with storageUri. At most 4 datasets are allowed, since JobSet limits the trainer Job to 5 dependencies, including the model initializer Job.")
     env: Optional[List[IoK8sApiCoreV1EnvVar]] = Field(default=None, description="env is the list of environment variables to set in the dataset initializer container. These values will be merged with the TrainingRuntime's dataset initializer environments.")
+    env_from: Optional[List[IoK8sApiCoreV1EnvFromSource]] = Field(default=None, description="envFrom is the list of sources to populate environment variables in the dataset initializer container. These values will be appended to the TrainingRuntime's dataset initializer envFrom sources.", alias="envFrom")
+    parallelism: Optional[StrictInt] = Field(default=None, description="parallelism is the number of dataset initializer Pods running in parallel, for example to download the dataset shards concurrently. It overrides the parallelism and completions of the dataset initializer Job. Defaults to the parallelism of the dataset initializer Job in the runtime.")
     secret_ref: Optional[IoK8sApiCoreV1LocalObjectReference] = Field(default=None, description="secretRef is the reference to the secret with credentials to download dataset. Secret must be created in the TrainJob's namespace.", alias="secretRef")
     storage_uri: Optional[StrictStr] = Field(default=None, description="storageUri is the URI for the dataset provider. If set, it may be empty, or it must be a valid URI format (e.g., s3://bucket/path, gs://bucket/path).", alias="storageUri")
-    __properties: ClassVar[List[str]] = ["env", "secretRef", "storageUri"]
+    __properties: ClassVar[List[str]] = ["datasets", "env", "envFrom", "parallelism", "secretRef", "storageUri"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -86,6 +91,13 @@ class TrainerV1alpha1DatasetInitializer(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of each item in datasets (list)
+        _items = []
+        if self.datasets:
+            for _item_datasets in self.datasets:
+                if _item_datasets:
+                    _items.append(_item_datasets.to_dict())
+            _dict['datasets'] = _items
         # override the default output from pydantic by calling `to_dict()` of each item in env (list)
         _items = []
         if self.env:
@@ -93,6 +105,13 @@ class TrainerV1alpha1DatasetInitializer(BaseModel):
                 if _item_env:
                     _items.append(_item_env.to_dict())
             _dict['env'] = _items
+        # override the default output from pydantic by calling `to_dict()` of each item in env_from (list)
+        _items = []
+        if self.env_from:
+            for _item_env_from in self.env_from:
+                if _item_env_from:
+                    _items.append(_item_env_from.to_dict())
+            _dict['envFrom'] = _items
         # override the default output from pydantic by calling `to_dict()` of secret_ref
         if self.secret_ref:
             _dict['secretRef'] = self.secret_ref.to_dict()
@@ -108,7 +127,10 @@ class TrainerV1alpha1DatasetInitializer(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "datasets": [TrainerV1alpha1DatasetSource.from_dict(_item) for _item in obj["datasets"]] if obj.get("datasets") is not None else None,
             "env": [IoK8sApiCoreV1EnvVar.from_dict(_item) for _item in obj["env"]] if obj.get("env") is not None else None,
+            "envFrom": [IoK8sApiCoreV1EnvFromSource.from_dict(_item) for _item in obj["envFrom"]] if obj.get("envFrom") is not None else None,
+            "parallelism": obj.get("parallelism"),
             "secretRef": IoK8sApiCoreV1LocalObjectReference.from_dict(obj["secretRef"]) if obj.get("secretRef") is not None else None,
             "storageUri": obj.get("storageUri")
         })
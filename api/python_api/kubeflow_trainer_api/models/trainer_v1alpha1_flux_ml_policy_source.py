@@ -31,7 +31,7 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictInt
+from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from typing import Optional, Set
 from typing_extensions import Self
@@ -40,8 +40,12 @@ class TrainerV1alpha1FluxMLPolicySource(BaseModel):
     """
     FluxMLPolicySource represents a Flux HPC runtime configuration.
     """ # noqa: E501
+    broker_port: Optional[StrictInt] = Field(default=None, description="brokerPort is the port for the Flux brokers communication. Defaults to 8050. It can be overridden by the FLUX_BROKER_PORT env in the trainer.", alias="brokerPort")
+    network_device: Optional[StrictStr] = Field(default=None, description="networkDevice is the network device the Flux brokers bind to for the tree-based overlay network. Defaults to eth0. It can be overridden by the FLUX_NETWORK_DEVICE env in the trainer.", alias="networkDevice")
     num_proc_per_node: Optional[StrictInt] = Field(default=None, description="numProcPerNode is the number of processes per node.", alias="numProcPerNode")
-    __properties: ClassVar[List[str]] = ["numProcPerNode"]
+    queue_policy: Optional[StrictStr] = Field(default=None, description="queuePolicy is the queue policy of the Flux scheduler. Defaults to fcfs. It can be overridden by the FLUX_QUEUE_POLICY env in the trainer.", alias="queuePolicy")
+    view_image: Optional[StrictStr] = Field(default=None, description="viewImage is the container image of the Flux view installed into the trainer nodes by the init container. The OS and version of the view image must match the trainer image. Defaults to ghcr.io/converged-computing/flux-view-ubuntu:tag-jammy. It can be overridden by the FLUX_VIEW_IMAGE env in the trainer.", alias="viewImage")
+    __properties: ClassVar[List[str]] = ["brokerPort", "networkDevice", "numProcPerNode", "queuePolicy", "viewImage"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -94,7 +98,11 @@ class TrainerV1alpha1FluxMLPolicySource(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
-            "numProcPerNode": obj.get("numProcPerNode")
+            "brokerPort": obj.get("brokerPort"),
+            "networkDevice": obj.get("networkDevice"),
+            "numProcPerNode": obj.get("numProcPerNode"),
+            "queuePolicy": obj.get("queuePolicy"),
+            "viewImage": obj.get("viewImage")
         })
         return _obj
 
@@ -31,7 +31,7 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field
+from pydantic import BaseModel, ConfigDict, Field, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from kubeflow_trainer_api.models.trainer_v1alpha1_dataset_initializer import TrainerV1alpha1DatasetInitializer
 from kubeflow_trainer_api.models.trainer_v1alpha1_model_initializer import TrainerV1alpha1ModelInitializer
@@ -44,7 +44,8 @@ class TrainerV1alpha1Initializer(BaseModel):
     """ # noqa: E501
     dataset: Optional[TrainerV1alpha1DatasetInitializer] = Field(default=None, description="dataset defines the configuration for the dataset initialization and pre-processing.")
     model: Optional[TrainerV1alpha1ModelInitializer] = Field(default=None, description="model defines the configuration for the pre-trained model initialization")
-    __properties: ClassVar[List[str]] = ["dataset", "model"]
+    trainer_dependency: Optional[StrictStr] = Field(default=None, description="trainerDependency is the status of the initializer Jobs which the trainer Job waits for before it starts. Ready starts the trainer once the initializer Pods are ready, for example to stream the dataset while the trainer runs. Complete starts the trainer once the initializers complete. Defaults to the dependsOn status of the TrainingRuntime.", alias="trainerDependency")
+    __properties: ClassVar[List[str]] = ["dataset", "model", "trainerDependency"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -104,7 +105,8 @@ class TrainerV1alpha1Initializer(BaseModel):
 
         _obj = cls.model_validate({
             "dataset": TrainerV1alpha1DatasetInitializer.from_dict(obj["dataset"]) if obj.get("dataset") is not None else None,
-            "model": TrainerV1alpha1ModelInitializer.from_dict(obj["model"]) if obj.get("model") is not None else None
+            "model": TrainerV1alpha1ModelInitializer.from_dict(obj["model"]) if obj.get("model") is not None else None,
+            "trainerDependency": obj.get("trainerDependency")
         })
         return _obj
 
@@ -31,7 +31,7 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field
+from pydantic import BaseModel, ConfigDict, Field, StrictInt
 from typing import Any, ClassVar, Dict, List, Optional
 from kubeflow_trainer_api.models.trainer_v1alpha1_pod_template_patch import TrainerV1alpha1PodTemplatePatch
 from typing import Optional, Set
@@ -41,8 +41,9 @@ class TrainerV1alpha1JobSpecPatch(BaseModel):
     """
     JobSpecPatch defines allowed patches for the Job spec.
     """ # noqa: E501
+    active_deadline_seconds: Optional[StrictInt] = Field(default=None, description="activeDeadlineSeconds patches the duration in seconds relative to the start time that the Job may be active before the system tries to terminate it, for example to bound the initializer Jobs.", alias="activeDeadlineSeconds")
     template: Optional[TrainerV1alpha1PodTemplatePatch] = Field(default=None, description="template patches the Pod template for this Job.")
-    __properties: ClassVar[List[str]] = ["template"]
+    __properties: ClassVar[List[str]] = ["activeDeadlineSeconds", "template"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -98,6 +99,7 @@ class TrainerV1alpha1JobSpecPatch(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "activeDeadlineSeconds": obj.get("activeDeadlineSeconds"),
             "template": TrainerV1alpha1PodTemplatePatch.from_dict(obj["template"]) if obj.get("template") is not None else None
         })
         return _obj
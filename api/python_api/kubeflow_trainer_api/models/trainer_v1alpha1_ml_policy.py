@@ -31,11 +31,15 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictInt
+from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.trainer_v1alpha1_deep_speed_ml_policy_source import TrainerV1alpha1DeepSpeedMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_flux_ml_policy_source import TrainerV1alpha1FluxMLPolicySource
+from kubeflow_trainer_api.models.trainer_v1alpha1_horovod_ml_policy_source import TrainerV1alpha1HorovodMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_mpiml_policy_source import TrainerV1alpha1MPIMLPolicySource
+from kubeflow_trainer_api.models.trainer_v1alpha1_named_port import TrainerV1alpha1NamedPort
 from kubeflow_trainer_api.models.trainer_v1alpha1_torch_ml_policy_source import TrainerV1alpha1TorchMLPolicySource
+from kubeflow_trainer_api.models.trainer_v1alpha1_xg_boost_ml_policy_source import TrainerV1alpha1XGBoostMLPolicySource
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -43,13 +47,21 @@ class TrainerV1alpha1MLPolicy(BaseModel):
     """
     MLPolicy represents configuration for the model training with ML-specific parameters.
     """ # noqa: E501
+    additional_ports: Optional[List[TrainerV1alpha1NamedPort]] = Field(default=None, description="additionalPorts are the named ports exposed by the trainer node container in addition to the trainer port, for example the metrics port.", alias="additionalPorts")
+    deepspeed: Optional[TrainerV1alpha1DeepSpeedMLPolicySource] = Field(default=None, description="deepspeed defines the configuration for the DeepSpeed runtime.")
     flux: Optional[TrainerV1alpha1FluxMLPolicySource] = Field(default=None, description="flux defines the configuration for the Flux runtime.")
+    horovod: Optional[TrainerV1alpha1HorovodMLPolicySource] = Field(default=None, description="horovod defines the configuration for the Horovod runtime. It must be configured together with mpi of the OpenMPI implementation, which provides the SSH keys and the hostfile.")
     jax: Optional[Dict[str, Any]] = Field(default=None, description="jax defines the configuration for the JAX Runtime")
     mpi: Optional[TrainerV1alpha1MPIMLPolicySource] = Field(default=None, description="mpi defines the configuration for the MPI Runtime.")
     num_nodes: Optional[StrictInt] = Field(default=None, description="numNodes is the number of training nodes. Defaults to 1.", alias="numNodes")
+    ray: Optional[Dict[str, Any]] = Field(default=None, description="ray defines the configuration for the Ray runtime.")
+    single_process_per_node: Optional[StrictBool] = Field(default=None, description="singleProcessPerNode declares that the runtime only supports a single training process per node, for example the legacy frameworks, so the TrainJob can not set numProcPerNode other than 1. Defaults to false.", alias="singleProcessPerNode")
+    tensorflow: Optional[Dict[str, Any]] = Field(default=None, description="tensorflow defines the configuration for the TensorFlow runtime.")
     torch: Optional[TrainerV1alpha1TorchMLPolicySource] = Field(default=None, description="torch defines the configuration for the PyTorch runtime.")
-    xgboost: Optional[Dict[str, Any]] = Field(default=None, description="xgboost defines the configuration for the XGBoost Runtime.")
-    __properties: ClassVar[List[str]] = ["flux", "jax", "mpi", "numNodes", "torch", "xgboost"]
+    trainer_port: Optional[StrictInt] = Field(default=None, description="trainerPort is the port for the trainer nodes communication, for example the PyTorch master port or the XGBoost tracker port. It can be changed to avoid the port collisions on the nodes with host networking. Defaults to 29500.", alias="trainerPort")
+    trainer_port_name: Optional[StrictStr] = Field(default=None, description="trainerPortName is the name of the trainer port in the trainer node container, so that it can be referenced by name, for example from the Service or the probes.", alias="trainerPortName")
+    xgboost: Optional[TrainerV1alpha1XGBoostMLPolicySource] = Field(default=None, description="xgboost defines the configuration for the XGBoost Runtime.")
+    __properties: ClassVar[List[str]] = ["additionalPorts", "deepspeed", "flux", "horovod", "jax", "mpi", "numNodes", "ray", "singleProcessPerNode", "tensorflow", "torch", "trainerPort", "trainerPortName", "xgboost"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -90,15 +102,31 @@ class TrainerV1alpha1MLPolicy(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of each item in additional_ports (list)
+        _items = []
+        if self.additional_ports:
+            for _item_additional_ports in self.additional_ports:
+                if _item_additional_ports:
+                    _items.append(_item_additional_ports.to_dict())
+            _dict['additionalPorts'] = _items
+        # override the default output from pydantic by calling `to_dict()` of deepspeed
+        if self.deepspeed:
+            _dict['deepspeed'] = self.deepspeed.to_dict()
         # override the default output from pydantic by calling `to_dict()` of flux
         if self.flux:
             _dict['flux'] = self.flux.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of horovod
+        if self.horovod:
+            _dict['horovod'] = self.horovod.to_dict()
         # override the default output from pydantic by calling `to_dict()` of mpi
         if self.mpi:
             _dict['mpi'] = self.mpi.to_dict()
         # override the default output from pydantic by calling `to_dict()` of torch
         if self.torch:
             _dict['torch'] = self.torch.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of xgboost
+        if self.xgboost:
+            _dict['xgboost'] = self.xgboost.to_dict()
         return _dict
 
     @classmethod
@@ -111,12 +139,20 @@ class TrainerV1alpha1MLPolicy(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "additionalPorts": [TrainerV1alpha1NamedPort.from_dict(_item) for _item in obj["additionalPorts"]] if obj.get("additionalPorts") is not None else None,
+            "deepspeed": TrainerV1alpha1DeepSpeedMLPolicySource.from_dict(obj["deepspeed"]) if obj.get("deepspeed") is not None else None,
             "flux": TrainerV1alpha1FluxMLPolicySource.from_dict(obj["flux"]) if obj.get("flux") is not None else None,
+            "horovod": TrainerV1alpha1HorovodMLPolicySource.from_dict(obj["horovod"]) if obj.get("horovod") is not None else None,
             "jax": obj.get("jax"),
             "mpi": TrainerV1alpha1MPIMLPolicySource.from_dict(obj["mpi"]) if obj.get("mpi") is not None else None,
             "numNodes": obj.get("numNodes"),
+            "ray": obj.get("ray"),
+            "singleProcessPerNode": obj.get("singleProcessPerNode"),
+            "tensorflow": obj.get("tensorflow"),
             "torch": TrainerV1alpha1TorchMLPolicySource.from_dict(obj["torch"]) if obj.get("torch") is not None else None,
-            "xgboost": obj.get("xgboost")
+            "trainerPort": obj.get("trainerPort"),
+            "trainerPortName": obj.get("trainerPortName"),
+            "xgboost": TrainerV1alpha1XGBoostMLPolicySource.from_dict(obj["xgboost"]) if obj.get("xgboost") is not None else None
         })
         return _obj
 
@@ -33,9 +33,12 @@ import json
 
 from pydantic import BaseModel, ConfigDict, Field
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.trainer_v1alpha1_deep_speed_ml_policy_source import TrainerV1alpha1DeepSpeedMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_flux_ml_policy_source import TrainerV1alpha1FluxMLPolicySource
+from kubeflow_trainer_api.models.trainer_v1alpha1_horovod_ml_policy_source import TrainerV1alpha1HorovodMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_mpiml_policy_source import TrainerV1alpha1MPIMLPolicySource
 from kubeflow_trainer_api.models.trainer_v1alpha1_torch_ml_policy_source import TrainerV1alpha1TorchMLPolicySource
+from kubeflow_trainer_api.models.trainer_v1alpha1_xg_boost_ml_policy_source import TrainerV1alpha1XGBoostMLPolicySource
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -43,12 +46,16 @@ class TrainerV1alpha1MLPolicySource(BaseModel):
     """
     MLPolicySource represents the runtime-specific configuration for various technologies. One of the following specs can be set.
     """ # noqa: E501
+    deepspeed: Optional[TrainerV1alpha1DeepSpeedMLPolicySource] = Field(default=None, description="deepspeed defines the configuration for the DeepSpeed runtime.")
     flux: Optional[TrainerV1alpha1FluxMLPolicySource] = Field(default=None, description="flux defines the configuration for the Flux runtime.")
+    horovod: Optional[TrainerV1alpha1HorovodMLPolicySource] = Field(default=None, description="horovod defines the configuration for the Horovod runtime. It must be configured together with mpi of the OpenMPI implementation, which provides the SSH keys and the hostfile.")
     jax: Optional[Dict[str, Any]] = Field(default=None, description="jax defines the configuration for the JAX Runtime")
     mpi: Optional[TrainerV1alpha1MPIMLPolicySource] = Field(default=None, description="mpi defines the configuration for the MPI Runtime.")
+    ray: Optional[Dict[str, Any]] = Field(default=None, description="ray defines the configuration for the Ray runtime.")
+    tensorflow: Optional[Dict[str, Any]] = Field(default=None, description="tensorflow defines the configuration for the TensorFlow runtime.")
     torch: Optional[TrainerV1alpha1TorchMLPolicySource] = Field(default=None, description="torch defines the configuration for the PyTorch runtime.")
-    xgboost: Optional[Dict[str, Any]] = Field(default=None, description="xgboost defines the configuration for the XGBoost Runtime.")
-    __properties: ClassVar[List[str]] = ["flux", "jax", "mpi", "torch", "xgboost"]
+    xgboost: Optional[TrainerV1alpha1XGBoostMLPolicySource] = Field(default=None, description="xgboost defines the configuration for the XGBoost Runtime.")
+    __properties: ClassVar[List[str]] = ["deepspeed", "flux", "horovod", "jax", "mpi", "ray", "tensorflow", "torch", "xgboost"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -89,15 +96,24 @@ class TrainerV1alpha1MLPolicySource(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of deepspeed
+        if self.deepspeed:
+            _dict['deepspeed'] = self.deepspeed.to_dict()
         # override the default output from pydantic by calling `to_dict()` of flux
         if self.flux:
             _dict['flux'] = self.flux.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of horovod
+        if self.horovod:
+            _dict['horovod'] = self.horovod.to_dict()
         # override the default output from pydantic by calling `to_dict()` of mpi
         if self.mpi:
             _dict['mpi'] = self.mpi.to_dict()
         # override the default output from pydantic by calling `to_dict()` of torch
         if self.torch:
             _dict['torch'] = self.torch.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of xgboost
+        if self.xgboost:
+            _dict['xgboost'] = self.xgboost.to_dict()
         return _dict
 
     @classmethod
@@ -110,11 +126,15 @@ class TrainerV1alpha1MLPolicySource(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "deepspeed": TrainerV1alpha1DeepSpeedMLPolicySource.from_dict(obj["deepspeed"]) if obj.get("deepspeed") is not None else None,
             "flux": TrainerV1alpha1FluxMLPolicySource.from_dict(obj["flux"]) if obj.get("flux") is not None else None,
+            "horovod": TrainerV1alpha1HorovodMLPolicySource.from_dict(obj["horovod"]) if obj.get("horovod") is not None else None,
             "jax": obj.get("jax"),
             "mpi": TrainerV1alpha1MPIMLPolicySource.from_dict(obj["mpi"]) if obj.get("mpi") is not None else None,
+            "ray": obj.get("ray"),
+            "tensorflow": obj.get("tensorflow"),
             "torch": TrainerV1alpha1TorchMLPolicySource.from_dict(obj["torch"]) if obj.get("torch") is not None else None,
-            "xgboost": obj.get("xgboost")
+            "xgboost": TrainerV1alpha1XGBoostMLPolicySource.from_dict(obj["xgboost"]) if obj.get("xgboost") is not None else None
         })
         return _obj
 
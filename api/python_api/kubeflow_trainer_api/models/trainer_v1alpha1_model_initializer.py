@@ -33,6 +33,7 @@ import json
 
 from pydantic import BaseModel, ConfigDict, Field, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.io_k8s_api_core_v1_env_from_source import IoK8sApiCoreV1EnvFromSource
 from kubeflow_trainer_api.models.io_k8s_api_core_v1_env_var import IoK8sApiCoreV1EnvVar
 from kubeflow_trainer_api.models.io_k8s_api_core_v1_local_object_reference import IoK8sApiCoreV1LocalObjectReference
 from typing import Optional, Set
@@ -43,9 +44,10 @@ class TrainerV1alpha1ModelInitializer(BaseModel):
     ModelInitializer represents the desired configuration to initialize pre-trained model. The ModelInitializer spec will override the runtime Job template which contains this label: `trainer.kubeflow.org/trainjob-ancestor-step: dataset-initializer`
     """ # noqa: E501
     env: Optional[List[IoK8sApiCoreV1EnvVar]] = Field(default=None, description="env is the list of environment variables to set in the model initializer container. These values will be merged with the TrainingRuntime's model initializer environments.")
+    env_from: Optional[List[IoK8sApiCoreV1EnvFromSource]] = Field(default=None, description="envFrom is the list of sources to populate environment variables in the model initializer container. These values will be appended to the TrainingRuntime's model initializer envFrom sources.", alias="envFrom")
     secret_ref: Optional[IoK8sApiCoreV1LocalObjectReference] = Field(default=None, description="secretRef is the reference to the secret with credentials to download model. Secret must be created in the TrainJob's namespace.", alias="secretRef")
     storage_uri: Optional[StrictStr] = Field(default=None, description="storageUri is the URI for the model provider. If set, it may be empty, or it must be a valid URI format (e.g., s3://bucket/path, gs://bucket/path).", alias="storageUri")
-    __properties: ClassVar[List[str]] = ["env", "secretRef", "storageUri"]
+    __properties: ClassVar[List[str]] = ["env", "envFrom", "secretRef", "storageUri"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -93,6 +95,13 @@ class TrainerV1alpha1ModelInitializer(BaseModel):
                 if _item_env:
                     _items.append(_item_env.to_dict())
             _dict['env'] = _items
+        # override the default output from pydantic by calling `to_dict()` of each item in env_from (list)
+        _items = []
+        if self.env_from:
+            for _item_env_from in self.env_from:
+                if _item_env_from:
+                    _items.append(_item_env_from.to_dict())
+            _dict['envFrom'] = _items
         # override the default output from pydantic by calling `to_dict()` of secret_ref
         if self.secret_ref:
             _dict['secretRef'] = self.secret_ref.to_dict()
@@ -109,6 +118,7 @@ class TrainerV1alpha1ModelInitializer(BaseModel):
 
         _obj = cls.model_validate({
             "env": [IoK8sApiCoreV1EnvVar.from_dict(_item) for _item in obj["env"]] if obj.get("env") is not None else None,
+            "envFrom": [IoK8sApiCoreV1EnvFromSource.from_dict(_item) for _item in obj["envFrom"]] if obj.get("envFrom") is not None else None,
             "secretRef": IoK8sApiCoreV1LocalObjectReference.from_dict(obj["secretRef"]) if obj.get("secretRef") is not None else None,
             "storageUri": obj.get("storageUri")
         })
@@ -40,11 +40,13 @@ class TrainerV1alpha1MPIMLPolicySource(BaseModel):
     """
     MPIMLPolicySource represents a MPI runtime configuration.
     """ # noqa: E501
+    launcher_binary: Optional[StrictStr] = Field(default=None, description="launcherBinary is the binary starting the MPI processes on the launcher, for example mpirun or mpiexec. When set, it replaces the first element of the launcher container command in the runtime, while the command of the TrainJob trainer is used as is.", alias="launcherBinary")
+    mount_hostfile_on_nodes: Optional[StrictBool] = Field(default=None, description="mountHostfileOnNodes defines whether to mount the generated hostfile on the node Jobs as well as the launcher Job, for the MPI setups where the training processes on the nodes read the hostfile. Defaults to false.", alias="mountHostfileOnNodes")
     mpi_implementation: Optional[StrictStr] = Field(default=None, description="mpiImplementation is the name of the MPI implementation to create the appropriate hostfile. Defaults to OpenMPI.", alias="mpiImplementation")
     num_proc_per_node: Optional[StrictInt] = Field(default=None, description="numProcPerNode is the number of processes per node. This value is equal to the number of slots for each node in the hostfile. Defaults to 1.", alias="numProcPerNode")
     run_launcher_as_node: Optional[StrictBool] = Field(default=None, description="runLauncherAsNode defines whether to run training process on the launcher Job. Defaults to false.", alias="runLauncherAsNode")
     ssh_auth_mount_path: Optional[StrictStr] = Field(default=None, description="sshAuthMountPath is the directory where SSH keys are mounted. Defaults to /root/.ssh.", alias="sshAuthMountPath")
-    __properties: ClassVar[List[str]] = ["mpiImplementation", "numProcPerNode", "runLauncherAsNode", "sshAuthMountPath"]
+    __properties: ClassVar[List[str]] = ["launcherBinary", "mountHostfileOnNodes", "mpiImplementation", "numProcPerNode", "runLauncherAsNode", "sshAuthMountPath"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -97,6 +99,8 @@ class TrainerV1alpha1MPIMLPolicySource(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "launcherBinary": obj.get("launcherBinary"),
+            "mountHostfileOnNodes": obj.get("mountHostfileOnNodes"),
             "mpiImplementation": obj.get("mpiImplementation"),
             "numProcPerNode": obj.get("numProcPerNode"),
             "runLauncherAsNode": obj.get("runLauncherAsNode"),
@@ -0,0 +1,113 @@
+# Copyright The Kubeflow Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# coding: utf-8
+
+"""
+    Kubeflow Trainer OpenAPI Spec
+
+    No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+
+    The version of the OpenAPI document: unversioned
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
+from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.io_k8s_api_core_v1_toleration import IoK8sApiCoreV1Toleration
+from typing import Optional, Set
+from typing_extensions import Self
+
+class TrainerV1alpha1Spot(BaseModel):
+    """
+    Spot represents the placement of the training nodes on the spot nodes.
+    """ # noqa: E501
+    fallback_after_scheduling_failures: Optional[StrictInt] = Field(default=None, description="fallbackAfterSchedulingFailures is the number of the trainer Pods failing to be scheduled on the spot nodes, after which the trainer is recreated on the on-demand nodes. The fallback is reported by the SpotFallback condition. Defaults to no fallback.", alias="fallbackAfterSchedulingFailures")
+    node_labels: Dict[str, StrictStr] = Field(description="nodeLabels are the node labels identifying the spot nodes, for example `cloud.google.com/gke-spot: \"true\"`. They are translated into the required node affinity of the trainer, which excludes the spot nodes instead once the TrainJob falls back to the on-demand nodes.", alias="nodeLabels")
+    tolerations: Optional[List[IoK8sApiCoreV1Toleration]] = Field(default=None, description="tolerations is the list of tolerations for the spot node taints. They are not added once the TrainJob falls back to the on-demand nodes.")
+    __properties: ClassVar[List[str]] = ["fallbackAfterSchedulingFailures", "nodeLabels", "tolerations"]
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Optional[Self]:
+        """Create an instance of TrainerV1alpha1Spot from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: Set[str] = set([
+        ])
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        # override the default output from pydantic by calling `to_dict()` of each item in tolerations (list)
+        _items = []
+        if self.tolerations:
+            for _item_tolerations in self.tolerations:
+                if _item_tolerations:
+                    _items.append(_item_tolerations.to_dict())
+            _dict['tolerations'] = _items
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Optional[Dict[str, Any]]) -> Optional[Self]:
+        """Create an instance of TrainerV1alpha1Spot from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "fallbackAfterSchedulingFailures": obj.get("fallbackAfterSchedulingFailures"),
+            "nodeLabels": obj.get("nodeLabels"),
+            "tolerations": [IoK8sApiCoreV1Toleration.from_dict(_item) for _item in obj["tolerations"]] if obj.get("tolerations") is not None else None
+        })
+        return _obj
+
+
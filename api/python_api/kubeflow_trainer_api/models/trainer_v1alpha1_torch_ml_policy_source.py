@@ -31,8 +31,10 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field
+from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.io_k8s_apimachinery_pkg_api_resource_quantity import IoK8sApimachineryPkgApiResourceQuantity
+from kubeflow_trainer_api.models.io_k8s_apimachinery_pkg_util_intstr_int_or_string import IoK8sApimachineryPkgUtilIntstrIntOrString
 from kubeflow_trainer_api.models.trainer_v1alpha1_env_injection import TrainerV1alpha1EnvInjection
 from typing import Optional, Set
 from typing_extensions import Self
@@ -42,7 +44,14 @@ class TrainerV1alpha1TorchMLPolicySource(BaseModel):
     TorchMLPolicySource represents a PyTorch runtime configuration.
     """ # noqa: E501
     env_injection: Optional[TrainerV1alpha1EnvInjection] = Field(default=None, description="envInjection configures which additional containers should receive the PET_* environment variables. By default, the PET_* variables are injected only into the main \"node\" container. Use this field to also inject them into selected sidecar or init containers. For torchtune, envInjection targets still receive PET_MASTER_ADDR and PET_MASTER_PORT even though the main trainer container uses command-line rendezvous instead. Defaults to empty (main container only).", alias="envInjection")
-    __properties: ClassVar[List[str]] = ["envInjection"]
+    max_nodes: Optional[StrictInt] = Field(default=None, description="maxNodes is the maximum number of nodes for the PyTorch elastic training. It must be set together with minNodes.", alias="maxNodes")
+    memory_per_process: Optional[IoK8sApimachineryPkgApiResourceQuantity] = Field(default=None, description="memoryPerProcess is the memory budget of each training process for the \"memory\" numProcPerNode mode. The number of processes per node is the memory requested by the trainer container divided by this budget, defaulting to 1 when the memory is not requested or the budget is not set.", alias="memoryPerProcess")
+    min_nodes: Optional[StrictInt] = Field(default=None, description="minNodes is the minimum number of nodes for the PyTorch elastic training. When minNodes and maxNodes are set, torchrun uses the c10d rendezvous backend and the training proceeds with any number of nodes within the range.", alias="minNodes")
+    num_proc_per_node: Optional[IoK8sApimachineryPkgUtilIntstrIntOrString] = Field(default=None, description="numProcPerNode is the number of processes per node, which is set to PET_NPROC_PER_NODE. It can be a positive integer or one of \"auto\", \"cpu\", \"gpu\", and \"memory\". The \"cpu\" and \"gpu\" modes resolve to the number of CPUs and GPUs requested by the trainer container, defaulting to 1 when none are requested. The GPUs of any vendor are counted, for example nvidia.com/gpu or amd.com/gpu. The \"memory\" mode resolves to the memory requested by the trainer container divided by memoryPerProcess. The numProcPerNode of the TrainJob trainer takes precedence. Defaults to \"auto\".", alias="numProcPerNode")
+    num_proc_per_node_label: Optional[StrictStr] = Field(default=None, description="numProcPerNodeLabel is the Node label holding the number of processes per node, for example for the clusters labeling the nodes with their GPU count. The label of the Node which the trainer Pod is scheduled to is copied to the trainer Pod label, which is set to PET_NPROC_PER_NODE through the downward API. It takes precedence over numProcPerNode, while the numProcPerNode of the TrainJob trainer takes precedence over it.", alias="numProcPerNodeLabel")
+    rdzv_backend: Optional[StrictStr] = Field(default=None, description="rdzvBackend is the torchrun rendezvous backend. The static backend uses the rank-0 node as the master address, while the other backends use the rendezvous endpoint and the TrainJob UID as the rendezvous id. Defaults to c10d when minNodes and maxNodes are set, otherwise to static.", alias="rdzvBackend")
+    rdzv_endpoint: Optional[StrictStr] = Field(default=None, description="rdzvEndpoint is the rendezvous endpoint in the host:port format. It is required for the etcd and etcd-v2 backends. Defaults to the rank-0 node address for the c10d backend.", alias="rdzvEndpoint")
+    __properties: ClassVar[List[str]] = ["envInjection", "maxNodes", "memoryPerProcess", "minNodes", "numProcPerNode", "numProcPerNodeLabel", "rdzvBackend", "rdzvEndpoint"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -86,6 +95,12 @@ class TrainerV1alpha1TorchMLPolicySource(BaseModel):
         # override the default output from pydantic by calling `to_dict()` of env_injection
         if self.env_injection:
             _dict['envInjection'] = self.env_injection.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of memory_per_process
+        if self.memory_per_process:
+            _dict['memoryPerProcess'] = self.memory_per_process.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of num_proc_per_node
+        if self.num_proc_per_node:
+            _dict['numProcPerNode'] = self.num_proc_per_node.to_dict()
         return _dict
 
     @classmethod
@@ -98,7 +113,14 @@ class TrainerV1alpha1TorchMLPolicySource(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
-            "envInjection": TrainerV1alpha1EnvInjection.from_dict(obj["envInjection"]) if obj.get("envInjection") is not None else None
+            "envInjection": TrainerV1alpha1EnvInjection.from_dict(obj["envInjection"]) if obj.get("envInjection") is not None else None,
+            "maxNodes": obj.get("maxNodes"),
+            "memoryPerProcess": IoK8sApimachineryPkgApiResourceQuantity.from_dict(obj["memoryPerProcess"]) if obj.get("memoryPerProcess") is not None else None,
+            "minNodes": obj.get("minNodes"),
+            "numProcPerNode": IoK8sApimachineryPkgUtilIntstrIntOrString.from_dict(obj["numProcPerNode"]) if obj.get("numProcPerNode") is not None else None,
+            "numProcPerNodeLabel": obj.get("numProcPerNodeLabel"),
+            "rdzvBackend": obj.get("rdzvBackend"),
+            "rdzvEndpoint": obj.get("rdzvEndpoint")
         })
         return _obj
 
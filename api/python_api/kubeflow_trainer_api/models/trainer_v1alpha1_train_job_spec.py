@@ -33,7 +33,9 @@ import json
 
 from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.trainer_v1alpha1_checkpoint_config import TrainerV1alpha1CheckpointConfig
 from kubeflow_trainer_api.models.trainer_v1alpha1_initializer import TrainerV1alpha1Initializer
+from kubeflow_trainer_api.models.trainer_v1alpha1_run_policy import TrainerV1alpha1RunPolicy
 from kubeflow_trainer_api.models.trainer_v1alpha1_runtime_patch import TrainerV1alpha1RuntimePatch
 from kubeflow_trainer_api.models.trainer_v1alpha1_runtime_ref import TrainerV1alpha1RuntimeRef
 from kubeflow_trainer_api.models.trainer_v1alpha1_trainer import TrainerV1alpha1Trainer
@@ -45,13 +47,15 @@ class TrainerV1alpha1TrainJobSpec(BaseModel):
     TrainJobSpec represents specification of the desired TrainJob.
     """ # noqa: E501
     active_deadline_seconds: Optional[StrictInt] = Field(default=None, description="activeDeadlineSeconds specifies the duration in seconds relative to the TrainJob start time (which resets on resume from suspension) that the TrainJob may be active before the system tries to terminate it. Value must be a positive integer. Once reached, all running Pods are terminated and the TrainJob status becomes Failed with reason: DeadlineExceeded.", alias="activeDeadlineSeconds")
+    checkpoint: Optional[TrainerV1alpha1CheckpointConfig] = Field(default=None, description="checkpoint defines the checkpoint to resume the training from.")
     initializer: Optional[TrainerV1alpha1Initializer] = Field(default=None, description="initializer defines the configuration of the initializer.")
     managed_by: Optional[StrictStr] = Field(default=None, description="managedBy is used to indicate the controller or entity that manages a TrainJob. The value must be either an empty, `trainer.kubeflow.org/trainjob-controller` or `kueue.x-k8s.io/multikueue`. The built-in TrainJob controller reconciles TrainJob which don't have this field at all or the field value is the reserved string `trainer.kubeflow.org/trainjob-controller`, but delegates reconciling TrainJobs with a 'kueue.x-k8s.io/multikueue' to the Kueue. The field is immutable.", alias="managedBy")
+    run_policy: Optional[TrainerV1alpha1RunPolicy] = Field(default=None, description="runPolicy defines the policies applied to the TrainJob resources at runtime.", alias="runPolicy")
     runtime_patches: Optional[List[TrainerV1alpha1RuntimePatch]] = Field(default=None, description="runtimePatches defines custom patches applied to the TrainJob's Runtime. Patches are keyed by manager to provide clear ownership and avoid conflicts between controllers.", alias="runtimePatches")
     runtime_ref: TrainerV1alpha1RuntimeRef = Field(description="runtimeRef is the reference to the training runtime.", alias="runtimeRef")
     suspend: Optional[StrictBool] = Field(default=None, description="suspend defines whether to suspend the running TrainJob.")
-    trainer: Optional[TrainerV1alpha1Trainer] = Field(default=None, description="trainer defines the configuration of the trainer.")
-    __properties: ClassVar[List[str]] = ["activeDeadlineSeconds", "initializer", "managedBy", "runtimePatches", "runtimeRef", "suspend", "trainer"]
+    trainer: Optional[TrainerV1alpha1Trainer] = Field(default=None, description="trainer defines the configuration of the trainer. The trainer is immutable except numNodes, which can be changed while the TrainJob is suspended.")
+    __properties: ClassVar[List[str]] = ["activeDeadlineSeconds", "checkpoint", "initializer", "managedBy", "runPolicy", "runtimePatches", "runtimeRef", "suspend", "trainer"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -92,9 +96,15 @@ class TrainerV1alpha1TrainJobSpec(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of checkpoint
+        if self.checkpoint:
+            _dict['checkpoint'] = self.checkpoint.to_dict()
         # override the default output from pydantic by calling `to_dict()` of initializer
         if self.initializer:
             _dict['initializer'] = self.initializer.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of run_policy
+        if self.run_policy:
+            _dict['runPolicy'] = self.run_policy.to_dict()
         # override the default output from pydantic by calling `to_dict()` of each item in runtime_patches (list)
         _items = []
         if self.runtime_patches:
@@ -121,8 +131,10 @@ class TrainerV1alpha1TrainJobSpec(BaseModel):
 
         _obj = cls.model_validate({
             "activeDeadlineSeconds": obj.get("activeDeadlineSeconds"),
+            "checkpoint": TrainerV1alpha1CheckpointConfig.from_dict(obj["checkpoint"]) if obj.get("checkpoint") is not None else None,
             "initializer": TrainerV1alpha1Initializer.from_dict(obj["initializer"]) if obj.get("initializer") is not None else None,
             "managedBy": obj.get("managedBy"),
+            "runPolicy": TrainerV1alpha1RunPolicy.from_dict(obj["runPolicy"]) if obj.get("runPolicy") is not None else None,
             "runtimePatches": [TrainerV1alpha1RuntimePatch.from_dict(_item) for _item in obj["runtimePatches"]] if obj.get("runtimePatches") is not None else None,
             "runtimeRef": TrainerV1alpha1RuntimeRef.from_dict(obj["runtimeRef"]) if obj.get("runtimeRef") is not None else None,
             "suspend": obj.get("suspend"),
@@ -31,9 +31,12 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field
+from datetime import datetime
+from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.io_k8s_apimachinery_pkg_api_resource_quantity import IoK8sApimachineryPkgApiResourceQuantity
 from kubeflow_trainer_api.models.io_k8s_apimachinery_pkg_apis_meta_v1_condition import IoK8sApimachineryPkgApisMetaV1Condition
+from kubeflow_trainer_api.models.trainer_v1alpha1_job_set_status import TrainerV1alpha1JobSetStatus
 from kubeflow_trainer_api.models.trainer_v1alpha1_job_status import TrainerV1alpha1JobStatus
 from kubeflow_trainer_api.models.trainer_v1alpha1_trainer_status import TrainerV1alpha1TrainerStatus
 from typing import Optional, Set
@@ -43,10 +46,16 @@ class TrainerV1alpha1TrainJobStatus(BaseModel):
     """
     TrainJobStatus represents the current status of TrainJob.
     """ # noqa: E501
+    completion_time: Optional[datetime] = Field(default=None, description="completionTime is the time when the TrainJob completed. It is not set if the TrainJob failed.", alias="completionTime")
     conditions: Optional[List[IoK8sApimachineryPkgApisMetaV1Condition]] = Field(default=None, description="conditions for the TrainJob.")
+    effective_command: Optional[List[StrictStr]] = Field(default=None, description="effectiveCommand is the final command and arguments of the trainer node container, as rendered into the runtime resources after all the runtime plugins applied. It is recorded for audit and reproducibility purposes.", alias="effectiveCommand")
+    job_set_status: Optional[TrainerV1alpha1JobSetStatus] = Field(default=None, description="jobSetStatus mirrors the high-level status of the JobSet created for the TrainJob.", alias="jobSetStatus")
     jobs_status: Optional[List[TrainerV1alpha1JobStatus]] = Field(default=None, description="jobsStatus tracks the child Jobs in TrainJob.", alias="jobsStatus")
+    restart_count: Optional[StrictInt] = Field(default=None, description="restartCount is the number of times the JobSet of the TrainJob has been restarted.", alias="restartCount")
+    start_time: Optional[datetime] = Field(default=None, description="startTime is the time when the TrainJob was started, or resumed after the suspension. It is reset when the TrainJob is suspended.", alias="startTime")
+    total_resources: Optional[Dict[str, IoK8sApimachineryPkgApiResourceQuantity]] = Field(default=None, description="totalResources is the total amount of compute resources requested by all the Pods of the TrainJob. For the gang-scheduled TrainJob, it matches the minResources of the PodGroup.", alias="totalResources")
     trainer_status: Optional[TrainerV1alpha1TrainerStatus] = Field(default=None, description="trainerStatus contains the latest observed runtime status of the Trainer step of the TrainJob. It reflects progress, remaining time, metrics, and the last update timestamp.  This field is nil if the TrainJob does not report trainer-level status, or if no status has been observed yet (for example, immediately after the TrainJob is created).  This is an alpha feature and requires enabling the TrainJobStatus feature gate.", alias="trainerStatus")
-    __properties: ClassVar[List[str]] = ["conditions", "jobsStatus", "trainerStatus"]
+    __properties: ClassVar[List[str]] = ["completionTime", "conditions", "effectiveCommand", "jobSetStatus", "jobsStatus", "restartCount", "startTime", "totalResources", "trainerStatus"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -94,6 +103,9 @@ class TrainerV1alpha1TrainJobStatus(BaseModel):
                 if _item_conditions:
                     _items.append(_item_conditions.to_dict())
             _dict['conditions'] = _items
+        # override the default output from pydantic by calling `to_dict()` of job_set_status
+        if self.job_set_status:
+            _dict['jobSetStatus'] = self.job_set_status.to_dict()
         # override the default output from pydantic by calling `to_dict()` of each item in jobs_status (list)
         _items = []
         if self.jobs_status:
@@ -116,8 +128,14 @@ class TrainerV1alpha1TrainJobStatus(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "completionTime": obj.get("completionTime"),
             "conditions": [IoK8sApimachineryPkgApisMetaV1Condition.from_dict(_item) for _item in obj["conditions"]] if obj.get("conditions") is not None else None,
+            "effectiveCommand": obj.get("effectiveCommand"),
+            "jobSetStatus": TrainerV1alpha1JobSetStatus.from_dict(obj["jobSetStatus"]) if obj.get("jobSetStatus") is not None else None,
             "jobsStatus": [TrainerV1alpha1JobStatus.from_dict(_item) for _item in obj["jobsStatus"]] if obj.get("jobsStatus") is not None else None,
+            "restartCount": obj.get("restartCount"),
+            "startTime": obj.get("startTime"),
+            "totalResources": obj.get("totalResources"),
             "trainerStatus": TrainerV1alpha1TrainerStatus.from_dict(obj["trainerStatus"]) if obj.get("trainerStatus") is not None else None
         })
         return _obj
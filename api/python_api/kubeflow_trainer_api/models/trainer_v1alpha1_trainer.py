@@ -31,10 +31,16 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
+from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_trainer_api.models.io_k8s_api_core_v1_env_from_source import IoK8sApiCoreV1EnvFromSource
 from kubeflow_trainer_api.models.io_k8s_api_core_v1_env_var import IoK8sApiCoreV1EnvVar
+from kubeflow_trainer_api.models.io_k8s_api_core_v1_local_object_reference import IoK8sApiCoreV1LocalObjectReference
 from kubeflow_trainer_api.models.io_k8s_api_core_v1_resource_requirements import IoK8sApiCoreV1ResourceRequirements
+from kubeflow_trainer_api.models.io_k8s_api_core_v1_toleration import IoK8sApiCoreV1Toleration
+from kubeflow_trainer_api.models.trainer_v1alpha1_gpu_topology import TrainerV1alpha1GPUTopology
+from kubeflow_trainer_api.models.trainer_v1alpha1_heartbeat import TrainerV1alpha1Heartbeat
+from kubeflow_trainer_api.models.trainer_v1alpha1_spot import TrainerV1alpha1Spot
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -42,14 +48,26 @@ class TrainerV1alpha1Trainer(BaseModel):
     """
     Trainer represents the desired configuration for the training job. The Trainer spec will override the runtime template which contains this label: `trainer.kubeflow.org/trainjob-ancestor-step: trainer`
     """ # noqa: E501
+    add_capabilities: Optional[List[StrictStr]] = Field(default=None, description="addCapabilities is the list of Linux capabilities to add to the training container security context. Only capabilities required by RDMA/InfiniBand networking are allowed. For example, `IPC_LOCK` is needed to pin memory for RDMA.", alias="addCapabilities")
     args: Optional[List[StrictStr]] = Field(default=None, description="args for the entrypoint for the training container.")
+    canary: Optional[StrictBool] = Field(default=None, description="canary runs the trainer with a single node as a smoke test before it is scaled to numNodes, for example to catch the image or entrypoint errors cheaply. The trainer is scaled to numNodes once the canary succeeds, which is reported by the Canary condition. The TrainJob fails if the canary fails. Defaults to false.")
     command: Optional[List[StrictStr]] = Field(default=None, description="command for the entrypoint of the training container.")
     env: Optional[List[IoK8sApiCoreV1EnvVar]] = Field(default=None, description="env is the list of environment variables to set in the training container. These values will be merged with the TrainingRuntime's trainer environments.")
+    env_from: Optional[List[IoK8sApiCoreV1EnvFromSource]] = Field(default=None, description="envFrom is the list of sources to populate environment variables in the training container. These values will be appended to the TrainingRuntime's trainer envFrom sources.", alias="envFrom")
+    gpu_product: Optional[StrictStr] = Field(default=None, description="gpuProduct is the GPU product to place the training nodes on, for example `NVIDIA-H100-80GB-HBM3`. It is translated into the required node affinity on the `nvidia.com/gpu.product` node label, and combined with the TrainingRuntime's trainer node affinity.", alias="gpuProduct")
+    gpu_topology: Optional[TrainerV1alpha1GPUTopology] = Field(default=None, description="gpuTopology requests the topology-aware placement of the training nodes, for example to place all nodes within the same NVLink domain. The placement is requested with the Pod annotations consumed by the Kueue Topology Aware Scheduling.", alias="gpuTopology")
+    heartbeat: Optional[TrainerV1alpha1Heartbeat] = Field(default=None, description="heartbeat configures the liveness probe restarting the trainer container when the training stalls, for example when the distributed job is deadlocked.")
     image: Optional[StrictStr] = Field(default=None, description="image is the container image for the training container.")
+    image_pull_secrets: Optional[List[IoK8sApiCoreV1LocalObjectReference]] = Field(default=None, description="imagePullSecrets is the list of Secrets to pull the trainer images, for example from a private registry. These values will be merged with the TrainingRuntime's trainer image pull secrets without duplicates.", alias="imagePullSecrets")
+    node_selector: Optional[Dict[str, StrictStr]] = Field(default=None, description="nodeSelector is the node selector to place the training nodes on specific nodes. These values will be merged with the TrainingRuntime's trainer node selector, and take precedence over the runtime values with the same keys.", alias="nodeSelector")
     num_nodes: Optional[StrictInt] = Field(default=None, description="numNodes is the number of training nodes.", alias="numNodes")
     num_proc_per_node: Optional[StrictInt] = Field(default=None, description="numProcPerNode is the number of processes/workers/slots on every training node. For the MPI runtime only int value can be set to represent number of slots per node. For the Torch runtime the value defaults to `auto` and can be overridden with an int.", alias="numProcPerNode")
+    pip_packages: Optional[List[StrictStr]] = Field(default=None, description="pipPackages is the list of extra Python packages to install with pip before training, for example `transformers==4.46.0`. The packages are installed by an init container into a volume shared with the training container. It requires the pip install to be enabled in the Trainer controller configuration.", alias="pipPackages")
+    resources_per_container: Optional[Dict[str, IoK8sApiCoreV1ResourceRequirements]] = Field(default=None, description="resourcesPerContainer defines the compute resources for the containers of each training node by the container name, for example the sidecar containers. The containers which are not listed keep the runtime resources. The resources for the node container take precedence over resourcesPerNode.", alias="resourcesPerContainer")
     resources_per_node: Optional[IoK8sApiCoreV1ResourceRequirements] = Field(default=None, description="resourcesPerNode defines the compute resources for each training node.", alias="resourcesPerNode")
-    __properties: ClassVar[List[str]] = ["args", "command", "env", "image", "numNodes", "numProcPerNode", "resourcesPerNode"]
+    spot: Optional[TrainerV1alpha1Spot] = Field(default=None, description="spot places the training nodes on the spot or preemptible nodes for cost savings, with an optional fallback to the on-demand nodes.")
+    tolerations: Optional[List[IoK8sApiCoreV1Toleration]] = Field(default=None, description="tolerations is the list of tolerations for the training nodes. These values will be merged with the TrainingRuntime's trainer tolerations, and replace the runtime tolerations with the same keys.")
+    __properties: ClassVar[List[str]] = ["addCapabilities", "args", "canary", "command", "env", "envFrom", "gpuProduct", "gpuTopology", "heartbeat", "image", "imagePullSecrets", "nodeSelector", "numNodes", "numProcPerNode", "pipPackages", "resourcesPerContainer", "resourcesPerNode", "spot", "tolerations"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -97,9 +115,46 @@ class TrainerV1alpha1Trainer(BaseModel):
                 if _item_env:
                     _items.append(_item_env.to_dict())
             _dict['env'] = _items
+        # override the default output from pydantic by calling `to_dict()` of each item in env_from (list)
+        _items = []
+        if self.env_from:
+            for _item_env_from in self.env_from:
+                if _item_env_from:
+                    _items.append(_item_env_from.to_dict())
+            _dict['envFrom'] = _items
+        # override the default output from pydantic by calling `to_dict()` of gpu_topology
+        if self.gpu_topology:
+            _dict['gpuTopology'] = self.gpu_topology.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of heartbeat
+        if self.heartbeat:
+            _dict['heartbeat'] = self.heartbeat.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of each item in image_pull_secrets (list)
+        _items = []
+        if self.image_pull_secrets:
+            for _item_image_pull_secrets in self.image_pull_secrets:
+                if _item_image_pull_secrets:
+                    _items.append(_item_image_pull_secrets.to_dict())
+            _dict['imagePullSecrets'] = _items
+        # override the default output from pydantic by calling `to_dict()` of each value in resources_per_container (dict)
+        _field_dict = {}
+        if self.resources_per_container:
+            for _key_resources_per_container in self.resources_per_container:
+                if self.resources_per_container[_key_resources_per_container]:
+                    _field_dict[_key_resources_per_container] = self.resources_per_container[_key_resources_per_container].to_dict()
+            _dict['resourcesPerContainer'] = _field_dict
         # override the default output from pydantic by calling `to_dict()` of resources_per_node
         if self.resources_per_node:
             _dict['resourcesPerNode'] = self.resources_per_node.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of spot
+        if self.spot:
+            _dict['spot'] = self.spot.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of each item in tolerations (list)
+        _items = []
+        if self.tolerations:
+            for _item_tolerations in self.tolerations:
+                if _item_tolerations:
+                    _items.append(_item_tolerations.to_dict())
+            _dict['tolerations'] = _items
         return _dict
 
     @classmethod
@@ -112,13 +167,30 @@ class TrainerV1alpha1Trainer(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "addCapabilities": obj.get("addCapabilities"),
             "args": obj.get("args"),
+            "canary": obj.get("canary"),
             "command": obj.get("command"),
             "env": [IoK8sApiCoreV1EnvVar.from_dict(_item) for _item in obj["env"]] if obj.get("env") is not None else None,
+            "envFrom": [IoK8sApiCoreV1EnvFromSource.from_dict(_item) for _item in obj["envFrom"]] if obj.get("envFrom") is not None else None,
+            "gpuProduct": obj.get("gpuProduct"),
+            "gpuTopology": TrainerV1alpha1GPUTopology.from_dict(obj["gpuTopology"]) if obj.get("gpuTopology") is not None else None,
+            "heartbeat": TrainerV1alpha1Heartbeat.from_dict(obj["heartbeat"]) if obj.get("heartbeat") is not None else None,
             "image": obj.get("image"),
+            "imagePullSecrets": [IoK8sApiCoreV1LocalObjectReference.from_dict(_item) for _item in obj["imagePullSecrets"]] if obj.get("imagePullSecrets") is not None else None,
+            "nodeSelector": obj.get("nodeSelector"),
             "numNodes": obj.get("numNodes"),
             "numProcPerNode": obj.get("numProcPerNode"),
-            "resourcesPerNode": IoK8sApiCoreV1ResourceRequirements.from_dict(obj["resourcesPerNode"]) if obj.get("resourcesPerNode") is not None else None
+            "pipPackages": obj.get("pipPackages"),
+            "resourcesPerContainer": dict(
+                (_k, IoK8sApiCoreV1ResourceRequirements.from_dict(_v))
+                for _k, _v in obj["resourcesPerContainer"].items()
+            )
+            if obj.get("resourcesPerContainer") is not None
+            else None,
+            "resourcesPerNode": IoK8sApiCoreV1ResourceRequirements.from_dict(obj["resourcesPerNode"]) if obj.get("resourcesPerNode") is not None else None,
+            "spot": TrainerV1alpha1Spot.from_dict(obj["spot"]) if obj.get("spot") is not None else None,
+            "tolerations": [IoK8sApiCoreV1Toleration.from_dict(_item) for _item in obj["tolerations"]] if obj.get("tolerations") is not None else None
         })
         return _obj
 
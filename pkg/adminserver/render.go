@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adminserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
+)
+
+// RenderPath is the path of the admin endpoint rendering the objects of a TrainJob.
+const RenderPath = TrainJobsPath + "/render"
+
+// Rendered is the response of the admin endpoint rendering the objects of a TrainJob.
+type Rendered struct {
+	JobSet *jobsetv1alpha2.JobSet `json:"jobSet,omitempty"`
+	// Objects are the other objects created for the TrainJob, e.g. ConfigMaps and Secrets.
+	Objects []client.Object `json:"objects"`
+}
+
+// RenderHandler serves the admin endpoint previewing the objects a TrainJob would create.
+// Nothing is persisted in the cluster.
+// The caller must be allowed to create the TrainJob in its namespace, and the Secret data is redacted.
+type RenderHandler struct {
+	log      logr.Logger
+	client   client.Client
+	runtimes map[string]jobruntimes.Runtime
+}
+
+var _ http.Handler = &RenderHandler{}
+
+// NewRenderHandler creates a new RenderHandler rendering the TrainJobs with the runtimes.
+// The client is used to review the bearer token and the access of the caller.
+func NewRenderHandler(c client.Client, runtimes map[string]jobruntimes.Runtime) *RenderHandler {
+	return &RenderHandler{
+		log:      ctrl.Log.WithName("admin"),
+		client:   c,
+		runtimes: runtimes,
+	}
+}
+
+// ServeHTTP renders the JobSet and the other objects for the TrainJob in the request body.
+func (h *RenderHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeStatus(w, h.log, "Method not allowed", metav1.StatusReasonMethodNotAllowed, http.StatusMethodNotAllowed)
+		return
+	}
+
+	trainJob := &trainer.TrainJob{}
+	if err := json.NewDecoder(r.Body).Decode(trainJob); err != nil {
+		writeStatus(w, h.log, fmt.Sprintf("Invalid TrainJob: %v", err), metav1.StatusReasonBadRequest, http.StatusBadRequest)
+		return
+	}
+	if len(trainJob.Namespace) == 0 {
+		writeStatus(w, h.log, "Invalid TrainJob: namespace must be set", metav1.StatusReasonBadRequest, http.StatusBadRequest)
+		return
+	}
+	userInfo, err := authenticate(r.Context(), h.client, r)
+	if err != nil {
+		h.log.Error(err, "Failed to review the bearer token")
+		writeStatus(w, h.log, "Internal error", metav1.StatusReasonInternalError, http.StatusInternalServerError)
+		return
+	}
+	if userInfo == nil {
+		writeStatus(w, h.log, "Unauthorized", metav1.StatusReasonUnauthorized, http.StatusUnauthorized)
+		return
+	}
+	// The rendered objects are the ones the TrainJob would create in its namespace.
+	allowed, err := authorize(r.Context(), h.client, userInfo, "create", trainJob.Namespace)
+	if err != nil {
+		h.log.Error(err, "Failed to review the access", "user", userInfo.Username, "namespace", trainJob.Namespace)
+		writeStatus(w, h.log, "Internal error", metav1.StatusReasonInternalError, http.StatusInternalServerError)
+		return
+	}
+	if !allowed {
+		writeStatus(w, h.log, fmt.Sprintf("User %q cannot create TrainJobs in the namespace %q", userInfo.Username, trainJob.Namespace),
+			metav1.StatusReasonForbidden, http.StatusForbidden)
+		return
+	}
+	runtimeRefGK := jobruntimes.RuntimeRefToRuntimeRegistryKey(trainJob.Spec.RuntimeRef)
+	runtime, ok := h.runtimes[runtimeRefGK]
+	if !ok {
+		writeStatus(w, h.log, fmt.Sprintf("Unsupported runtime: %s", runtimeRefGK), metav1.StatusReasonBadRequest, http.StatusBadRequest)
+		return
+	}
+	jobSet, objs, err := jobruntimes.Render(r.Context(), runtime, trainJob)
+	if err != nil {
+		writeStatus(w, h.log, fmt.Sprintf("Failed to render TrainJob: %v", err), metav1.StatusReasonBadRequest, http.StatusBadRequest)
+		return
+	}
+
+	redactSecrets(objs)
+	rendered := Rendered{JobSet: jobSet, Objects: objs}
+	if rendered.Objects == nil {
+		rendered.Objects = []client.Object{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(rendered); err != nil {
+		h.log.Error(err, "Failed to write rendered objects")
+	}
+}
+
+// redactSecrets clears the values of the rendered Secrets, e.g. the generated SSH private keys,
+// keeping their keys so that the structure of the Secrets can still be previewed.
+func redactSecrets(objs []client.Object) {
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok || u.GroupVersionKind() != corev1.SchemeGroupVersion.WithKind("Secret") {
+			continue
+		}
+		for _, field := range []string{"data", "stringData"} {
+			data, found, _ := unstructured.NestedMap(u.Object, field)
+			if !found {
+				continue
+			}
+			for k := range data {
+				data[k] = ""
+			}
+			_ = unstructured.SetNestedMap(u.Object, data, field)
+		}
+	}
+}
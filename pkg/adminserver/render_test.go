@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adminserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+// fakeRuntime renders a JobSet and an SSH auth Secret for the TrainJob.
+type fakeRuntime struct{}
+
+var _ jobruntimes.Runtime = (*fakeRuntime)(nil)
+
+func (f *fakeRuntime) NewObjects(context.Context, *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
+	return nil, nil
+}
+
+func (f *fakeRuntime) DryRunObjects(_ context.Context, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
+	return []apiruntime.ApplyConfiguration{
+		jobsetv1alpha2ac.JobSet(trainJob.Name, trainJob.Namespace).WithSpec(jobsetv1alpha2ac.JobSetSpec()),
+		corev1ac.Secret(trainJob.Name+"-mpi-ssh-auth", trainJob.Namespace).
+			WithType("kubernetes.io/ssh-auth").
+			WithData(map[string][]byte{"ssh-privatekey": []byte("private")}),
+	}, nil
+}
+
+func (f *fakeRuntime) RuntimeInfo(*trainer.TrainJob, any, *trainer.MLPolicy, *trainer.PodGroupPolicy) (*jobruntimes.Info, error) {
+	return nil, nil
+}
+
+func (f *fakeRuntime) TrainJobStatus(context.Context, *trainer.TrainJob) (*trainer.TrainJobStatus, error) {
+	return nil, nil
+}
+
+func (f *fakeRuntime) EventHandlerRegistrars() []jobruntimes.ReconcilerBuilder {
+	return nil
+}
+
+func (f *fakeRuntime) ValidateObjects(context.Context, *trainer.TrainJob, *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	return nil, nil
+}
+
+func TestRenderHandler(t *testing.T) {
+	trainJob := utiltesting.MakeTrainJobWrapper("team-a", "test").
+		RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "mpi").
+		Obj()
+
+	cases := map[string]struct {
+		token           string
+		allowedUsers    []string
+		wantCode        int
+		wantSecretData  map[string]any
+		wantStatus      *metav1.Status
+		wantReviewedSAR *authorizationv1.ResourceAttributes
+	}{
+		"allowed user gets the rendered objects with the Secret data redacted": {
+			token:        "alice-token",
+			allowedUsers: []string{"alice"},
+			wantCode:     http.StatusOK,
+			wantSecretData: map[string]any{
+				"ssh-privatekey": "",
+			},
+			wantReviewedSAR: &authorizationv1.ResourceAttributes{
+				Namespace: "team-a",
+				Verb:      "create",
+				Group:     trainer.GroupVersion.Group,
+				Resource:  "trainjobs",
+			},
+		},
+		"user not allowed to create TrainJobs in the namespace fails with 403 forbidden": {
+			token:    "alice-token",
+			wantCode: http.StatusForbidden,
+			wantStatus: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: `User "alice" cannot create TrainJobs in the namespace "team-a"`,
+				Reason:  metav1.StatusReasonForbidden,
+				Code:    http.StatusForbidden,
+			},
+		},
+		"request without the bearer token fails with 401 unauthorized": {
+			allowedUsers: []string{"alice"},
+			wantCode:     http.StatusUnauthorized,
+			wantStatus: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: "Unauthorized",
+				Reason:  metav1.StatusReasonUnauthorized,
+				Code:    http.StatusUnauthorized,
+			},
+		},
+		"request with the invalid bearer token fails with 401 unauthorized": {
+			token:        "invalid-token",
+			allowedUsers: []string{"alice"},
+			wantCode:     http.StatusUnauthorized,
+			wantStatus: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: "Unauthorized",
+				Reason:  metav1.StatusReasonUnauthorized,
+				Code:    http.StatusUnauthorized,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotReviewedSAR *authorizationv1.ResourceAttributes
+			cli := utiltesting.NewClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						switch review := obj.(type) {
+						case *authenticationv1.TokenReview:
+							if review.Spec.Token == "alice-token" {
+								review.Status.Authenticated = true
+								review.Status.User = authenticationv1.UserInfo{Username: "alice"}
+							}
+							return nil
+						case *authorizationv1.SubjectAccessReview:
+							gotReviewedSAR = review.Spec.ResourceAttributes
+							for _, user := range tc.allowedUsers {
+								review.Status.Allowed = review.Status.Allowed || review.Spec.User == user
+							}
+							return nil
+						}
+						return c.Create(ctx, obj, opts...)
+					},
+				}).
+				Build()
+			ts := httptest.NewServer(NewRenderHandler(cli, map[string]jobruntimes.Runtime{
+				jobruntimes.RuntimeRefToRuntimeRegistryKey(trainJob.Spec.RuntimeRef): &fakeRuntime{},
+			}))
+			t.Cleanup(ts.Close)
+
+			body, err := json.Marshal(trainJob)
+			if err != nil {
+				t.Fatalf("Failed to marshal TrainJob: %v", err)
+			}
+			req, err := http.NewRequest(http.MethodPost, ts.URL+RenderPath, bytes.NewReader(body))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			if len(tc.token) != 0 {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("HTTP request failed: %v", err)
+			}
+			t.Cleanup(func() { _ = resp.Body.Close() })
+
+			if resp.StatusCode != tc.wantCode {
+				t.Errorf("status = %v, want %v", resp.StatusCode, tc.wantCode)
+			}
+			if tc.wantStatus != nil {
+				var got metav1.Status
+				if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				if diff := cmp.Diff(tc.wantStatus, &got); len(diff) != 0 {
+					t.Errorf("Unexpected response (-want,+got):\n%s", diff)
+				}
+				return
+			}
+			var got struct {
+				Objects []map[string]any `json:"objects"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if len(got.Objects) != 1 {
+				t.Fatalf("Unexpected number of rendered objects: %d, want 1", len(got.Objects))
+			}
+			if diff := cmp.Diff(tc.wantSecretData, got.Objects[0]["data"]); len(diff) != 0 {
+				t.Errorf("Unexpected Secret data (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantReviewedSAR, gotReviewedSAR); len(diff) != 0 {
+				t.Errorf("Unexpected SubjectAccessReview attributes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
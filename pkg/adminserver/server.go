@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adminserver
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
+)
+
+// TrainJobsPath is the path of the admin endpoint listing the active TrainJobs.
+const TrainJobsPath = "/admin/trainjobs"
+
+// TrainJobList is the response of the admin endpoint listing the active TrainJobs.
+type TrainJobList struct {
+	Items []TrainJob `json:"items"`
+}
+
+// TrainJob is the summary of an active TrainJob.
+type TrainJob struct {
+	Namespace  string             `json:"namespace"`
+	Name       string             `json:"name"`
+	RuntimeRef trainer.RuntimeRef `json:"runtimeRef"`
+	Suspended  bool               `json:"suspended"`
+	// Runtime is nil when the referenced runtime does not exist.
+	Runtime *Runtime `json:"runtime,omitempty"`
+	// JobSet is nil until the JobSet is rendered for the TrainJob.
+	JobSet *JobSet `json:"jobSet,omitempty"`
+}
+
+// Runtime is the summary of the runtime resolved from the TrainJob runtimeRef.
+type Runtime struct {
+	Kind string `json:"kind"`
+	// Namespace is empty for the ClusterTrainingRuntime.
+	Namespace string            `json:"namespace,omitempty"`
+	Name      string            `json:"name"`
+	MLPolicy  *trainer.MLPolicy `json:"mlPolicy,omitempty"`
+}
+
+// JobSet is the summary of the JobSet rendered for a TrainJob.
+type JobSet struct {
+	ReplicatedJobs []ReplicatedJob `json:"replicatedJobs"`
+}
+
+// ReplicatedJob is the summary of a replicated Job of the rendered JobSet.
+type ReplicatedJob struct {
+	Name        string   `json:"name"`
+	Replicas    int32    `json:"replicas"`
+	Parallelism int32    `json:"parallelism"`
+	Images      []string `json:"images"`
+}
+
+// Handler serves the read-only admin endpoint listing the active TrainJobs.
+// The caller must be allowed to list the TrainJobs in all the namespaces.
+type Handler struct {
+	log    logr.Logger
+	client client.Client
+}
+
+var _ http.Handler = &Handler{}
+
+// NewHandler creates a new Handler reading the TrainJobs, runtimes and JobSets with the client.
+// The client is also used to review the bearer token and the access of the caller.
+func NewHandler(c client.Client) *Handler {
+	return &Handler{
+		log:    ctrl.Log.WithName("admin"),
+		client: c,
+	}
+}
+
+// ServeHTTP lists the active TrainJobs, ordered by namespace and name,
+// with their resolved runtime and the summary of the rendered JobSet.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeStatus(w, h.log, "Method not allowed", metav1.StatusReasonMethodNotAllowed, http.StatusMethodNotAllowed)
+		return
+	}
+	userInfo, err := authenticate(r.Context(), h.client, r)
+	if err != nil {
+		h.log.Error(err, "Failed to review the bearer token")
+		writeStatus(w, h.log, "Internal error", metav1.StatusReasonInternalError, http.StatusInternalServerError)
+		return
+	}
+	if userInfo == nil {
+		writeStatus(w, h.log, "Unauthorized", metav1.StatusReasonUnauthorized, http.StatusUnauthorized)
+		return
+	}
+	allowed, err := authorize(r.Context(), h.client, userInfo, "list", metav1.NamespaceAll)
+	if err != nil {
+		h.log.Error(err, "Failed to review the access", "user", userInfo.Username)
+		writeStatus(w, h.log, "Internal error", metav1.StatusReasonInternalError, http.StatusInternalServerError)
+		return
+	}
+	if !allowed {
+		writeStatus(w, h.log, fmt.Sprintf("User %q cannot list TrainJobs in all the namespaces", userInfo.Username),
+			metav1.StatusReasonForbidden, http.StatusForbidden)
+		return
+	}
+
+	var trainJobs trainer.TrainJobList
+	if err := h.client.List(r.Context(), &trainJobs); err != nil {
+		h.log.Error(err, "Failed to list TrainJobs")
+		writeStatus(w, h.log, "Internal error", metav1.StatusReasonInternalError, http.StatusInternalServerError)
+		return
+	}
+
+	list := TrainJobList{Items: []TrainJob{}}
+	for i := range trainJobs.Items {
+		trainJob := &trainJobs.Items[i]
+		if trainjob.IsTrainJobFinished(trainJob) {
+			continue
+		}
+		item := TrainJob{
+			Namespace:  trainJob.Namespace,
+			Name:       trainJob.Name,
+			RuntimeRef: trainJob.Spec.RuntimeRef,
+			Suspended:  ptr.Deref(trainJob.Spec.Suspend, false),
+		}
+		runtime, err := h.resolveRuntime(r.Context(), trainJob)
+		if err != nil {
+			h.log.Error(err, "Failed to get the runtime", "namespace", trainJob.Namespace, "name", trainJob.Name)
+			writeStatus(w, h.log, "Internal error", metav1.StatusReasonInternalError, http.StatusInternalServerError)
+			return
+		}
+		item.Runtime = runtime
+		jobSet := &jobsetv1alpha2.JobSet{}
+		if err := h.client.Get(r.Context(), client.ObjectKeyFromObject(trainJob), jobSet); err != nil {
+			if !apierrors.IsNotFound(err) {
+				h.log.Error(err, "Failed to get JobSet", "namespace", trainJob.Namespace, "name", trainJob.Name)
+				writeStatus(w, h.log, "Internal error", metav1.StatusReasonInternalError, http.StatusInternalServerError)
+				return
+			}
+		} else {
+			item.JobSet = jobSetSummary(jobSet)
+		}
+		list.Items = append(list.Items, item)
+	}
+	slices.SortFunc(list.Items, func(a, b TrainJob) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		h.log.Error(err, "Failed to write TrainJobs")
+	}
+}
+
+// resolveRuntime gets the TrainingRuntime or ClusterTrainingRuntime referenced by the TrainJob,
+// and returns nil if the runtime does not exist or has an unsupported kind.
+func (h *Handler) resolveRuntime(ctx context.Context, trainJob *trainer.TrainJob) (*Runtime, error) {
+	runtimeRef := trainJob.Spec.RuntimeRef
+	kind := ptr.Deref(runtimeRef.Kind, trainer.ClusterTrainingRuntimeKind)
+	var (
+		runtime client.Object
+		spec    *trainer.TrainingRuntimeSpec
+	)
+	switch kind {
+	case trainer.TrainingRuntimeKind:
+		trainingRuntime := &trainer.TrainingRuntime{}
+		runtime, spec = trainingRuntime, &trainingRuntime.Spec
+		if err := h.client.Get(ctx, client.ObjectKey{Namespace: trainJob.Namespace, Name: runtimeRef.Name}, trainingRuntime); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+	case trainer.ClusterTrainingRuntimeKind:
+		clusterTrainingRuntime := &trainer.ClusterTrainingRuntime{}
+		runtime, spec = clusterTrainingRuntime, &clusterTrainingRuntime.Spec
+		if err := h.client.Get(ctx, client.ObjectKey{Name: runtimeRef.Name}, clusterTrainingRuntime); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+	default:
+		return nil, nil
+	}
+	return &Runtime{
+		Kind:      kind,
+		Namespace: runtime.GetNamespace(),
+		Name:      runtime.GetName(),
+		MLPolicy:  spec.MLPolicy,
+	}, nil
+}
+
+func jobSetSummary(jobSet *jobsetv1alpha2.JobSet) *JobSet {
+	summary := &JobSet{ReplicatedJobs: []ReplicatedJob{}}
+	for _, rJob := range jobSet.Spec.ReplicatedJobs {
+		images := []string{}
+		for _, container := range rJob.Template.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+		summary.ReplicatedJobs = append(summary.ReplicatedJobs, ReplicatedJob{
+			Name:        rJob.Name,
+			Replicas:    rJob.Replicas,
+			Parallelism: ptr.Deref(rJob.Template.Spec.Parallelism, 1),
+			Images:      images,
+		})
+	}
+	return summary
+}
+
+// writeStatus sends a kubernetes Status response with the error message.
+func writeStatus(w http.ResponseWriter, log logr.Logger, message string, reason metav1.StatusReason, code int32) {
+	status := metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: message,
+		Reason:  reason,
+		Code:    code,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(code))
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		log.Error(err, "Failed to write error details")
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adminserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestHandler(t *testing.T) {
+	runningJob := utiltesting.MakeTrainJobWrapper("team-a", "running").
+		RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "torch-distributed").
+		Suspend(false).
+		Obj()
+	suspendedJob := utiltesting.MakeTrainJobWrapper("team-a", "suspended").
+		RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "custom").
+		Suspend(true).
+		Obj()
+	completedJob := utiltesting.MakeTrainJobWrapper("team-a", "completed").
+		RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "torch-distributed").
+		Obj()
+	completedJob.Status.Conditions = []metav1.Condition{{
+		Type:   trainer.TrainJobComplete,
+		Status: metav1.ConditionTrue,
+	}}
+	runningJobSet := utiltesting.MakeJobSetWrapper("team-a", "running").
+		Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
+		NumNodes(2).
+		Container(constants.DatasetInitializer, constants.DatasetInitializer, "docker.io/dataset-initializer:latest", nil, nil, nil).
+		Container(constants.ModelInitializer, constants.ModelInitializer, "docker.io/model-initializer:latest", nil, nil, nil).
+		Container(constants.Node, constants.Node, "docker.io/trainer:latest", nil, nil, nil).
+		Obj()
+	clusterTrainingRuntime := utiltesting.MakeClusterTrainingRuntimeWrapper("torch-distributed").Obj()
+	clusterTrainingRuntime.Spec.MLPolicy = &trainer.MLPolicy{NumNodes: ptr.To[int32](2)}
+
+	cases := map[string]struct {
+		method          string
+		token           string
+		allowedUsers    []string
+		objs            []client.Object
+		wantCode        int
+		wantResponse    *TrainJobList
+		wantStatus      *metav1.Status
+		wantReviewedSAR *authorizationv1.ResourceAttributes
+	}{
+		"no TrainJobs": {
+			method:       http.MethodGet,
+			token:        "alice-token",
+			allowedUsers: []string{"alice"},
+			wantCode:     http.StatusOK,
+			wantResponse: &TrainJobList{Items: []TrainJob{}},
+			wantReviewedSAR: &authorizationv1.ResourceAttributes{
+				Verb:     "list",
+				Group:    trainer.GroupVersion.Group,
+				Resource: "trainjobs",
+			},
+		},
+		"active TrainJobs with and without the resolved runtime and the rendered JobSet": {
+			method:       http.MethodGet,
+			token:        "alice-token",
+			allowedUsers: []string{"alice"},
+			objs:         []client.Object{suspendedJob, runningJob, completedJob, runningJobSet, clusterTrainingRuntime},
+			wantCode:     http.StatusOK,
+			wantResponse: &TrainJobList{
+				Items: []TrainJob{
+					{
+						Namespace:  "team-a",
+						Name:       "running",
+						RuntimeRef: runningJob.Spec.RuntimeRef,
+						Runtime: &Runtime{
+							Kind:     trainer.ClusterTrainingRuntimeKind,
+							Name:     "torch-distributed",
+							MLPolicy: &trainer.MLPolicy{NumNodes: ptr.To[int32](2)},
+						},
+						JobSet: &JobSet{
+							ReplicatedJobs: []ReplicatedJob{
+								{Name: constants.DatasetInitializer, Replicas: 1, Parallelism: 1, Images: []string{"docker.io/dataset-initializer:latest"}},
+								{Name: constants.ModelInitializer, Replicas: 1, Parallelism: 1, Images: []string{"docker.io/model-initializer:latest"}},
+								{Name: constants.Node, Replicas: 1, Parallelism: 2, Images: []string{"docker.io/trainer:latest"}},
+							},
+						},
+					},
+					{
+						Namespace:  "team-a",
+						Name:       "suspended",
+						RuntimeRef: suspendedJob.Spec.RuntimeRef,
+						Suspended:  true,
+					},
+				},
+			},
+			wantReviewedSAR: &authorizationv1.ResourceAttributes{
+				Verb:     "list",
+				Group:    trainer.GroupVersion.Group,
+				Resource: "trainjobs",
+			},
+		},
+		"TrainJob referencing the TrainingRuntime in its namespace": {
+			method:       http.MethodGet,
+			token:        "alice-token",
+			allowedUsers: []string{"alice"},
+			objs: []client.Object{
+				suspendedJob,
+				utiltesting.MakeTrainingRuntimeWrapper("team-a", "custom").Obj(),
+				utiltesting.MakeTrainingRuntimeWrapper("team-b", "custom").Obj(),
+			},
+			wantCode: http.StatusOK,
+			wantResponse: &TrainJobList{
+				Items: []TrainJob{
+					{
+						Namespace:  "team-a",
+						Name:       "suspended",
+						RuntimeRef: suspendedJob.Spec.RuntimeRef,
+						Suspended:  true,
+						Runtime: &Runtime{
+							Kind:      trainer.TrainingRuntimeKind,
+							Namespace: "team-a",
+							Name:      "custom",
+							MLPolicy:  utiltesting.MakeTrainingRuntimeWrapper("team-a", "custom").Obj().Spec.MLPolicy,
+						},
+					},
+				},
+			},
+			wantReviewedSAR: &authorizationv1.ResourceAttributes{
+				Verb:     "list",
+				Group:    trainer.GroupVersion.Group,
+				Resource: "trainjobs",
+			},
+		},
+		"user not allowed to list TrainJobs in all the namespaces fails with 403 forbidden": {
+			method:   http.MethodGet,
+			token:    "alice-token",
+			objs:     []client.Object{runningJob},
+			wantCode: http.StatusForbidden,
+			wantStatus: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: `User "alice" cannot list TrainJobs in all the namespaces`,
+				Reason:  metav1.StatusReasonForbidden,
+				Code:    http.StatusForbidden,
+			},
+		},
+		"request without the bearer token fails with 401 unauthorized": {
+			method:       http.MethodGet,
+			allowedUsers: []string{"alice"},
+			objs:         []client.Object{runningJob},
+			wantCode:     http.StatusUnauthorized,
+			wantStatus: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: "Unauthorized",
+				Reason:  metav1.StatusReasonUnauthorized,
+				Code:    http.StatusUnauthorized,
+			},
+		},
+		"request with the invalid bearer token fails with 401 unauthorized": {
+			method:       http.MethodGet,
+			token:        "invalid-token",
+			allowedUsers: []string{"alice"},
+			objs:         []client.Object{runningJob},
+			wantCode:     http.StatusUnauthorized,
+			wantStatus: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: "Unauthorized",
+				Reason:  metav1.StatusReasonUnauthorized,
+				Code:    http.StatusUnauthorized,
+			},
+		},
+		"non-GET request fails with 405 method not allowed": {
+			method:   http.MethodPost,
+			wantCode: http.StatusMethodNotAllowed,
+			wantStatus: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: "Method not allowed",
+				Reason:  metav1.StatusReasonMethodNotAllowed,
+				Code:    http.StatusMethodNotAllowed,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotReviewedSAR *authorizationv1.ResourceAttributes
+			cli := utiltesting.NewClientBuilder().
+				WithObjects(tc.objs...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						switch review := obj.(type) {
+						case *authenticationv1.TokenReview:
+							if review.Spec.Token == "alice-token" {
+								review.Status.Authenticated = true
+								review.Status.User = authenticationv1.UserInfo{Username: "alice"}
+							}
+							return nil
+						case *authorizationv1.SubjectAccessReview:
+							gotReviewedSAR = review.Spec.ResourceAttributes
+							for _, user := range tc.allowedUsers {
+								review.Status.Allowed = review.Status.Allowed || review.Spec.User == user
+							}
+							return nil
+						}
+						return c.Create(ctx, obj, opts...)
+					},
+				}).
+				Build()
+			ts := httptest.NewServer(NewHandler(cli))
+			t.Cleanup(ts.Close)
+
+			req, err := http.NewRequest(tc.method, ts.URL+TrainJobsPath, nil)
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			if len(tc.token) != 0 {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("HTTP request failed: %v", err)
+			}
+			t.Cleanup(func() { _ = resp.Body.Close() })
+
+			if resp.StatusCode != tc.wantCode {
+				t.Errorf("status = %v, want %v", resp.StatusCode, tc.wantCode)
+			}
+			if tc.wantStatus != nil {
+				var got metav1.Status
+				if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				if diff := cmp.Diff(tc.wantStatus, &got); len(diff) != 0 {
+					t.Errorf("Unexpected response (-want,+got):\n%s", diff)
+				}
+				return
+			}
+			var got TrainJobList
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResponse, &got); len(diff) != 0 {
+				t.Errorf("Unexpected response (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantReviewedSAR, gotReviewedSAR); len(diff) != 0 {
+				t.Errorf("Unexpected SubjectAccessReview attributes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adminserver
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
+)
+
+// SetupServer serves the admin endpoint on the metrics server, so that it shares its secure serving.
+// The TrainJobs, runtimes and JobSets are listed from the manager cache for the callers allowed to list
+// the TrainJobs in all the namespaces, and the TrainJobs are rendered with the runtimes for the callers
+// allowed to create them.
+func SetupServer(mgr ctrl.Manager, runtimes map[string]jobruntimes.Runtime) error {
+	if err := mgr.AddMetricsServerExtraHandler(TrainJobsPath, NewHandler(mgr.GetClient())); err != nil {
+		return err
+	}
+	return mgr.AddMetricsServerExtraHandler(RenderPath, NewRenderHandler(mgr.GetClient(), runtimes))
+}
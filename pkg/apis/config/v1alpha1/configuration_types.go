@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -64,6 +65,50 @@ type Configuration struct {
 	// +optional
 	StatusServer *StatusServer `json:"statusServer,omitempty"`
 
+	// resources provides the default resource configuration for the trainer and initializer containers.
+	// +optional
+	Resources *Resources `json:"resources,omitempty"`
+
+	// gpuEnv provides the default environment variables for the trainer containers requesting GPUs.
+	// +optional
+	GPUEnv *GPUEnv `json:"gpuEnv,omitempty"`
+
+	// pipInstall enables the init container installing the TrainJob trainer pipPackages.
+	// The pipPackages are rejected when unset, since the init container requires network access.
+	// +optional
+	PipInstall *PipInstall `json:"pipInstall,omitempty"`
+
+	// rendezvousWait enables the init container waiting for the rank-0 trainer node hostname
+	// to be resolvable before the multi-node Torch trainer starts the rendezvous.
+	// +optional
+	RendezvousWait *RendezvousWait `json:"rendezvousWait,omitempty"`
+
+	// defaultNumProcPerNode is the number of processes per node of the Torch trainer with numProcPerNode auto
+	// or cpu, when the trainer resources request neither GPUs nor CPUs to derive the number of processes from.
+	// Defaults to 1.
+	// +optional
+	DefaultNumProcPerNode *int32 `json:"defaultNumProcPerNode,omitempty"`
+
+	// trainerPodLabels are the labels applied to the trainer Pods, e.g. to target them with NetworkPolicies.
+	// These labels take precedence over the labels with the same keys defined in the runtime.
+	// +optional
+	TrainerPodLabels map[string]string `json:"trainerPodLabels,omitempty"`
+
+	// proxy provides the proxy environment variables injected into all the initializer and trainer containers,
+	// e.g. when the cluster is behind a corporate proxy.
+	// +optional
+	Proxy *Proxy `json:"proxy,omitempty"`
+
+	// generatedSecrets provides the configuration for the Secrets generated for the TrainJobs,
+	// e.g. the MPI and DeepSpeed SSH auth Secrets and the Flux curve Secret.
+	// +optional
+	GeneratedSecrets *GeneratedSecrets `json:"generatedSecrets,omitempty"`
+
+	// retryBackoff provides the exponential backoff of the TrainJob reconciliation retries
+	// after the transient API server errors creating or updating the TrainJob resources, e.g. the JobSet.
+	// +optional
+	RetryBackoff *RetryBackoff `json:"retryBackoff,omitempty"`
+
 	// featureGates is a map of feature names to bools that allows to override the
 	// default enablement status of a feature.
 	// +optional
@@ -72,6 +117,14 @@ type Configuration struct {
 	// tls contains TLS configuration for the controller manager servers.
 	// +optional
 	TLS *TLSOptions `json:"tls,omitempty"`
+
+	// plugins configures the runtime framework plugins run for the TrainingRuntimes and ClusterTrainingRuntimes.
+	// +optional
+	Plugins *Plugins `json:"plugins,omitempty"`
+
+	// progress provides the configuration for the progress reporting of the trainer to the status server.
+	// +optional
+	Progress *Progress `json:"progress,omitempty"`
 }
 
 // ControllerWebhook defines the webhook server for the controller.
@@ -110,6 +163,22 @@ type ControllerMetrics struct {
 	// +optional
 	// +kubebuilder:default=true
 	SecureServing *bool `json:"secureServing,omitempty"`
+
+	// enableAdminEndpoint enables the read-only /admin/trainjobs endpoint on the metrics server,
+	// which lists the active TrainJobs with their runtime and the summary of the rendered JobSet,
+	// for example for the dashboards, and the /admin/trainjobs/render endpoint previewing the JobSet
+	// and the other objects a TrainJob would create without persisting them. The render endpoint is only
+	// served to the callers allowed to create the TrainJob in its namespace, and the Secret data is redacted.
+	// It requires secureServing.
+	// Defaults to false.
+	// +optional
+	EnableAdminEndpoint *bool `json:"enableAdminEndpoint,omitempty"`
+
+	// reconcileLatencyBuckets are the upper bounds in seconds of the TrainJob reconcile duration
+	// histogram buckets, which must be positive and strictly increasing.
+	// Defaults to the controller-runtime reconcile time buckets.
+	// +optional
+	ReconcileLatencyBuckets []float64 `json:"reconcileLatencyBuckets,omitempty"`
 }
 
 // ControllerHealth defines the health configs.
@@ -227,6 +296,112 @@ type StatusServer struct {
 	Burst *int32 `json:"burst,omitempty"`
 }
 
+// Progress defines the configuration for the progress reporting of the trainer to the status server.
+type Progress struct {
+	// tokenExpirySeconds is the expiration of the projected service account token
+	// used by the trainer to report the progress. It must be at least 600 seconds.
+	// Defaults to 3600.
+	// +optional
+	// +kubebuilder:default=3600
+	TokenExpirySeconds *int64 `json:"tokenExpirySeconds,omitempty"`
+}
+
+// GPUEnv defines the default environment variables for the trainer containers requesting GPUs.
+// The environment variables are only set when they are not already configured in the runtime or the TrainJob.
+type GPUEnv struct {
+	// visibleDevices is the default value of the NVIDIA_VISIBLE_DEVICES environment variable.
+	// Defaults to empty, which means that the environment variable is not set.
+	// +optional
+	VisibleDevices *string `json:"visibleDevices,omitempty"`
+
+	// driverCapabilities is the default value of the NVIDIA_DRIVER_CAPABILITIES environment variable.
+	// Defaults to empty, which means that the environment variable is not set.
+	// +optional
+	DriverCapabilities *string `json:"driverCapabilities,omitempty"`
+}
+
+// Proxy defines the proxy environment variables for the initializer and trainer containers.
+// The environment variables are only set when they are not already configured in the runtime or the TrainJob.
+type Proxy struct {
+	// httpProxy is the value of the HTTP_PROXY environment variable.
+	// Defaults to empty, which means that the environment variable is not set.
+	// +optional
+	HTTPProxy *string `json:"httpProxy,omitempty"`
+
+	// httpsProxy is the value of the HTTPS_PROXY environment variable.
+	// Defaults to empty, which means that the environment variable is not set.
+	// +optional
+	HTTPSProxy *string `json:"httpsProxy,omitempty"`
+
+	// noProxy is the value of the NO_PROXY environment variable.
+	// Defaults to empty, which means that the environment variable is not set.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+}
+
+// PipInstall defines the init container installing the extra pip packages for the trainer.
+type PipInstall struct {
+	// image is the container image of the init container running `pip install`.
+	// +required
+	Image string `json:"image"`
+}
+
+// RendezvousWait defines the init container waiting for the DNS propagation of the rank-0 trainer node.
+type RendezvousWait struct {
+	// image is the container image of the init container, which must provide `sh` and `nslookup`.
+	// +required
+	Image string `json:"image"`
+
+	// maxRetries is the maximum number of DNS lookups of the rank-0 hostname, performed every second.
+	// The init container fails once the retries are exhausted.
+	// Defaults to 60.
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+// GeneratedSecrets defines the lifecycle of the Secrets generated for the TrainJobs.
+// The generated Secrets are always owned by the TrainJob, so they are garbage collected
+// once the TrainJob is deleted.
+type GeneratedSecrets struct {
+	// ttl is the duration after which the generated Secrets can be removed by external Secret cleaners,
+	// for compliance policies requiring the credentials to be short-lived.
+	// The ttl is recorded in the trainer.kubeflow.org/secret-ttl annotation of the generated Secrets.
+	// Defaults to empty, which means that the annotation is not set.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// RetryBackoff defines the exponential backoff of the TrainJob reconciliation retries.
+// The delay doubles with every consecutive transient error, from baseDelay up to maxDelay,
+// and is reset once the TrainJob resources are reconciled successfully.
+type RetryBackoff struct {
+	// baseDelay is the delay before the first retry. It must be greater than 0.
+	// Defaults to 1s.
+	// +optional
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+
+	// maxDelay is the maximum delay between the retries. It must be greater than or equal to baseDelay.
+	// Defaults to 5m.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+}
+
+// Resources defines the default resource configuration for the trainer and initializer containers.
+type Resources struct {
+	// limitRequestRatio is the ratio used to derive the container resource limits from
+	// the requests when the limits are not set, i.e. limits = requests * ratio.
+	// Only cpu and memory are supported, and the ratio must be greater than or equal to 1.
+	// Defaults to empty, which means that the limits are not derived.
+	// +optional
+	LimitRequestRatio corev1.ResourceList `json:"limitRequestRatio,omitempty"`
+
+	// gpuSharing allows the fractional GPU requests and limits in the TrainJob trainer resources,
+	// e.g. when the GPUs are shared between the containers by the device plugin.
+	// Defaults to false, which means that the GPU resources must be whole GPUs.
+	// +optional
+	GPUSharing *bool `json:"gpuSharing,omitempty"`
+}
+
 const (
 	// TLSVersion10 is the TLS 1.0 version string.
 	TLSVersion10 = "1.0"
@@ -261,3 +436,19 @@ type TLSOptions struct {
 	// +kubebuilder:validation:items:MaxLength=32
 	NextProtos []string `json:"nextProtos,omitempty"`
 }
+
+// Plugins defines the runtime framework plugins to run.
+type Plugins struct {
+	// enabled is the list of the plugin names to run, for example Torch.
+	// Defaults to empty, which means that all the registered plugins run.
+	// When set, it must contain the JobSet plugin.
+	// +optional
+	// +listType=set
+	Enabled []string `json:"enabled,omitempty"`
+
+	// disabled is the list of the plugin names not to run, for example TrainJobStatus.
+	// It takes precedence over enabled. The JobSet plugin can not be disabled.
+	// +optional
+	// +listType=set
+	Disabled []string `json:"disabled,omitempty"`
+}
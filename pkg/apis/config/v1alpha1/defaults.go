@@ -73,4 +73,13 @@ func SetDefaults_Configuration(cfg *Configuration) {
 	if cfg.StatusServer.Burst == nil {
 		cfg.StatusServer.Burst = ptr.To[int32](10)
 	}
+	if cfg.Progress == nil {
+		cfg.Progress = &Progress{}
+	}
+	if cfg.Progress.TokenExpirySeconds == nil {
+		cfg.Progress.TokenExpirySeconds = ptr.To[int64](3600)
+	}
+	if cfg.RendezvousWait != nil && cfg.RendezvousWait.MaxRetries == nil {
+		cfg.RendezvousWait.MaxRetries = ptr.To[int32](60)
+	}
 }
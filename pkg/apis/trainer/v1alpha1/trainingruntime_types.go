@@ -17,7 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
@@ -159,6 +161,13 @@ type CoschedulingPodGroupPolicySource struct {
 	// +kubebuilder:default=60
 	// +optional
 	ScheduleTimeoutSeconds *int32 `json:"scheduleTimeoutSeconds,omitempty"`
+
+	// queue is the name of the scheduler queue for the gang-scheduling.
+	// It is set as the scheduling.x-k8s.io/queue-name label on the PodGroup and the TrainJob Pods.
+	// Defaults to empty, which means that no queue label is set.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Queue string `json:"queue,omitempty"`
 }
 
 // VolcanoPodGroupPolicySource represents configuration for the Volcano gang-scheduler.
@@ -169,18 +178,63 @@ type VolcanoPodGroupPolicySource struct {
 }
 
 // MLPolicy represents configuration for the model training with ML-specific parameters.
-// +kubebuilder:validation:XValidation:rule="[has(self.torch), has(self.mpi), has(self.jax), has(self.xgboost),has(self.flux)].filter(x, x).size() <= 1", message="Only one of the policy can be configured"
+// +kubebuilder:validation:XValidation:rule="[has(self.torch), has(self.mpi), has(self.jax), has(self.xgboost),has(self.flux), has(self.deepspeed), has(self.tensorflow), has(self.ray)].filter(x, x).size() <= 1", message="Only one of the policy can be configured"
+// +kubebuilder:validation:XValidation:rule="!has(self.horovod) || (has(self.mpi) && (!has(self.mpi.mpiImplementation) || self.mpi.mpiImplementation == 'OpenMPI'))", message="horovod must be configured together with mpi of the OpenMPI implementation"
 type MLPolicy struct {
 	// numNodes is the number of training nodes.
 	// Defaults to 1.
 	// +optional
 	NumNodes *int32 `json:"numNodes,omitempty"`
 
+	// trainerPort is the port for the trainer nodes communication, for example the PyTorch master port
+	// or the XGBoost tracker port. It can be changed to avoid the port collisions on the nodes with host networking.
+	// Defaults to 29500.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	TrainerPort *int32 `json:"trainerPort,omitempty"`
+
+	// trainerPortName is the name of the trainer port in the trainer node container,
+	// so that it can be referenced by name, for example from the Service or the probes.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	TrainerPortName *string `json:"trainerPortName,omitempty"`
+
+	// additionalPorts are the named ports exposed by the trainer node container in addition to the trainer port,
+	// for example the metrics port.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	AdditionalPorts []NamedPort `json:"additionalPorts,omitempty"`
+
+	// singleProcessPerNode declares that the runtime only supports a single training process per node,
+	// for example the legacy frameworks, so the TrainJob can not set numProcPerNode other than 1.
+	// Defaults to false.
+	// +optional
+	SingleProcessPerNode *bool `json:"singleProcessPerNode,omitempty"`
+
 	// Configuration for the runtime-specific parameters, such as Torch, Flux, or MPI.
 	// Only one of its members may be specified.
 	MLPolicySource `json:",inline"`
 }
 
+// NamedPort represents the named port exposed by the trainer node container.
+type NamedPort struct {
+	// name is the name of the port, which must be unique in the trainer node container.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// containerPort is the number of the port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +required
+	ContainerPort int32 `json:"containerPort"`
+}
+
 // MLPolicySource represents the runtime-specific configuration for various technologies.
 // One of the following specs can be set.
 type MLPolicySource struct {
@@ -203,9 +257,31 @@ type MLPolicySource struct {
 	// xgboost defines the configuration for the XGBoost Runtime.
 	// +optional
 	XGBoost *XGBoostMLPolicySource `json:"xgboost,omitempty"`
+
+	// deepspeed defines the configuration for the DeepSpeed runtime.
+	// +optional
+	DeepSpeed *DeepSpeedMLPolicySource `json:"deepspeed,omitempty"`
+
+	// tensorflow defines the configuration for the TensorFlow runtime.
+	// +optional
+	TensorFlow *TensorFlowMLPolicySource `json:"tensorflow,omitempty"`
+
+	// ray defines the configuration for the Ray runtime.
+	// +optional
+	Ray *RayMLPolicySource `json:"ray,omitempty"`
+
+	// horovod defines the configuration for the Horovod runtime.
+	// It must be configured together with mpi of the OpenMPI implementation, which provides
+	// the SSH keys and the hostfile.
+	// +optional
+	Horovod *HorovodMLPolicySource `json:"horovod,omitempty"`
 }
 
 // TorchMLPolicySource represents a PyTorch runtime configuration.
+// +kubebuilder:validation:XValidation:rule="has(self.minNodes) == has(self.maxNodes)", message="minNodes and maxNodes must be set together"
+// +kubebuilder:validation:XValidation:rule="!has(self.minNodes) || !has(self.maxNodes) || self.minNodes <= self.maxNodes", message="minNodes must be less than or equal to maxNodes"
+// +kubebuilder:validation:XValidation:rule="!has(self.rdzvBackend) || !(self.rdzvBackend in ['etcd', 'etcd-v2']) || (has(self.rdzvEndpoint) && size(self.rdzvEndpoint) > 0)", message="rdzvEndpoint must be set when rdzvBackend is etcd or etcd-v2"
+// +kubebuilder:validation:XValidation:rule="!has(self.rdzvBackend) || self.rdzvBackend != 'static' || !has(self.minNodes)", message="minNodes and maxNodes can not be set when rdzvBackend is static"
 type TorchMLPolicySource struct {
 	// envInjection configures which additional containers should receive the
 	// PET_* environment variables. By default, the PET_* variables are injected
@@ -216,8 +292,74 @@ type TorchMLPolicySource struct {
 	// Defaults to empty (main container only).
 	// +optional
 	EnvInjection *EnvInjection `json:"envInjection,omitempty"`
+
+	// numProcPerNode is the number of processes per node, which is set to PET_NPROC_PER_NODE.
+	// It can be a positive integer or one of "auto", "cpu", "gpu", and "memory". The "cpu" and "gpu" modes resolve
+	// to the number of CPUs and GPUs requested by the trainer container, defaulting to 1 when none are requested.
+	// The GPUs of any vendor are counted, for example nvidia.com/gpu or amd.com/gpu.
+	// The "memory" mode resolves to the memory requested by the trainer container divided by memoryPerProcess.
+	// The numProcPerNode of the TrainJob trainer takes precedence.
+	// Defaults to "auto".
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:XValidation:rule="type(self) == int ? self > 0 : self in ['auto', 'cpu', 'gpu', 'memory']", message="must be a positive integer or one of auto, cpu, gpu, memory"
+	// +optional
+	NumProcPerNode *intstr.IntOrString `json:"numProcPerNode,omitempty"`
+
+	// memoryPerProcess is the memory budget of each training process for the "memory" numProcPerNode mode.
+	// The number of processes per node is the memory requested by the trainer container divided by this budget,
+	// defaulting to 1 when the memory is not requested or the budget is not set.
+	// +optional
+	MemoryPerProcess *resource.Quantity `json:"memoryPerProcess,omitempty"`
+
+	// numProcPerNodeLabel is the Node label holding the number of processes per node,
+	// for example for the clusters labeling the nodes with their GPU count.
+	// The label of the Node which the trainer Pod is scheduled to is copied to the trainer Pod label,
+	// which is set to PET_NPROC_PER_NODE through the downward API.
+	// It takes precedence over numProcPerNode, while the numProcPerNode of the TrainJob trainer takes precedence over it.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	// +optional
+	NumProcPerNodeLabel *string `json:"numProcPerNodeLabel,omitempty"`
+
+	// minNodes is the minimum number of nodes for the PyTorch elastic training.
+	// When minNodes and maxNodes are set, torchrun uses the c10d rendezvous backend
+	// and the training proceeds with any number of nodes within the range.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinNodes *int32 `json:"minNodes,omitempty"`
+
+	// maxNodes is the maximum number of nodes for the PyTorch elastic training.
+	// It must be set together with minNodes.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxNodes *int32 `json:"maxNodes,omitempty"`
+
+	// rdzvBackend is the torchrun rendezvous backend.
+	// The static backend uses the rank-0 node as the master address, while the other backends
+	// use the rendezvous endpoint and the TrainJob UID as the rendezvous id.
+	// Defaults to c10d when minNodes and maxNodes are set, otherwise to static.
+	// +kubebuilder:validation:Enum=static;c10d;etcd;etcd-v2
+	// +optional
+	RdzvBackend *RdzvBackend `json:"rdzvBackend,omitempty"`
+
+	// rdzvEndpoint is the rendezvous endpoint in the host:port format.
+	// It is required for the etcd and etcd-v2 backends.
+	// Defaults to the rank-0 node address for the c10d backend.
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	RdzvEndpoint *string `json:"rdzvEndpoint,omitempty"`
 }
 
+// RdzvBackend represents one of the supported torchrun rendezvous backends.
+type RdzvBackend string
+
+const (
+	RdzvBackendStatic RdzvBackend = "static"
+	RdzvBackendC10d   RdzvBackend = "c10d"
+	RdzvBackendEtcd   RdzvBackend = "etcd"
+	RdzvBackendEtcdV2 RdzvBackend = "etcd-v2"
+)
+
 // EnvInjection specifies which containers in which jobs receive framework env injection.
 // Defined as a standalone type so it can be embedded by other MLPolicySource
 // variants in the future.
@@ -259,6 +401,28 @@ type EnvInjectionTarget struct {
 // JAXMLPolicySource represents a jax runtime configuration.
 type JAXMLPolicySource struct{}
 
+// TensorFlowMLPolicySource represents a TensorFlow runtime configuration.
+// The TF_CONFIG env is constructed for the MultiWorkerMirroredStrategy, where every node
+// is a worker and the worker with the completion index 0 acts as the chief.
+type TensorFlowMLPolicySource struct{}
+
+// RayMLPolicySource represents a Ray runtime configuration.
+// The node with the completion index 0 starts the Ray head and runs the training entrypoint,
+// and the other nodes join the Ray cluster as the workers.
+type RayMLPolicySource struct{}
+
+// HorovodMLPolicySource represents a Horovod runtime configuration.
+// It is layered on the MPI policy: the launcher runs the training command with horovodrun
+// over the MPI hostfile, and the nodes are reached over the MPI SSH keys.
+type HorovodMLPolicySource struct {
+	// glooIface is the network interface used by the Gloo controller for the communication
+	// between the training processes, for example eth0. It is set to HOROVOD_GLOO_IFACE.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	// +optional
+	GlooIface *string `json:"glooIface,omitempty"`
+}
+
 // XGBoostMLPolicySource represents an XGBoost runtime configuration.
 // The number of workers per node is automatically derived from container GPU resources:
 //   - GPU training: 1 worker per GPU (from resourcesPerNode)
@@ -266,7 +430,15 @@ type JAXMLPolicySource struct{}
 //     via XGBoost's multi-threaded execution, controlled by the nthread parameter)
 //
 // DMLC_NUM_WORKER = numNodes × workersPerNode (where workersPerNode = GPU count or 1)
-type XGBoostMLPolicySource struct{}
+type XGBoostMLPolicySource struct {
+	// numServers is the number of parameter servers for the XGBoost parameter-server topology.
+	// When it is greater than 0, the server PodSet is created from the trainer Job template
+	// unless the runtime already defines the server Job, and the DMLC_NUM_SERVER and DMLC_ROLE envs are set.
+	// Defaults to 0, which means that the parameter-server topology is disabled.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	NumServers *int32 `json:"numServers,omitempty"`
+}
 
 // MPIMLPolicySource represents a MPI runtime configuration.
 type MPIMLPolicySource struct {
@@ -280,7 +452,7 @@ type MPIMLPolicySource struct {
 	// mpiImplementation is the name of the MPI implementation to create the appropriate hostfile.
 	// Defaults to OpenMPI.
 	// +kubebuilder:default=OpenMPI
-	// +kubebuilder:validation:Enum=OpenMPI;""
+	// +kubebuilder:validation:Enum=OpenMPI;Intel;MPICH;""
 	// +optional
 	MPIImplementation *MPIImplementation `json:"mpiImplementation,omitempty"`
 
@@ -296,6 +468,40 @@ type MPIMLPolicySource struct {
 	// +kubebuilder:default=false
 	// +optional
 	RunLauncherAsNode *bool `json:"runLauncherAsNode,omitempty"`
+
+	// launcherBinary is the binary starting the MPI processes on the launcher, for example mpirun or mpiexec.
+	// When set, it replaces the first element of the launcher container command in the runtime,
+	// while the command of the TrainJob trainer is used as is.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	// +optional
+	LauncherBinary *string `json:"launcherBinary,omitempty"`
+
+	// mountHostfileOnNodes defines whether to mount the generated hostfile on the node Jobs as well as the launcher Job,
+	// for the MPI setups where the training processes on the nodes read the hostfile.
+	// Defaults to false.
+	// +optional
+	MountHostfileOnNodes *bool `json:"mountHostfileOnNodes,omitempty"`
+}
+
+// DeepSpeedMLPolicySource represents a DeepSpeed runtime configuration.
+// The launcher Job runs `deepspeed --hostfile` which starts the training processes
+// on the node Jobs over SSH.
+type DeepSpeedMLPolicySource struct {
+	// numProcPerNode is the number of processes per node.
+	// This value is equal to the number of slots for each node in the hostfile.
+	// Defaults to 1.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NumProcPerNode *int32 `json:"numProcPerNode,omitempty"`
+
+	// sshAuthMountPath is the directory where SSH keys are mounted.
+	// Defaults to /root/.ssh.
+	// +kubebuilder:default=/root/.ssh
+	// +kubebuilder:validation:MaxLength=4096
+	// +optional
+	SSHAuthMountPath *string `json:"sshAuthMountPath,omitempty"`
 }
 
 // FluxMLPolicySource represents a Flux HPC runtime configuration.
@@ -305,6 +511,38 @@ type FluxMLPolicySource struct {
 	// +kubebuilder:validation:XValidation:rule="self >= 1",message="NumProcPerNode in fluxPolicy must be >= 1"
 	// +optional
 	NumProcPerNode *int32 `json:"numProcPerNode,omitempty"`
+
+	// viewImage is the container image of the Flux view installed into the trainer nodes by the init container.
+	// The OS and version of the view image must match the trainer image.
+	// Defaults to ghcr.io/converged-computing/flux-view-ubuntu:tag-jammy.
+	// It can be overridden by the FLUX_VIEW_IMAGE env in the trainer.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=500
+	// +optional
+	ViewImage *string `json:"viewImage,omitempty"`
+
+	// networkDevice is the network device the Flux brokers bind to for the tree-based overlay network.
+	// Defaults to eth0.
+	// It can be overridden by the FLUX_NETWORK_DEVICE env in the trainer.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	// +optional
+	NetworkDevice *string `json:"networkDevice,omitempty"`
+
+	// queuePolicy is the queue policy of the Flux scheduler.
+	// Defaults to fcfs.
+	// It can be overridden by the FLUX_QUEUE_POLICY env in the trainer.
+	// +kubebuilder:validation:Enum=fcfs;easy;hybrid;conservative
+	// +optional
+	QueuePolicy *string `json:"queuePolicy,omitempty"`
+
+	// brokerPort is the port for the Flux brokers communication.
+	// Defaults to 8050.
+	// It can be overridden by the FLUX_BROKER_PORT env in the trainer.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	BrokerPort *int32 `json:"brokerPort,omitempty"`
 }
 
 // MPIImplementation represents one of the supported MPI implementations.
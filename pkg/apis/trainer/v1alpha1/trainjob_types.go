@@ -62,6 +62,28 @@ const (
 
 	// TrainJobFailed means that the actual jobs have failed its execution.
 	TrainJobFailed string = "Failed"
+
+	// TrainJobCanary means that the single-node canary of the trainer has succeeded,
+	// and the trainer can be scaled to numNodes.
+	TrainJobCanary string = "Canary"
+
+	// TrainJobImagePullFailed means that a container image of the TrainJob Pods can not be pulled.
+	TrainJobImagePullFailed string = "ImagePullFailed"
+
+	// TrainJobSpotFallback means that the trainer failed to be scheduled on the spot nodes,
+	// and it falls back to the on-demand nodes.
+	TrainJobSpotFallback string = "SpotFallback"
+
+	// TrainJobRestarting means that the JobSet of the TrainJob has been restarted while it is running.
+	TrainJobRestarting string = "Restarting"
+
+	// TrainJobUnschedulable means that the TrainJob requests exceed the cluster allocatable resources,
+	// so the scheduling preflight keeps it suspended.
+	TrainJobUnschedulable string = "Unschedulable"
+
+	// TrainJobJobSetConflict means that a JobSet with the TrainJob name exists and it is not owned by the TrainJob,
+	// e.g. the JobSet of a previous TrainJob with the same name is still being deleted.
+	TrainJobJobSetConflict string = "JobSetConflict"
 )
 
 const (
@@ -77,10 +99,54 @@ const (
 	// when the referenced TrainingRuntime is not supported.
 	TrainJobRuntimeNotSupportedReason string = "TrainingRuntimeNotSupported"
 
+	// TrainJobRuntimeMisconfiguredReason is the "Failed" condition reason
+	// when the referenced TrainingRuntime does not match the expectations of its ML policy.
+	TrainJobRuntimeMisconfiguredReason string = "RuntimeMisconfigured"
+
 	// TrainJobDeadlineExceededReason is the "Failed" condition reason
 	// when the TrainJob exceeds its ActiveDeadlineSeconds.
 	// Matches the Kubernetes Job behavior.
 	TrainJobDeadlineExceededReason string = "DeadlineExceeded"
+
+	// TrainJobOOMKilledReason is the "Failed" condition reason
+	// when the TrainJob failed because a container was killed for running out of memory.
+	TrainJobOOMKilledReason string = "OOMKilled"
+
+	// TrainJobCanaryRunningReason is the "Canary" condition reason
+	// when the single-node canary of the trainer is running.
+	TrainJobCanaryRunningReason string = "CanaryRunning"
+
+	// TrainJobCanarySucceededReason is the "Canary" condition reason
+	// when the single-node canary of the trainer has succeeded.
+	TrainJobCanarySucceededReason string = "CanarySucceeded"
+
+	// TrainJobCanaryFailedReason is the "Canary" condition reason
+	// when the single-node canary of the trainer has failed.
+	TrainJobCanaryFailedReason string = "CanaryFailed"
+
+	// TrainJobErrImagePullReason is the "ImagePullFailed" condition reason
+	// when the container image pull has failed.
+	TrainJobErrImagePullReason string = "ErrImagePull"
+
+	// TrainJobImagePullBackOffReason is the "ImagePullFailed" condition reason
+	// when the container image pull is backing off after the failures.
+	TrainJobImagePullBackOffReason string = "ImagePullBackOff"
+
+	// TrainJobSpotSchedulingFailedReason is the "SpotFallback" condition reason
+	// when the trainer Pods failed to be scheduled on the spot nodes.
+	TrainJobSpotSchedulingFailedReason string = "SpotSchedulingFailed"
+
+	// TrainJobRestartedReason is the "Restarting" condition reason
+	// when the JobSet restart count has increased.
+	TrainJobRestartedReason string = "Restarted"
+
+	// TrainJobInsufficientClusterCapacityReason is the "Unschedulable" condition reason
+	// when the TrainJob requests exceed the cluster allocatable resources.
+	TrainJobInsufficientClusterCapacityReason string = "InsufficientClusterCapacity"
+
+	// TrainJobJobSetOwnedByOtherReason is the "JobSetConflict" condition reason
+	// when the JobSet with the TrainJob name is controlled by another owner.
+	TrainJobJobSetOwnedByOtherReason string = "JobSetOwnedByOther"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -99,6 +165,7 @@ type TrainJobList struct {
 }
 
 // TrainJobSpec represents specification of the desired TrainJob.
+// +kubebuilder:validation:XValidation:rule="!has(self.trainer) || !has(oldSelf.trainer) || self.trainer == oldSelf.trainer || (has(oldSelf.suspend) && oldSelf.suspend)", message="trainer can only be changed while the TrainJob is suspended"
 type TrainJobSpec struct {
 	// runtimeRef is the reference to the training runtime.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="field is immutable"
@@ -111,10 +178,15 @@ type TrainJobSpec struct {
 	Initializer *Initializer `json:"initializer,omitempty"`
 
 	// trainer defines the configuration of the trainer.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="field is immutable"
+	// The trainer is immutable except numNodes, which can be changed while the TrainJob is suspended.
 	// +optional
 	Trainer *Trainer `json:"trainer,omitempty"`
 
+	// checkpoint defines the checkpoint to resume the training from.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="field is immutable"
+	// +optional
+	Checkpoint *CheckpointConfig `json:"checkpoint,omitempty"`
+
 	// runtimePatches defines custom patches applied to the TrainJob's Runtime.
 	// Patches are keyed by manager to provide clear ownership and avoid conflicts between controllers.
 	// +listType=map
@@ -138,6 +210,10 @@ type TrainJobSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="field is immutable"
 	ActiveDeadlineSeconds int64 `json:"activeDeadlineSeconds,omitempty"`
 
+	// runPolicy defines the policies applied to the TrainJob resources at runtime.
+	// +optional
+	RunPolicy *RunPolicy `json:"runPolicy,omitempty"`
+
 	// managedBy is used to indicate the controller or entity that manages a TrainJob.
 	// The value must be either an empty, `trainer.kubeflow.org/trainjob-controller` or
 	// `kueue.x-k8s.io/multikueue`. The built-in TrainJob controller reconciles TrainJob which
@@ -177,6 +253,33 @@ type RuntimeRef struct {
 	Kind *string `json:"kind,omitempty"`
 }
 
+// RunPolicy represents the policies applied to the TrainJob resources at runtime.
+type RunPolicy struct {
+	// cleanPodPolicy defines which Pods are deleted once the TrainJob is finished.
+	// All deletes all the Pods, Running deletes only the Pods which are still pending
+	// or running, and None keeps all the Pods.
+	// Defaults to None.
+	// +kubebuilder:default=None
+	// +kubebuilder:validation:Enum=All;Running;None
+	// +optional
+	CleanPodPolicy *CleanPodPolicy `json:"cleanPodPolicy,omitempty"`
+}
+
+// CleanPodPolicy describes which Pods are deleted once the TrainJob is finished.
+type CleanPodPolicy string
+
+const (
+	// CleanPodPolicyAll means that all the Pods are deleted once the TrainJob is finished.
+	CleanPodPolicyAll CleanPodPolicy = "All"
+
+	// CleanPodPolicyRunning means that only the pending or running Pods are deleted
+	// once the TrainJob is finished.
+	CleanPodPolicyRunning CleanPodPolicy = "Running"
+
+	// CleanPodPolicyNone means that no Pods are deleted once the TrainJob is finished.
+	CleanPodPolicyNone CleanPodPolicy = "None"
+)
+
 // Initializer represents the desired configuration for the dataset and model initialization.
 // It is used to initialize the assets (dataset and pre-trained model) and pre-process data.
 type Initializer struct {
@@ -187,11 +290,31 @@ type Initializer struct {
 	// model defines the configuration for the pre-trained model initialization
 	// +optional
 	Model *ModelInitializer `json:"model,omitempty"`
+
+	// trainerDependency is the status of the initializer Jobs which the trainer Job waits for before it starts.
+	// Ready starts the trainer once the initializer Pods are ready, for example to stream the dataset while the trainer runs.
+	// Complete starts the trainer once the initializers complete.
+	// Defaults to the dependsOn status of the TrainingRuntime.
+	// +optional
+	TrainerDependency *InitializerDependency `json:"trainerDependency,omitempty"`
 }
 
+// InitializerDependency represents the status of the initializer Jobs which the trainer Job depends on.
+// +kubebuilder:validation:Enum=Ready;Complete
+type InitializerDependency string
+
+const (
+	// InitializerDependencyReady means that the trainer Job starts once the initializer Pods are ready.
+	InitializerDependencyReady InitializerDependency = "Ready"
+
+	// InitializerDependencyComplete means that the trainer Job starts once the initializer Jobs complete.
+	InitializerDependencyComplete InitializerDependency = "Complete"
+)
+
 // DatasetInitializer represents the desired configuration to initialize and pre-process dataset.
 // The DatasetInitializer spec will override the runtime Job template
 // which contains this label: `trainer.kubeflow.org/trainjob-ancestor-step: dataset-initializer`
+// +kubebuilder:validation:XValidation:rule="!(has(self.storageUri) && has(self.datasets))",message="storageUri and datasets are mutually exclusive"
 type DatasetInitializer struct {
 	// storageUri is the URI for the dataset provider.
 	// If set, it may be empty, or it must be a valid URI format
@@ -201,6 +324,17 @@ type DatasetInitializer struct {
 	// +optional
 	StorageUri *string `json:"storageUri,omitempty"`
 
+	// datasets is the list of datasets to initialize, each mounted at its own path in the trainer Pods.
+	// A dataset initializer Job is created for every dataset from the runtime dataset initializer Job.
+	// This field is mutually exclusive with storageUri.
+	// At most 4 datasets are allowed, since JobSet limits the trainer Job to 5 dependencies, including the model initializer Job.
+	// +listType=map
+	// +listMapKey=mountPath
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	Datasets []DatasetSource `json:"datasets,omitempty"`
+
 	// env is the list of environment variables to set in the dataset initializer container.
 	// These values will be merged with the TrainingRuntime's dataset initializer environments.
 	// +listType=map
@@ -209,10 +343,41 @@ type DatasetInitializer struct {
 	// +optional
 	Env []corev1.EnvVar `json:"env,omitempty"`
 
+	// envFrom is the list of sources to populate environment variables in the dataset initializer container.
+	// These values will be appended to the TrainingRuntime's dataset initializer envFrom sources.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+
 	// secretRef is the reference to the secret with credentials to download dataset.
 	// Secret must be created in the TrainJob's namespace.
 	// +optional
 	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+
+	// parallelism is the number of dataset initializer Pods running in parallel,
+	// for example to download the dataset shards concurrently.
+	// It overrides the parallelism and completions of the dataset initializer Job.
+	// Defaults to the parallelism of the dataset initializer Job in the runtime.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+}
+
+// DatasetSource represents a dataset to initialize and the path to mount it in the trainer Pods.
+type DatasetSource struct {
+	// storageUri is the URI for the dataset provider, e.g. s3://bucket/path or gs://bucket/path.
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[A-Za-z][A-Za-z0-9+.-]*://.+$')",message="storageUri must be a valid URI (scheme://...)"
+	// +kubebuilder:validation:MaxLength=2048
+	// +required
+	StorageUri string `json:"storageUri"`
+
+	// mountPath is the absolute path to mount the dataset in the trainer containers.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('/')",message="mountPath must be an absolute path"
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	// +required
+	MountPath string `json:"mountPath"`
 }
 
 // ModelInitializer represents the desired configuration to initialize pre-trained model.
@@ -235,12 +400,32 @@ type ModelInitializer struct {
 	// +optional
 	Env []corev1.EnvVar `json:"env,omitempty"`
 
+	// envFrom is the list of sources to populate environment variables in the model initializer container.
+	// These values will be appended to the TrainingRuntime's model initializer envFrom sources.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+
 	// secretRef is the reference to the secret with credentials to download model.
 	// Secret must be created in the TrainJob's namespace.
 	// +optional
 	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
 }
 
+// CheckpointConfig represents the desired checkpoint configuration.
+// The checkpoint is downloaded by the model initializer, and the trainer
+// can discover it from the CHECKPOINT_PATH environment variable.
+type CheckpointConfig struct {
+	// storageUri is the URI for the checkpoint to resume the training from.
+	// It must be a valid URI format (e.g., s3://bucket/path, gs://bucket/path).
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[A-Za-z][A-Za-z0-9+.-]*://.+$')",message="storageUri must be a valid URI (scheme://...)"
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	StorageUri *string `json:"storageUri,omitempty"`
+}
+
 // Trainer represents the desired configuration for the training job.
 // The Trainer spec will override the runtime template
 // which contains this label: `trainer.kubeflow.org/trainjob-ancestor-step: trainer`
@@ -272,6 +457,13 @@ type Trainer struct {
 	// +optional
 	Env []corev1.EnvVar `json:"env,omitempty"`
 
+	// envFrom is the list of sources to populate environment variables in the training container.
+	// These values will be appended to the TrainingRuntime's trainer envFrom sources.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+
 	// numNodes is the number of training nodes.
 	// TODO (andreyvelich): Do we want to support dynamic num of nodes in TrainJob for PyTorch elastic: `--nnodes=1:4` ?
 	// +optional
@@ -281,13 +473,171 @@ type Trainer struct {
 	// +optional
 	ResourcesPerNode *corev1.ResourceRequirements `json:"resourcesPerNode,omitempty"`
 
+	// resourcesPerContainer defines the compute resources for the containers of each training node
+	// by the container name, for example the sidecar containers. The containers which are not listed
+	// keep the runtime resources. The resources for the node container take precedence over resourcesPerNode.
+	// +kubebuilder:validation:MaxProperties=16
+	// +optional
+	ResourcesPerContainer map[string]corev1.ResourceRequirements `json:"resourcesPerContainer,omitempty"`
+
 	// numProcPerNode is the number of processes/workers/slots on every training node.
 	// For the MPI runtime only int value can be set to represent number of slots per node.
 	// For the Torch runtime the value defaults to `auto` and can be overridden with an int.
 	// +optional
 	NumProcPerNode *int32 `json:"numProcPerNode,omitempty"`
+
+	// addCapabilities is the list of Linux capabilities to add to the training container security context.
+	// Only capabilities required by RDMA/InfiniBand networking are allowed.
+	// For example, `IPC_LOCK` is needed to pin memory for RDMA.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:Enum=IPC_LOCK;SYS_RESOURCE
+	// +optional
+	AddCapabilities []corev1.Capability `json:"addCapabilities,omitempty"`
+
+	// pipPackages is the list of extra Python packages to install with pip before training,
+	// for example `transformers==4.46.0`. The packages are installed by an init container
+	// into a volume shared with the training container.
+	// It requires the pip install to be enabled in the Trainer controller configuration.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=256
+	// +optional
+	PipPackages []string `json:"pipPackages,omitempty"`
+
+	// nodeSelector is the node selector to place the training nodes on specific nodes.
+	// These values will be merged with the TrainingRuntime's trainer node selector,
+	// and take precedence over the runtime values with the same keys.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// tolerations is the list of tolerations for the training nodes.
+	// These values will be merged with the TrainingRuntime's trainer tolerations,
+	// and replace the runtime tolerations with the same keys.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=128
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// imagePullSecrets is the list of Secrets to pull the trainer images, for example from a private registry.
+	// These values will be merged with the TrainingRuntime's trainer image pull secrets without duplicates.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// gpuTopology requests the topology-aware placement of the training nodes,
+	// for example to place all nodes within the same NVLink domain.
+	// The placement is requested with the Pod annotations consumed by the Kueue
+	// Topology Aware Scheduling.
+	// +optional
+	GPUTopology *GPUTopology `json:"gpuTopology,omitempty"`
+
+	// gpuProduct is the GPU product to place the training nodes on, for example `NVIDIA-H100-80GB-HBM3`.
+	// It is translated into the required node affinity on the `nvidia.com/gpu.product` node label,
+	// and combined with the TrainingRuntime's trainer node affinity.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	GPUProduct *string `json:"gpuProduct,omitempty"`
+
+	// canary runs the trainer with a single node as a smoke test before it is scaled to numNodes,
+	// for example to catch the image or entrypoint errors cheaply.
+	// The trainer is scaled to numNodes once the canary succeeds, which is reported
+	// by the Canary condition. The TrainJob fails if the canary fails.
+	// Defaults to false.
+	// +optional
+	Canary *bool `json:"canary,omitempty"`
+
+	// heartbeat configures the liveness probe restarting the trainer container
+	// when the training stalls, for example when the distributed job is deadlocked.
+	// +optional
+	Heartbeat *Heartbeat `json:"heartbeat,omitempty"`
+
+	// spot places the training nodes on the spot or preemptible nodes for cost savings,
+	// with an optional fallback to the on-demand nodes.
+	// +optional
+	Spot *Spot `json:"spot,omitempty"`
+}
+
+// Spot represents the placement of the training nodes on the spot nodes.
+type Spot struct {
+	// nodeLabels are the node labels identifying the spot nodes, for example
+	// `cloud.google.com/gke-spot: "true"`. They are translated into the required node affinity
+	// of the trainer, which excludes the spot nodes instead once the TrainJob falls back to the on-demand nodes.
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=8
+	// +required
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// tolerations is the list of tolerations for the spot node taints.
+	// They are not added once the TrainJob falls back to the on-demand nodes.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// fallbackAfterSchedulingFailures is the number of the trainer Pods failing to be scheduled
+	// on the spot nodes, after which the trainer is recreated on the on-demand nodes.
+	// The fallback is reported by the SpotFallback condition.
+	// Defaults to no fallback.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FallbackAfterSchedulingFailures *int32 `json:"fallbackAfterSchedulingFailures,omitempty"`
+}
+
+// Heartbeat represents the liveness check of the trainer based on the heartbeat file,
+// which is periodically touched by the training loop. The path of the heartbeat file is
+// exposed to the trainer with the TRAINER_HEARTBEAT_FILE environment variable.
+type Heartbeat struct {
+	// path is the absolute path of the heartbeat file in the trainer container.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('/')",message="path must be an absolute path"
+	// +required
+	Path string `json:"path,omitempty"`
+
+	// timeoutSeconds is the duration in seconds after which the trainer container is restarted
+	// if the heartbeat file is not updated. The first check is delayed by the same duration.
+	// Defaults to 300.
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// GPUTopology represents the topology-aware placement of the training nodes.
+type GPUTopology struct {
+	// level is the node label key of the topology domain the training nodes are placed in.
+	// Defaults to `nvidia.com/gpu.clique`, which identifies the NVLink domain of the GPUs.
+	// +kubebuilder:default="nvidia.com/gpu.clique"
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	// +optional
+	Level *string `json:"level,omitempty"`
+
+	// mode defines whether the placement within the topology domain is required or preferred.
+	// Defaults to Required.
+	// +kubebuilder:default=Required
+	// +optional
+	Mode *GPUTopologyMode `json:"mode,omitempty"`
 }
 
+// GPUTopologyMode represents the mode of the topology-aware placement.
+// +kubebuilder:validation:Enum=Required;Preferred
+type GPUTopologyMode string
+
+const (
+	// GPUTopologyModeRequired places all training nodes within a single topology domain,
+	// or keeps them pending until the domain has enough capacity.
+	GPUTopologyModeRequired GPUTopologyMode = "Required"
+
+	// GPUTopologyModePreferred places the training nodes within as few topology domains as possible.
+	GPUTopologyModePreferred GPUTopologyMode = "Preferred"
+)
+
 // RuntimePatch represents a custom patch applied to the TrainJob's training runtime template.
 // Patches are keyed by manager to provide clear ownership and avoid conflicts between controllers.
 type RuntimePatch struct {
@@ -372,6 +722,13 @@ type JobSpecPatch struct {
 	// template patches the Pod template for this Job.
 	// +optional
 	Template *PodTemplatePatch `json:"template,omitempty"`
+
+	// activeDeadlineSeconds patches the duration in seconds relative to the start time
+	// that the Job may be active before the system tries to terminate it, for example to bound the initializer Jobs.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="field is immutable"
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
 }
 
 // PodTemplatePatch defines patches for a Pod template within a Job.
@@ -491,6 +848,14 @@ type ContainerPatch struct {
 	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/
 	// +optional
 	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// restartPolicy marks the init container as a sidecar when set to Always, so it keeps
+	// running alongside the containers and is counted in the Pod's running resource sum.
+	// This value can only be set for the init containers.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/sidecar-containers/
+	// +kubebuilder:validation:Enum=Always
+	// +optional
+	RestartPolicy *corev1.ContainerRestartPolicy `json:"restartPolicy,omitempty"`
 }
 
 // TrainJobStatus represents the current status of TrainJob.
@@ -521,8 +886,68 @@ type TrainJobStatus struct {
 	// This is an alpha feature and requires enabling the TrainJobStatus feature gate.
 	// +optional
 	TrainerStatus *TrainerStatus `json:"trainerStatus,omitempty"`
+
+	// effectiveCommand is the final command and arguments of the trainer node container,
+	// as rendered into the runtime resources after all the runtime plugins applied.
+	// It is recorded for audit and reproducibility purposes.
+	// +listType=atomic
+	// +optional
+	EffectiveCommand []string `json:"effectiveCommand,omitempty"`
+
+	// totalResources is the total amount of compute resources requested by all the Pods of the TrainJob.
+	// For the gang-scheduled TrainJob, it matches the minResources of the PodGroup.
+	// +optional
+	TotalResources corev1.ResourceList `json:"totalResources,omitempty"`
+
+	// jobSetStatus mirrors the high-level status of the JobSet created for the TrainJob.
+	// +optional
+	JobSetStatus *JobSetStatus `json:"jobSetStatus,omitempty"`
+
+	// startTime is the time when the TrainJob was started, or resumed after the suspension.
+	// It is reset when the TrainJob is suspended.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// completionTime is the time when the TrainJob completed.
+	// It is not set if the TrainJob failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// restartCount is the number of times the JobSet of the TrainJob has been restarted.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RestartCount *int32 `json:"restartCount,omitempty"`
+}
+
+// JobSetStatus represents the high-level status of the JobSet created for the TrainJob.
+type JobSetStatus struct {
+	// creationTimestamp is the time when the JobSet was created.
+	// +required
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// phase is the high-level phase of the JobSet.
+	// +required
+	Phase JobSetPhase `json:"phase,omitempty"`
 }
 
+// JobSetPhase represents the high-level phase of the JobSet.
+// +kubebuilder:validation:Enum=Suspended;Running;Completed;Failed
+type JobSetPhase string
+
+const (
+	// JobSetPhaseSuspended means the JobSet is suspended.
+	JobSetPhaseSuspended JobSetPhase = "Suspended"
+
+	// JobSetPhaseRunning means the JobSet is neither suspended nor finished.
+	JobSetPhaseRunning JobSetPhase = "Running"
+
+	// JobSetPhaseCompleted means the JobSet has completed.
+	JobSetPhaseCompleted JobSetPhase = "Completed"
+
+	// JobSetPhaseFailed means the JobSet has failed.
+	JobSetPhaseFailed JobSetPhase = "Failed"
+)
+
 type JobStatus struct {
 	// name of the child Job.
 	// +kubebuilder:validation:MinLength=1
@@ -25,9 +25,31 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	v1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CheckpointConfig) DeepCopyInto(out *CheckpointConfig) {
+	*out = *in
+	if in.StorageUri != nil {
+		in, out := &in.StorageUri, &out.StorageUri
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckpointConfig.
+func (in *CheckpointConfig) DeepCopy() *CheckpointConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CheckpointConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterTrainingRuntime) DeepCopyInto(out *ClusterTrainingRuntime) {
 	*out = *in
@@ -110,6 +132,11 @@ func (in *ContainerPatch) DeepCopyInto(out *ContainerPatch) {
 		*out = new(v1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RestartPolicy != nil {
+		in, out := &in.RestartPolicy, &out.RestartPolicy
+		*out = new(v1.ContainerRestartPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -152,6 +179,11 @@ func (in *DatasetInitializer) DeepCopyInto(out *DatasetInitializer) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Datasets != nil {
+		in, out := &in.Datasets, &out.Datasets
+		*out = make([]DatasetSource, len(*in))
+		copy(*out, *in)
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]v1.EnvVar, len(*in))
@@ -159,11 +191,23 @@ func (in *DatasetInitializer) DeepCopyInto(out *DatasetInitializer) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]v1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SecretRef != nil {
 		in, out := &in.SecretRef, &out.SecretRef
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -177,6 +221,48 @@ func (in *DatasetInitializer) DeepCopy() *DatasetInitializer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetSource) DeepCopyInto(out *DatasetSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSource.
+func (in *DatasetSource) DeepCopy() *DatasetSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeepSpeedMLPolicySource) DeepCopyInto(out *DeepSpeedMLPolicySource) {
+	*out = *in
+	if in.NumProcPerNode != nil {
+		in, out := &in.NumProcPerNode, &out.NumProcPerNode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SSHAuthMountPath != nil {
+		in, out := &in.SSHAuthMountPath, &out.SSHAuthMountPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeepSpeedMLPolicySource.
+func (in *DeepSpeedMLPolicySource) DeepCopy() *DeepSpeedMLPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(DeepSpeedMLPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvInjection) DeepCopyInto(out *EnvInjection) {
 	*out = *in
@@ -229,6 +315,26 @@ func (in *FluxMLPolicySource) DeepCopyInto(out *FluxMLPolicySource) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ViewImage != nil {
+		in, out := &in.ViewImage, &out.ViewImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkDevice != nil {
+		in, out := &in.NetworkDevice, &out.NetworkDevice
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueuePolicy != nil {
+		in, out := &in.QueuePolicy, &out.QueuePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.BrokerPort != nil {
+		in, out := &in.BrokerPort, &out.BrokerPort
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -242,6 +348,74 @@ func (in *FluxMLPolicySource) DeepCopy() *FluxMLPolicySource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUTopology) DeepCopyInto(out *GPUTopology) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(GPUTopologyMode)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUTopology.
+func (in *GPUTopology) DeepCopy() *GPUTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Heartbeat) DeepCopyInto(out *Heartbeat) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Heartbeat.
+func (in *Heartbeat) DeepCopy() *Heartbeat {
+	if in == nil {
+		return nil
+	}
+	out := new(Heartbeat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorovodMLPolicySource) DeepCopyInto(out *HorovodMLPolicySource) {
+	*out = *in
+	if in.GlooIface != nil {
+		in, out := &in.GlooIface, &out.GlooIface
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorovodMLPolicySource.
+func (in *HorovodMLPolicySource) DeepCopy() *HorovodMLPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(HorovodMLPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Initializer) DeepCopyInto(out *Initializer) {
 	*out = *in
@@ -255,6 +429,11 @@ func (in *Initializer) DeepCopyInto(out *Initializer) {
 		*out = new(ModelInitializer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TrainerDependency != nil {
+		in, out := &in.TrainerDependency, &out.TrainerDependency
+		*out = new(InitializerDependency)
+		**out = **in
+	}
 	return
 }
 
@@ -307,6 +486,26 @@ func (in *JobSetSpecPatch) DeepCopy() *JobSetSpecPatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobSetStatus) DeepCopyInto(out *JobSetStatus) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobSetStatus.
+func (in *JobSetStatus) DeepCopy() *JobSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobSetTemplatePatch) DeepCopyInto(out *JobSetTemplatePatch) {
 	*out = *in
@@ -359,6 +558,11 @@ func (in *JobSpecPatch) DeepCopyInto(out *JobSpecPatch) {
 		*out = new(PodTemplatePatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -447,6 +651,26 @@ func (in *MLPolicy) DeepCopyInto(out *MLPolicy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TrainerPort != nil {
+		in, out := &in.TrainerPort, &out.TrainerPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TrainerPortName != nil {
+		in, out := &in.TrainerPortName, &out.TrainerPortName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalPorts != nil {
+		in, out := &in.AdditionalPorts, &out.AdditionalPorts
+		*out = make([]NamedPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.SingleProcessPerNode != nil {
+		in, out := &in.SingleProcessPerNode, &out.SingleProcessPerNode
+		*out = new(bool)
+		**out = **in
+	}
 	in.MLPolicySource.DeepCopyInto(&out.MLPolicySource)
 	return
 }
@@ -487,8 +711,28 @@ func (in *MLPolicySource) DeepCopyInto(out *MLPolicySource) {
 	if in.XGBoost != nil {
 		in, out := &in.XGBoost, &out.XGBoost
 		*out = new(XGBoostMLPolicySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeepSpeed != nil {
+		in, out := &in.DeepSpeed, &out.DeepSpeed
+		*out = new(DeepSpeedMLPolicySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TensorFlow != nil {
+		in, out := &in.TensorFlow, &out.TensorFlow
+		*out = new(TensorFlowMLPolicySource)
 		**out = **in
 	}
+	if in.Ray != nil {
+		in, out := &in.Ray, &out.Ray
+		*out = new(RayMLPolicySource)
+		**out = **in
+	}
+	if in.Horovod != nil {
+		in, out := &in.Horovod, &out.Horovod
+		*out = new(HorovodMLPolicySource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -525,6 +769,16 @@ func (in *MPIMLPolicySource) DeepCopyInto(out *MPIMLPolicySource) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LauncherBinary != nil {
+		in, out := &in.LauncherBinary, &out.LauncherBinary
+		*out = new(string)
+		**out = **in
+	}
+	if in.MountHostfileOnNodes != nil {
+		in, out := &in.MountHostfileOnNodes, &out.MountHostfileOnNodes
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -569,6 +823,13 @@ func (in *ModelInitializer) DeepCopyInto(out *ModelInitializer) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]v1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SecretRef != nil {
 		in, out := &in.SecretRef, &out.SecretRef
 		*out = new(v1.LocalObjectReference)
@@ -587,6 +848,22 @@ func (in *ModelInitializer) DeepCopy() *ModelInitializer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedPort) DeepCopyInto(out *NamedPort) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedPort.
+func (in *NamedPort) DeepCopy() *NamedPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodGroupPolicy) DeepCopyInto(out *PodGroupPolicy) {
 	*out = *in
@@ -758,6 +1035,43 @@ func (in *ReplicatedJobPatch) DeepCopy() *ReplicatedJobPatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayMLPolicySource) DeepCopyInto(out *RayMLPolicySource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayMLPolicySource.
+func (in *RayMLPolicySource) DeepCopy() *RayMLPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(RayMLPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunPolicy) DeepCopyInto(out *RunPolicy) {
+	*out = *in
+	if in.CleanPodPolicy != nil {
+		in, out := &in.CleanPodPolicy, &out.CleanPodPolicy
+		*out = new(CleanPodPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunPolicy.
+func (in *RunPolicy) DeepCopy() *RunPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RunPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimePatch) DeepCopyInto(out *RuntimePatch) {
 	*out = *in
@@ -809,6 +1123,57 @@ func (in *RuntimeRef) DeepCopy() *RuntimeRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Spot) DeepCopyInto(out *Spot) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FallbackAfterSchedulingFailures != nil {
+		in, out := &in.FallbackAfterSchedulingFailures, &out.FallbackAfterSchedulingFailures
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Spot.
+func (in *Spot) DeepCopy() *Spot {
+	if in == nil {
+		return nil
+	}
+	out := new(Spot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TensorFlowMLPolicySource) DeepCopyInto(out *TensorFlowMLPolicySource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TensorFlowMLPolicySource.
+func (in *TensorFlowMLPolicySource) DeepCopy() *TensorFlowMLPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(TensorFlowMLPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TorchMLPolicySource) DeepCopyInto(out *TorchMLPolicySource) {
 	*out = *in
@@ -817,6 +1182,41 @@ func (in *TorchMLPolicySource) DeepCopyInto(out *TorchMLPolicySource) {
 		*out = new(EnvInjection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NumProcPerNode != nil {
+		in, out := &in.NumProcPerNode, &out.NumProcPerNode
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MemoryPerProcess != nil {
+		in, out := &in.MemoryPerProcess, &out.MemoryPerProcess
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.NumProcPerNodeLabel != nil {
+		in, out := &in.NumProcPerNodeLabel, &out.NumProcPerNodeLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinNodes != nil {
+		in, out := &in.MinNodes, &out.MinNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNodes != nil {
+		in, out := &in.MaxNodes, &out.MaxNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RdzvBackend != nil {
+		in, out := &in.RdzvBackend, &out.RdzvBackend
+		*out = new(RdzvBackend)
+		**out = **in
+	}
+	if in.RdzvEndpoint != nil {
+		in, out := &in.RdzvEndpoint, &out.RdzvEndpoint
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -905,6 +1305,11 @@ func (in *TrainJobSpec) DeepCopyInto(out *TrainJobSpec) {
 		*out = new(Trainer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(CheckpointConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RuntimePatches != nil {
 		in, out := &in.RuntimePatches, &out.RuntimePatches
 		*out = make([]RuntimePatch, len(*in))
@@ -917,6 +1322,11 @@ func (in *TrainJobSpec) DeepCopyInto(out *TrainJobSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RunPolicy != nil {
+		in, out := &in.RunPolicy, &out.RunPolicy
+		*out = new(RunPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedBy != nil {
 		in, out := &in.ManagedBy, &out.ManagedBy
 		*out = new(string)
@@ -957,6 +1367,36 @@ func (in *TrainJobStatus) DeepCopyInto(out *TrainJobStatus) {
 		*out = new(TrainerStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EffectiveCommand != nil {
+		in, out := &in.EffectiveCommand, &out.EffectiveCommand
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TotalResources != nil {
+		in, out := &in.TotalResources, &out.TotalResources
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.JobSetStatus != nil {
+		in, out := &in.JobSetStatus, &out.JobSetStatus
+		*out = new(JobSetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RestartCount != nil {
+		in, out := &in.RestartCount, &out.RestartCount
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -995,6 +1435,13 @@ func (in *Trainer) DeepCopyInto(out *Trainer) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]v1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NumNodes != nil {
 		in, out := &in.NumNodes, &out.NumNodes
 		*out = new(int32)
@@ -1005,11 +1452,72 @@ func (in *Trainer) DeepCopyInto(out *Trainer) {
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourcesPerContainer != nil {
+		in, out := &in.ResourcesPerContainer, &out.ResourcesPerContainer
+		*out = make(map[string]v1.ResourceRequirements, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.NumProcPerNode != nil {
 		in, out := &in.NumProcPerNode, &out.NumProcPerNode
 		*out = new(int32)
 		**out = **in
 	}
+	if in.AddCapabilities != nil {
+		in, out := &in.AddCapabilities, &out.AddCapabilities
+		*out = make([]v1.Capability, len(*in))
+		copy(*out, *in)
+	}
+	if in.PipPackages != nil {
+		in, out := &in.PipPackages, &out.PipPackages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.GPUTopology != nil {
+		in, out := &in.GPUTopology, &out.GPUTopology
+		*out = new(GPUTopology)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUProduct != nil {
+		in, out := &in.GPUProduct, &out.GPUProduct
+		*out = new(string)
+		**out = **in
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Heartbeat != nil {
+		in, out := &in.Heartbeat, &out.Heartbeat
+		*out = new(Heartbeat)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spot != nil {
+		in, out := &in.Spot, &out.Spot
+		*out = new(Spot)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1208,6 +1716,11 @@ func (in *VolcanoPodGroupPolicySource) DeepCopy() *VolcanoPodGroupPolicySource {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *XGBoostMLPolicySource) DeepCopyInto(out *XGBoostMLPolicySource) {
 	*out = *in
+	if in.NumServers != nil {
+		in, out := &in.NumServers, &out.NumServers
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
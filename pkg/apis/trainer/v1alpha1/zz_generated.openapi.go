@@ -35,17 +35,24 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.CheckpointConfig":                 schema_pkg_apis_trainer_v1alpha1_CheckpointConfig(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.ClusterTrainingRuntime":           schema_pkg_apis_trainer_v1alpha1_ClusterTrainingRuntime(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.ClusterTrainingRuntimeList":       schema_pkg_apis_trainer_v1alpha1_ClusterTrainingRuntimeList(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.ContainerPatch":                   schema_pkg_apis_trainer_v1alpha1_ContainerPatch(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.CoschedulingPodGroupPolicySource": schema_pkg_apis_trainer_v1alpha1_CoschedulingPodGroupPolicySource(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DatasetInitializer":               schema_pkg_apis_trainer_v1alpha1_DatasetInitializer(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DatasetSource":                    schema_pkg_apis_trainer_v1alpha1_DatasetSource(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DeepSpeedMLPolicySource":          schema_pkg_apis_trainer_v1alpha1_DeepSpeedMLPolicySource(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.EnvInjection":                     schema_pkg_apis_trainer_v1alpha1_EnvInjection(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.EnvInjectionTarget":               schema_pkg_apis_trainer_v1alpha1_EnvInjectionTarget(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.FluxMLPolicySource":               schema_pkg_apis_trainer_v1alpha1_FluxMLPolicySource(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.GPUTopology":                      schema_pkg_apis_trainer_v1alpha1_GPUTopology(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Heartbeat":                        schema_pkg_apis_trainer_v1alpha1_Heartbeat(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.HorovodMLPolicySource":            schema_pkg_apis_trainer_v1alpha1_HorovodMLPolicySource(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Initializer":                      schema_pkg_apis_trainer_v1alpha1_Initializer(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JAXMLPolicySource":                schema_pkg_apis_trainer_v1alpha1_JAXMLPolicySource(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobSetSpecPatch":                  schema_pkg_apis_trainer_v1alpha1_JobSetSpecPatch(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobSetStatus":                     schema_pkg_apis_trainer_v1alpha1_JobSetStatus(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobSetTemplatePatch":              schema_pkg_apis_trainer_v1alpha1_JobSetTemplatePatch(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobSetTemplateSpec":               schema_pkg_apis_trainer_v1alpha1_JobSetTemplateSpec(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobSpecPatch":                     schema_pkg_apis_trainer_v1alpha1_JobSpecPatch(ref),
@@ -56,13 +63,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.MPIMLPolicySource":                schema_pkg_apis_trainer_v1alpha1_MPIMLPolicySource(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Metric":                           schema_pkg_apis_trainer_v1alpha1_Metric(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.ModelInitializer":                 schema_pkg_apis_trainer_v1alpha1_ModelInitializer(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.NamedPort":                        schema_pkg_apis_trainer_v1alpha1_NamedPort(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.PodGroupPolicy":                   schema_pkg_apis_trainer_v1alpha1_PodGroupPolicy(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.PodGroupPolicySource":             schema_pkg_apis_trainer_v1alpha1_PodGroupPolicySource(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.PodSpecPatch":                     schema_pkg_apis_trainer_v1alpha1_PodSpecPatch(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.PodTemplatePatch":                 schema_pkg_apis_trainer_v1alpha1_PodTemplatePatch(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.ReplicatedJobPatch":               schema_pkg_apis_trainer_v1alpha1_ReplicatedJobPatch(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RayMLPolicySource":                schema_pkg_apis_trainer_v1alpha1_RayMLPolicySource(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RunPolicy":                        schema_pkg_apis_trainer_v1alpha1_RunPolicy(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RuntimePatch":                     schema_pkg_apis_trainer_v1alpha1_RuntimePatch(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RuntimeRef":                       schema_pkg_apis_trainer_v1alpha1_RuntimeRef(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Spot":                             schema_pkg_apis_trainer_v1alpha1_Spot(ref),
+		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TensorFlowMLPolicySource":         schema_pkg_apis_trainer_v1alpha1_TensorFlowMLPolicySource(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TorchMLPolicySource":              schema_pkg_apis_trainer_v1alpha1_TorchMLPolicySource(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TrainJob":                         schema_pkg_apis_trainer_v1alpha1_TrainJob(ref),
 		"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TrainJobList":                     schema_pkg_apis_trainer_v1alpha1_TrainJobList(ref),
@@ -447,6 +459,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_pkg_apis_trainer_v1alpha1_CheckpointConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CheckpointConfig represents the desired checkpoint configuration. The checkpoint is downloaded by the model initializer, and the trainer can discover it from the CHECKPOINT_PATH environment variable.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageUri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "storageUri is the URI for the checkpoint to resume the training from. It must be a valid URI format (e.g., s3://bucket/path, gs://bucket/path).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_trainer_v1alpha1_ClusterTrainingRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -605,6 +637,13 @@ func schema_pkg_apis_trainer_v1alpha1_ContainerPatch(ref common.ReferenceCallbac
 							Ref:         ref(corev1.SecurityContext{}.OpenAPIModelName()),
 						},
 					},
+					"restartPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restartPolicy marks the init container as a sidecar when set to Always, so it keeps running alongside the containers and is counted in the Pod's running resource sum. This value can only be set for the init containers. More info: https://kubernetes.io/docs/concepts/workloads/pods/sidecar-containers/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -628,6 +667,13 @@ func schema_pkg_apis_trainer_v1alpha1_CoschedulingPodGroupPolicySource(ref commo
 							Format:      "int32",
 						},
 					},
+					"queue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "queue is the name of the scheduler queue for the gang-scheduling. It is set as the scheduling.x-k8s.io/queue-name label on the PodGroup and the TrainJob Pods. Defaults to empty, which means that no queue label is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -648,6 +694,28 @@ func schema_pkg_apis_trainer_v1alpha1_DatasetInitializer(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"datasets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"mountPath",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "datasets is the list of datasets to initialize, each mounted at its own path in the trainer Pods. A dataset initializer Job is created for every dataset from the runtime dataset initializer Job. This field is mutually exclusive with storageUri. At most 4 datasets are allowed, since JobSet limits the trainer Job to 5 dependencies, including the model initializer Job.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DatasetSource"),
+									},
+								},
+							},
+						},
+					},
 					"env": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -670,17 +738,100 @@ func schema_pkg_apis_trainer_v1alpha1_DatasetInitializer(ref common.ReferenceCal
 							},
 						},
 					},
+					"envFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "envFrom is the list of sources to populate environment variables in the dataset initializer container. These values will be appended to the TrainingRuntime's dataset initializer envFrom sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1.EnvFromSource{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 					"secretRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "secretRef is the reference to the secret with credentials to download dataset. Secret must be created in the TrainJob's namespace.",
 							Ref:         ref(corev1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"parallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "parallelism is the number of dataset initializer Pods running in parallel, for example to download the dataset shards concurrently. It overrides the parallelism and completions of the dataset initializer Job. Defaults to the parallelism of the dataset initializer Job in the runtime.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			corev1.EnvVar{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
+			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DatasetSource", corev1.EnvFromSource{}.OpenAPIModelName(), corev1.EnvVar{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_DatasetSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatasetSource represents a dataset to initialize and the path to mount it in the trainer Pods.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageUri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "storageUri is the URI for the dataset provider, e.g. s3://bucket/path or gs://bucket/path.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "mountPath is the absolute path to mount the dataset in the trainer containers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"storageUri", "mountPath"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_DeepSpeedMLPolicySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeepSpeedMLPolicySource represents a DeepSpeed runtime configuration. The launcher Job runs `deepspeed --hostfile` which starts the training processes on the node Jobs over SSH.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numProcPerNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "numProcPerNode is the number of processes per node. This value is equal to the number of slots for each node in the hostfile. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"sshAuthMountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "sshAuthMountPath is the directory where SSH keys are mounted. Defaults to /root/.ssh.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -776,6 +927,109 @@ func schema_pkg_apis_trainer_v1alpha1_FluxMLPolicySource(ref common.ReferenceCal
 							Format:      "int32",
 						},
 					},
+					"viewImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "viewImage is the container image of the Flux view installed into the trainer nodes by the init container. The OS and version of the view image must match the trainer image. Defaults to ghcr.io/converged-computing/flux-view-ubuntu:tag-jammy. It can be overridden by the FLUX_VIEW_IMAGE env in the trainer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"networkDevice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "networkDevice is the network device the Flux brokers bind to for the tree-based overlay network. Defaults to eth0. It can be overridden by the FLUX_NETWORK_DEVICE env in the trainer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queuePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "queuePolicy is the queue policy of the Flux scheduler. Defaults to fcfs. It can be overridden by the FLUX_QUEUE_POLICY env in the trainer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"brokerPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "brokerPort is the port for the Flux brokers communication. Defaults to 8050. It can be overridden by the FLUX_BROKER_PORT env in the trainer.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_GPUTopology(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUTopology represents the topology-aware placement of the training nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "level is the node label key of the topology domain the training nodes are placed in. Defaults to `nvidia.com/gpu.clique`, which identifies the NVLink domain of the GPUs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "mode defines whether the placement within the topology domain is required or preferred. Defaults to Required.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_Heartbeat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Heartbeat represents the liveness check of the trainer based on the heartbeat file, which is periodically touched by the training loop. The path of the heartbeat file is exposed to the trainer with the TRAINER_HEARTBEAT_FILE environment variable.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "path is the absolute path of the heartbeat file in the trainer container.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timeoutSeconds is the duration in seconds after which the trainer container is restarted if the heartbeat file is not updated. The first check is delayed by the same duration. Defaults to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_HorovodMLPolicySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HorovodMLPolicySource represents a Horovod runtime configuration. It is layered on the MPI policy: the launcher runs the training command with horovodrun over the MPI hostfile, and the nodes are reached over the MPI SSH keys.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"glooIface": {
+						SchemaProps: spec.SchemaProps{
+							Description: "glooIface is the network interface used by the Gloo controller for the communication between the training processes, for example eth0. It is set to HOROVOD_GLOO_IFACE.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -801,6 +1055,13 @@ func schema_pkg_apis_trainer_v1alpha1_Initializer(ref common.ReferenceCallback)
 							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.ModelInitializer"),
 						},
 					},
+					"trainerDependency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "trainerDependency is the status of the initializer Jobs which the trainer Job waits for before it starts. Ready starts the trainer once the initializer Pods are ready, for example to stream the dataset while the trainer runs. Complete starts the trainer once the initializers complete. Defaults to the dependsOn status of the TrainingRuntime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -857,6 +1118,35 @@ func schema_pkg_apis_trainer_v1alpha1_JobSetSpecPatch(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_trainer_v1alpha1_JobSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JobSetStatus represents the high-level status of the JobSet created for the TrainJob.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"creationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "creationTimestamp is the time when the JobSet was created.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phase is the high-level phase of the JobSet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"creationTimestamp", "phase"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_trainer_v1alpha1_JobSetTemplatePatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -926,6 +1216,13 @@ func schema_pkg_apis_trainer_v1alpha1_JobSpecPatch(ref common.ReferenceCallback)
 							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.PodTemplatePatch"),
 						},
 					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "activeDeadlineSeconds patches the duration in seconds relative to the start time that the Job may be active before the system tries to terminate it, for example to bound the initializer Jobs.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -1030,6 +1327,49 @@ func schema_pkg_apis_trainer_v1alpha1_MLPolicy(ref common.ReferenceCallback) com
 							Format:      "int32",
 						},
 					},
+					"trainerPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "trainerPort is the port for the trainer nodes communication, for example the PyTorch master port or the XGBoost tracker port. It can be changed to avoid the port collisions on the nodes with host networking. Defaults to 29500.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"trainerPortName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "trainerPortName is the name of the trainer port in the trainer node container, so that it can be referenced by name, for example from the Service or the probes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"additionalPorts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "additionalPorts are the named ports exposed by the trainer node container in addition to the trainer port, for example the metrics port.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.NamedPort"),
+									},
+								},
+							},
+						},
+					},
+					"singleProcessPerNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "singleProcessPerNode declares that the runtime only supports a single training process per node, for example the legacy frameworks, so the TrainJob can not set numProcPerNode other than 1. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"torch": {
 						SchemaProps: spec.SchemaProps{
 							Description: "torch defines the configuration for the PyTorch runtime.",
@@ -1060,11 +1400,35 @@ func schema_pkg_apis_trainer_v1alpha1_MLPolicy(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.XGBoostMLPolicySource"),
 						},
 					},
+					"deepspeed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deepspeed defines the configuration for the DeepSpeed runtime.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DeepSpeedMLPolicySource"),
+						},
+					},
+					"tensorflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "tensorflow defines the configuration for the TensorFlow runtime.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TensorFlowMLPolicySource"),
+						},
+					},
+					"ray": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ray defines the configuration for the Ray runtime.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RayMLPolicySource"),
+						},
+					},
+					"horovod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "horovod defines the configuration for the Horovod runtime. It must be configured together with mpi of the OpenMPI implementation, which provides the SSH keys and the hostfile.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.HorovodMLPolicySource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.FluxMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JAXMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.MPIMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TorchMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.XGBoostMLPolicySource"},
+			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DeepSpeedMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.FluxMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.HorovodMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JAXMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.MPIMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.NamedPort", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RayMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TensorFlowMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TorchMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.XGBoostMLPolicySource"},
 	}
 }
 
@@ -1105,11 +1469,35 @@ func schema_pkg_apis_trainer_v1alpha1_MLPolicySource(ref common.ReferenceCallbac
 							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.XGBoostMLPolicySource"),
 						},
 					},
+					"deepspeed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deepspeed defines the configuration for the DeepSpeed runtime.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DeepSpeedMLPolicySource"),
+						},
+					},
+					"tensorflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "tensorflow defines the configuration for the TensorFlow runtime.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TensorFlowMLPolicySource"),
+						},
+					},
+					"ray": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ray defines the configuration for the Ray runtime.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RayMLPolicySource"),
+						},
+					},
+					"horovod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "horovod defines the configuration for the Horovod runtime. It must be configured together with mpi of the OpenMPI implementation, which provides the SSH keys and the hostfile.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.HorovodMLPolicySource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.FluxMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JAXMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.MPIMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TorchMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.XGBoostMLPolicySource"},
+			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.DeepSpeedMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.FluxMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.HorovodMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JAXMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.MPIMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RayMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TensorFlowMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TorchMLPolicySource", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.XGBoostMLPolicySource"},
 	}
 }
 
@@ -1148,6 +1536,20 @@ func schema_pkg_apis_trainer_v1alpha1_MPIMLPolicySource(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"launcherBinary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "launcherBinary is the binary starting the MPI processes on the launcher, for example mpirun or mpiexec. When set, it replaces the first element of the launcher container command in the runtime, while the command of the TrainJob trainer is used as is.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountHostfileOnNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "mountHostfileOnNodes defines whether to mount the generated hostfile on the node Jobs as well as the launcher Job, for the MPI setups where the training processes on the nodes read the hostfile. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1217,6 +1619,25 @@ func schema_pkg_apis_trainer_v1alpha1_ModelInitializer(ref common.ReferenceCallb
 							},
 						},
 					},
+					"envFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "envFrom is the list of sources to populate environment variables in the model initializer container. These values will be appended to the TrainingRuntime's model initializer envFrom sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1.EnvFromSource{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 					"secretRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "secretRef is the reference to the secret with credentials to download model. Secret must be created in the TrainJob's namespace.",
@@ -1227,7 +1648,37 @@ func schema_pkg_apis_trainer_v1alpha1_ModelInitializer(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			corev1.EnvVar{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
+			corev1.EnvFromSource{}.OpenAPIModelName(), corev1.EnvVar{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_NamedPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedPort represents the named port exposed by the trainer node container.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the port, which must be unique in the trainer node container.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"containerPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "containerPort is the number of the port.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "containerPort"},
+			},
+		},
 	}
 }
 
@@ -1527,6 +1978,37 @@ func schema_pkg_apis_trainer_v1alpha1_ReplicatedJobPatch(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_trainer_v1alpha1_RayMLPolicySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RayMLPolicySource represents a Ray runtime configuration. The node with the completion index 0 starts the Ray head and runs the training entrypoint, and the other nodes join the Ray cluster as the workers.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_RunPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RunPolicy represents the policies applied to the TrainJob resources at runtime.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cleanPodPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cleanPodPolicy defines which Pods are deleted once the TrainJob is finished. All deletes all the Pods, Running deletes only the Pods which are still pending or running, and None keeps all the Pods. Defaults to None.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_trainer_v1alpha1_RuntimePatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1557,64 +2039,180 @@ func schema_pkg_apis_trainer_v1alpha1_RuntimePatch(ref common.ReferenceCallback)
 				Required: []string{"manager"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TrainingRuntimeSpecPatch", metav1.Time{}.OpenAPIModelName()},
+		Dependencies: []string{
+			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TrainingRuntimeSpecPatch", metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_RuntimeRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeRef represents the reference to the existing training runtime.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the runtime being referenced. When namespaced-scoped TrainingRuntime is used, the TrainJob must have the same namespace as the deployed runtime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiGroup of the runtime being referenced. Defaults to `trainer.kubeflow.org`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "kind of the runtime being referenced. Defaults to ClusterTrainingRuntime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_Spot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Spot represents the placement of the training nodes on the spot nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeLabels are the node labels identifying the spot nodes, for example `cloud.google.com/gke-spot: \"true\"`. They are translated into the required node affinity of the trainer, which excludes the spot nodes instead once the TrainJob falls back to the on-demand nodes.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tolerations is the list of tolerations for the spot node taints. They are not added once the TrainJob falls back to the on-demand nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1.Toleration{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"fallbackAfterSchedulingFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "fallbackAfterSchedulingFailures is the number of the trainer Pods failing to be scheduled on the spot nodes, after which the trainer is recreated on the on-demand nodes. The fallback is reported by the SpotFallback condition. Defaults to no fallback.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"nodeLabels"},
+			},
+		},
+		Dependencies: []string{
+			corev1.Toleration{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_trainer_v1alpha1_TensorFlowMLPolicySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TensorFlowMLPolicySource represents a TensorFlow runtime configuration. The TF_CONFIG env is constructed for the MultiWorkerMirroredStrategy, where every node is a worker and the worker with the completion index 0 acts as the chief.",
+				Type:        []string{"object"},
+			},
+		},
 	}
 }
 
-func schema_pkg_apis_trainer_v1alpha1_RuntimeRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_trainer_v1alpha1_TorchMLPolicySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RuntimeRef represents the reference to the existing training runtime.",
+				Description: "TorchMLPolicySource represents a PyTorch runtime configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"envInjection": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name of the runtime being referenced. When namespaced-scoped TrainingRuntime is used, the TrainJob must have the same namespace as the deployed runtime.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "envInjection configures which additional containers should receive the PET_* environment variables. By default, the PET_* variables are injected only into the main \"node\" container. Use this field to also inject them into selected sidecar or init containers. For torchtune, envInjection targets still receive PET_MASTER_ADDR and PET_MASTER_PORT even though the main trainer container uses command-line rendezvous instead. Defaults to empty (main container only).",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.EnvInjection"),
 						},
 					},
-					"apiGroup": {
+					"numProcPerNode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "apiGroup of the runtime being referenced. Defaults to `trainer.kubeflow.org`.",
+							Description: "numProcPerNode is the number of processes per node, which is set to PET_NPROC_PER_NODE. It can be a positive integer or one of \"auto\", \"cpu\", \"gpu\", and \"memory\". The \"cpu\" and \"gpu\" modes resolve to the number of CPUs and GPUs requested by the trainer container, defaulting to 1 when none are requested. The GPUs of any vendor are counted, for example nvidia.com/gpu or amd.com/gpu. The \"memory\" mode resolves to the memory requested by the trainer container divided by memoryPerProcess. The numProcPerNode of the TrainJob trainer takes precedence. Defaults to \"auto\".",
+							Ref:         ref(intstr.IntOrString{}.OpenAPIModelName()),
+						},
+					},
+					"memoryPerProcess": {
+						SchemaProps: spec.SchemaProps{
+							Description: "memoryPerProcess is the memory budget of each training process for the \"memory\" numProcPerNode mode. The number of processes per node is the memory requested by the trainer container divided by this budget, defaulting to 1 when the memory is not requested or the budget is not set.",
+							Ref:         ref(resource.Quantity{}.OpenAPIModelName()),
+						},
+					},
+					"numProcPerNodeLabel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "numProcPerNodeLabel is the Node label holding the number of processes per node, for example for the clusters labeling the nodes with their GPU count. The label of the Node which the trainer Pod is scheduled to is copied to the trainer Pod label, which is set to PET_NPROC_PER_NODE through the downward API. It takes precedence over numProcPerNode, while the numProcPerNode of the TrainJob trainer takes precedence over it.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"minNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "kind of the runtime being referenced. Defaults to ClusterTrainingRuntime.",
+							Description: "minNodes is the minimum number of nodes for the PyTorch elastic training. When minNodes and maxNodes are set, torchrun uses the c10d rendezvous backend and the training proceeds with any number of nodes within the range.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxNodes is the maximum number of nodes for the PyTorch elastic training. It must be set together with minNodes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"rdzvBackend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "rdzvBackend is the torchrun rendezvous backend. The static backend uses the rank-0 node as the master address, while the other backends use the rendezvous endpoint and the TrainJob UID as the rendezvous id. Defaults to c10d when minNodes and maxNodes are set, otherwise to static.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_trainer_v1alpha1_TorchMLPolicySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TorchMLPolicySource represents a PyTorch runtime configuration.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"envInjection": {
+					"rdzvEndpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "envInjection configures which additional containers should receive the PET_* environment variables. By default, the PET_* variables are injected only into the main \"node\" container. Use this field to also inject them into selected sidecar or init containers. For torchtune, envInjection targets still receive PET_MASTER_ADDR and PET_MASTER_PORT even though the main trainer container uses command-line rendezvous instead. Defaults to empty (main container only).",
-							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.EnvInjection"),
+							Description: "rdzvEndpoint is the rendezvous endpoint in the host:port format. It is required for the etcd and etcd-v2 backends. Defaults to the rank-0 node address for the c10d backend.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.EnvInjection"},
+			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.EnvInjection", resource.Quantity{}.OpenAPIModelName(), intstr.IntOrString{}.OpenAPIModelName()},
 	}
 }
 
@@ -1741,10 +2339,16 @@ func schema_pkg_apis_trainer_v1alpha1_TrainJobSpec(ref common.ReferenceCallback)
 					},
 					"trainer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "trainer defines the configuration of the trainer.",
+							Description: "trainer defines the configuration of the trainer. The trainer is immutable except numNodes, which can be changed while the TrainJob is suspended.",
 							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Trainer"),
 						},
 					},
+					"checkpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "checkpoint defines the checkpoint to resume the training from.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.CheckpointConfig"),
+						},
+					},
 					"runtimePatches": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -1781,6 +2385,12 @@ func schema_pkg_apis_trainer_v1alpha1_TrainJobSpec(ref common.ReferenceCallback)
 							Format:      "int64",
 						},
 					},
+					"runPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "runPolicy defines the policies applied to the TrainJob resources at runtime.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RunPolicy"),
+						},
+					},
 					"managedBy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "managedBy is used to indicate the controller or entity that manages a TrainJob. The value must be either an empty, `trainer.kubeflow.org/trainjob-controller` or `kueue.x-k8s.io/multikueue`. The built-in TrainJob controller reconciles TrainJob which don't have this field at all or the field value is the reserved string `trainer.kubeflow.org/trainjob-controller`, but delegates reconciling TrainJobs with a 'kueue.x-k8s.io/multikueue' to the Kueue. The field is immutable.",
@@ -1793,7 +2403,7 @@ func schema_pkg_apis_trainer_v1alpha1_TrainJobSpec(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Initializer", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RuntimePatch", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RuntimeRef", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Trainer"},
+			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.CheckpointConfig", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Initializer", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RunPolicy", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RuntimePatch", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.RuntimeRef", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Trainer"},
 	}
 }
 
@@ -1854,11 +2464,70 @@ func schema_pkg_apis_trainer_v1alpha1_TrainJobStatus(ref common.ReferenceCallbac
 							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TrainerStatus"),
 						},
 					},
+					"effectiveCommand": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "effectiveCommand is the final command and arguments of the trainer node container, as rendered into the runtime resources after all the runtime plugins applied. It is recorded for audit and reproducibility purposes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"totalResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "totalResources is the total amount of compute resources requested by all the Pods of the TrainJob. For the gang-scheduled TrainJob, it matches the minResources of the PodGroup.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"jobSetStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "jobSetStatus mirrors the high-level status of the JobSet created for the TrainJob.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobSetStatus"),
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "startTime is the time when the TrainJob was started, or resumed after the suspension. It is reset when the TrainJob is suspended.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "completionTime is the time when the TrainJob completed. It is not set if the TrainJob failed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restartCount is the number of times the JobSet of the TrainJob has been restarted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobStatus", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TrainerStatus", metav1.Condition{}.OpenAPIModelName()},
+			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobSetStatus", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.JobStatus", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.TrainerStatus", resource.Quantity{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -1938,6 +2607,25 @@ func schema_pkg_apis_trainer_v1alpha1_Trainer(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"envFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "envFrom is the list of sources to populate environment variables in the training container. These values will be appended to the TrainingRuntime's trainer envFrom sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1.EnvFromSource{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 					"numNodes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "numNodes is the number of training nodes.",
@@ -1951,6 +2639,21 @@ func schema_pkg_apis_trainer_v1alpha1_Trainer(ref common.ReferenceCallback) comm
 							Ref:         ref(corev1.ResourceRequirements{}.OpenAPIModelName()),
 						},
 					},
+					"resourcesPerContainer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resourcesPerContainer defines the compute resources for the containers of each training node by the container name, for example the sidecar containers. The containers which are not listed keep the runtime resources. The resources for the node container take precedence over resourcesPerNode.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1.ResourceRequirements{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 					"numProcPerNode": {
 						SchemaProps: spec.SchemaProps{
 							Description: "numProcPerNode is the number of processes/workers/slots on every training node. For the MPI runtime only int value can be set to represent number of slots per node. For the Torch runtime the value defaults to `auto` and can be overridden with an int.",
@@ -1958,11 +2661,140 @@ func schema_pkg_apis_trainer_v1alpha1_Trainer(ref common.ReferenceCallback) comm
 							Format:      "int32",
 						},
 					},
+					"addCapabilities": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "addCapabilities is the list of Linux capabilities to add to the training container security context. Only capabilities required by RDMA/InfiniBand networking are allowed. For example, `IPC_LOCK` is needed to pin memory for RDMA.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"pipPackages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "pipPackages is the list of extra Python packages to install with pip before training, for example `transformers==4.46.0`. The packages are installed by an init container into a volume shared with the training container. It requires the pip install to be enabled in the Trainer controller configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeSelector is the node selector to place the training nodes on specific nodes. These values will be merged with the TrainingRuntime's trainer node selector, and take precedence over the runtime values with the same keys.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tolerations is the list of tolerations for the training nodes. These values will be merged with the TrainingRuntime's trainer tolerations, and replace the runtime tolerations with the same keys.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1.Toleration{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"imagePullSecrets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "imagePullSecrets is the list of Secrets to pull the trainer images, for example from a private registry. These values will be merged with the TrainingRuntime's trainer image pull secrets without duplicates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1.LocalObjectReference{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"gpuTopology": {
+						SchemaProps: spec.SchemaProps{
+							Description: "gpuTopology requests the topology-aware placement of the training nodes, for example to place all nodes within the same NVLink domain. The placement is requested with the Pod annotations consumed by the Kueue Topology Aware Scheduling.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.GPUTopology"),
+						},
+					},
+					"gpuProduct": {
+						SchemaProps: spec.SchemaProps{
+							Description: "gpuProduct is the GPU product to place the training nodes on, for example `NVIDIA-H100-80GB-HBM3`. It is translated into the required node affinity on the `nvidia.com/gpu.product` node label, and combined with the TrainingRuntime's trainer node affinity.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "canary runs the trainer with a single node as a smoke test before it is scaled to numNodes, for example to catch the image or entrypoint errors cheaply. The trainer is scaled to numNodes once the canary succeeds, which is reported by the Canary condition. The TrainJob fails if the canary fails. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"heartbeat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "heartbeat configures the liveness probe restarting the trainer container when the training stalls, for example when the distributed job is deadlocked.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Heartbeat"),
+						},
+					},
+					"spot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spot places the training nodes on the spot or preemptible nodes for cost savings, with an optional fallback to the on-demand nodes.",
+							Ref:         ref("github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Spot"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			corev1.EnvVar{}.OpenAPIModelName(), corev1.ResourceRequirements{}.OpenAPIModelName()},
+			"github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.GPUTopology", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Heartbeat", "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1.Spot", corev1.EnvFromSource{}.OpenAPIModelName(), corev1.EnvVar{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName(), corev1.ResourceRequirements{}.OpenAPIModelName(), corev1.Toleration{}.OpenAPIModelName()},
 	}
 }
 
@@ -2217,6 +3049,15 @@ func schema_pkg_apis_trainer_v1alpha1_XGBoostMLPolicySource(ref common.Reference
 			SchemaProps: spec.SchemaProps{
 				Description: "XGBoostMLPolicySource represents an XGBoost runtime configuration. The number of workers per node is automatically derived from container GPU resources:\n  - GPU training: 1 worker per GPU (from resourcesPerNode)\n  - CPU training: 1 worker per node (each worker utilizes all available CPU cores\n    via XGBoost's multi-threaded execution, controlled by the nthread parameter)\n\nDMLC_NUM_WORKER = numNodes × workersPerNode (where workersPerNode = GPU count or 1)",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "numServers is the number of parameter servers for the XGBoost parameter-server topology. When it is greater than 0, the server PodSet is created from the trainer Job template unless the runtime already defines the server Job, and the DMLC_NUM_SERVER and DMLC_ROLE envs are set. Defaults to 0, which means that the parameter-server topology is disabled.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -58,6 +58,18 @@ func UpsertVolumeMounts(mounts *[]corev1ac.VolumeMountApplyConfiguration, upMoun
 	}
 }
 
+func UpsertTolerations(tolerations *[]corev1ac.TolerationApplyConfiguration, upTolerations ...corev1ac.TolerationApplyConfiguration) {
+	for _, t := range upTolerations {
+		upsert(tolerations, t, byTolerationKey)
+	}
+}
+
+func UpsertImagePullSecrets(secrets *[]corev1ac.LocalObjectReferenceApplyConfiguration, upSecrets ...corev1ac.LocalObjectReferenceApplyConfiguration) {
+	for _, secret := range upSecrets {
+		upsert(secrets, secret, byLocalObjectReferenceName)
+	}
+}
+
 func byEnvVarName(a, b corev1ac.EnvVarApplyConfiguration) bool {
 	return ptr.Equal(a.Name, b.Name)
 }
@@ -74,6 +86,14 @@ func byVolumeMountPath(a, b corev1ac.VolumeMountApplyConfiguration) bool {
 	return ptr.Equal(a.MountPath, b.MountPath)
 }
 
+func byTolerationKey(a, b corev1ac.TolerationApplyConfiguration) bool {
+	return ptr.Equal(a.Key, b.Key)
+}
+
+func byLocalObjectReferenceName(a, b corev1ac.LocalObjectReferenceApplyConfiguration) bool {
+	return ptr.Equal(a.Name, b.Name)
+}
+
 type compare[T any] func(T, T) bool
 
 func upsert[T any](items *[]T, item T, predicate compare[T]) {
@@ -128,6 +148,72 @@ func EnvVars(e ...corev1.EnvVar) []corev1ac.EnvVarApplyConfiguration {
 	return envs
 }
 
+func Toleration(t corev1.Toleration) *corev1ac.TolerationApplyConfiguration {
+	toleration := corev1ac.Toleration()
+	if t.Key != "" {
+		toleration.WithKey(t.Key)
+	}
+	if t.Operator != "" {
+		toleration.WithOperator(t.Operator)
+	}
+	if t.Value != "" {
+		toleration.WithValue(t.Value)
+	}
+	if t.Effect != "" {
+		toleration.WithEffect(t.Effect)
+	}
+	if t.TolerationSeconds != nil {
+		toleration.WithTolerationSeconds(*t.TolerationSeconds)
+	}
+	return toleration
+}
+
+func Tolerations(t ...corev1.Toleration) []corev1ac.TolerationApplyConfiguration {
+	var tolerations []corev1ac.TolerationApplyConfiguration
+	for _, toleration := range t {
+		tolerations = append(tolerations, *Toleration(toleration))
+	}
+	return tolerations
+}
+
+func LocalObjectReferences(refs ...corev1.LocalObjectReference) []corev1ac.LocalObjectReferenceApplyConfiguration {
+	var localObjectReferences []corev1ac.LocalObjectReferenceApplyConfiguration
+	for _, ref := range refs {
+		localObjectReferences = append(localObjectReferences, *corev1ac.LocalObjectReference().WithName(ref.Name))
+	}
+	return localObjectReferences
+}
+
+func EnvFromSource(e corev1.EnvFromSource) *corev1ac.EnvFromSourceApplyConfiguration {
+	envFrom := corev1ac.EnvFromSource()
+	if e.Prefix != "" {
+		envFrom.WithPrefix(e.Prefix)
+	}
+	if ref := e.ConfigMapRef; ref != nil {
+		source := corev1ac.ConfigMapEnvSource().WithName(ref.Name)
+		if optional := ref.Optional; optional != nil {
+			source.WithOptional(*optional)
+		}
+		envFrom.WithConfigMapRef(source)
+	}
+	if ref := e.SecretRef; ref != nil {
+		source := corev1ac.SecretEnvSource().WithName(ref.Name)
+		if optional := ref.Optional; optional != nil {
+			source.WithOptional(*optional)
+		}
+		envFrom.WithSecretRef(source)
+	}
+	return envFrom
+}
+
+func EnvFromSources(e ...corev1.EnvFromSource) []corev1ac.EnvFromSourceApplyConfiguration {
+	var envFrom []corev1ac.EnvFromSourceApplyConfiguration
+	for _, source := range e {
+		envFrom = append(envFrom, *EnvFromSource(source))
+	}
+	return envFrom
+}
+
 func FromTypedObjWithFields[A any](typed client.Object, fields ...string) (*A, error) {
 	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
 	if err != nil {
@@ -150,3 +236,17 @@ func FromTypedObjWithFields[A any](typed client.Object, fields ...string) (*A, e
 	}
 	return objApply, nil
 }
+
+// DeepCopy returns a deep copy of the apply configuration.
+// The apply configurations have no generated DeepCopy, and they only consist of the JSON serializable fields.
+func DeepCopy[A any](objApply *A) (*A, error) {
+	raw, err := json.Marshal(objApply)
+	if err != nil {
+		return nil, err
+	}
+	var clone *A
+	if err = json.Unmarshal(raw, &clone); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
@@ -322,6 +322,92 @@ func TestUpsertVolumeMounts(t *testing.T) {
 	}
 }
 
+func TestUpsertTolerations(t *testing.T) {
+	cases := map[string]struct {
+		existing []corev1ac.TolerationApplyConfiguration
+		toUpsert []corev1ac.TolerationApplyConfiguration
+		want     []corev1ac.TolerationApplyConfiguration
+	}{
+		"update existing toleration with the same key": {
+			existing: []corev1ac.TolerationApplyConfiguration{
+				*corev1ac.Toleration().WithKey("nvidia.com/gpu").WithOperator(corev1.TolerationOpExists),
+			},
+			toUpsert: []corev1ac.TolerationApplyConfiguration{
+				*corev1ac.Toleration().WithKey("nvidia.com/gpu").WithValue("a100").WithEffect(corev1.TaintEffectNoSchedule),
+			},
+			want: []corev1ac.TolerationApplyConfiguration{
+				*corev1ac.Toleration().WithKey("nvidia.com/gpu").WithValue("a100").WithEffect(corev1.TaintEffectNoSchedule),
+			},
+		},
+		"insert new toleration": {
+			existing: []corev1ac.TolerationApplyConfiguration{
+				*corev1ac.Toleration().WithKey("nvidia.com/gpu").WithOperator(corev1.TolerationOpExists),
+			},
+			toUpsert: []corev1ac.TolerationApplyConfiguration{
+				*corev1ac.Toleration().WithKey("pool").WithValue("h100"),
+			},
+			want: []corev1ac.TolerationApplyConfiguration{
+				*corev1ac.Toleration().WithKey("nvidia.com/gpu").WithOperator(corev1.TolerationOpExists),
+				*corev1ac.Toleration().WithKey("pool").WithValue("h100"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tolerations := make([]corev1ac.TolerationApplyConfiguration, len(tc.existing))
+			copy(tolerations, tc.existing)
+			UpsertTolerations(&tolerations, tc.toUpsert...)
+			if diff := cmp.Diff(tc.want, tolerations); diff != "" {
+				t.Errorf("Unexpected tolerations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpsertImagePullSecrets(t *testing.T) {
+	cases := map[string]struct {
+		existing []corev1ac.LocalObjectReferenceApplyConfiguration
+		toUpsert []corev1ac.LocalObjectReferenceApplyConfiguration
+		want     []corev1ac.LocalObjectReferenceApplyConfiguration
+	}{
+		"skip existing secret with the same name": {
+			existing: []corev1ac.LocalObjectReferenceApplyConfiguration{
+				*corev1ac.LocalObjectReference().WithName("registry"),
+			},
+			toUpsert: []corev1ac.LocalObjectReferenceApplyConfiguration{
+				*corev1ac.LocalObjectReference().WithName("registry"),
+			},
+			want: []corev1ac.LocalObjectReferenceApplyConfiguration{
+				*corev1ac.LocalObjectReference().WithName("registry"),
+			},
+		},
+		"insert new secret": {
+			existing: []corev1ac.LocalObjectReferenceApplyConfiguration{
+				*corev1ac.LocalObjectReference().WithName("registry"),
+			},
+			toUpsert: []corev1ac.LocalObjectReferenceApplyConfiguration{
+				*corev1ac.LocalObjectReference().WithName("private-registry"),
+			},
+			want: []corev1ac.LocalObjectReferenceApplyConfiguration{
+				*corev1ac.LocalObjectReference().WithName("registry"),
+				*corev1ac.LocalObjectReference().WithName("private-registry"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			secrets := make([]corev1ac.LocalObjectReferenceApplyConfiguration, len(tc.existing))
+			copy(secrets, tc.existing)
+			UpsertImagePullSecrets(&secrets, tc.toUpsert...)
+			if diff := cmp.Diff(tc.want, secrets); diff != "" {
+				t.Errorf("Unexpected image pull secrets (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestEnvVar(t *testing.T) {
 	cases := map[string]struct {
 		input corev1.EnvVar
@@ -507,6 +593,132 @@ func TestEnvVars(t *testing.T) {
 	}
 }
 
+func TestEnvFromSource(t *testing.T) {
+	cases := map[string]struct {
+		input corev1.EnvFromSource
+		want  *corev1ac.EnvFromSourceApplyConfiguration
+	}{
+		"configmap ref with prefix": {
+			input: corev1.EnvFromSource{
+				Prefix: "CM_",
+				ConfigMapRef: &corev1.ConfigMapEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+				},
+			},
+			want: corev1ac.EnvFromSource().
+				WithPrefix("CM_").
+				WithConfigMapRef(corev1ac.ConfigMapEnvSource().WithName("config")),
+		},
+		"optional secret ref": {
+			input: corev1.EnvFromSource{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+					Optional:             ptr.To(true),
+				},
+			},
+			want: corev1ac.EnvFromSource().
+				WithSecretRef(corev1ac.SecretEnvSource().WithName("secret").WithOptional(true)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result := EnvFromSource(tc.input)
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("Unexpected EnvFromSource (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvFromSources(t *testing.T) {
+	cases := map[string]struct {
+		input []corev1.EnvFromSource
+		want  []corev1ac.EnvFromSourceApplyConfiguration
+	}{
+		"empty input": {
+			input: []corev1.EnvFromSource{},
+			want:  nil,
+		},
+		"multiple sources": {
+			input: []corev1.EnvFromSource{
+				{
+					ConfigMapRef: &corev1.ConfigMapEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+					},
+				},
+				{
+					SecretRef: &corev1.SecretEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+					},
+				},
+			},
+			want: []corev1ac.EnvFromSourceApplyConfiguration{
+				*corev1ac.EnvFromSource().WithConfigMapRef(corev1ac.ConfigMapEnvSource().WithName("config")),
+				*corev1ac.EnvFromSource().WithSecretRef(corev1ac.SecretEnvSource().WithName("secret")),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result := EnvFromSources(tc.input...)
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("Unexpected EnvFromSources (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTolerations(t *testing.T) {
+	cases := map[string]struct {
+		input []corev1.Toleration
+		want  []corev1ac.TolerationApplyConfiguration
+	}{
+		"empty input": {
+			input: []corev1.Toleration{},
+			want:  nil,
+		},
+		"multiple tolerations": {
+			input: []corev1.Toleration{
+				{
+					Key:      "nvidia.com/gpu",
+					Operator: corev1.TolerationOpExists,
+					Effect:   corev1.TaintEffectNoSchedule,
+				},
+				{
+					Key:               "node.kubernetes.io/unreachable",
+					Operator:          corev1.TolerationOpEqual,
+					Value:             "true",
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To[int64](300),
+				},
+			},
+			want: []corev1ac.TolerationApplyConfiguration{
+				*corev1ac.Toleration().
+					WithKey("nvidia.com/gpu").
+					WithOperator(corev1.TolerationOpExists).
+					WithEffect(corev1.TaintEffectNoSchedule),
+				*corev1ac.Toleration().
+					WithKey("node.kubernetes.io/unreachable").
+					WithOperator(corev1.TolerationOpEqual).
+					WithValue("true").
+					WithEffect(corev1.TaintEffectNoExecute).
+					WithTolerationSeconds(300),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result := Tolerations(tc.input...)
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("Unexpected Tolerations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestFromTypedObjWithFields(t *testing.T) {
 	cases := map[string]struct {
 		input     client.Object
@@ -590,3 +802,20 @@ func TestFromTypedObjWithFields(t *testing.T) {
 		})
 	}
 }
+
+func TestDeepCopy(t *testing.T) {
+	orig := corev1ac.Container().
+		WithName("node").
+		WithEnv(corev1ac.EnvVar().WithName("TEST_VAR").WithValue("test-value"))
+	got, err := DeepCopy(orig)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(orig, got); diff != "" {
+		t.Errorf("Unexpected copy (-want +got):\n%s", diff)
+	}
+	got.Env[0].WithValue("updated")
+	if value := ptr.Deref(orig.Env[0].Value, ""); value != "test-value" {
+		t.Errorf("Original env var is changed by the copy: %q", value)
+	}
+}
@@ -40,6 +40,11 @@ type ContainerPatchApplyConfiguration struct {
 	// securityContext patches the container's security context.
 	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/
 	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	// restartPolicy marks the init container as a sidecar when set to Always, so it keeps
+	// running alongside the containers and is counted in the Pod's running resource sum.
+	// This value can only be set for the init containers.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/sidecar-containers/
+	RestartPolicy *corev1.ContainerRestartPolicy `json:"restartPolicy,omitempty"`
 }
 
 // ContainerPatchApplyConfiguration constructs a declarative configuration of the ContainerPatch type for use with
@@ -89,3 +94,11 @@ func (b *ContainerPatchApplyConfiguration) WithSecurityContext(value corev1.Secu
 	b.SecurityContext = &value
 	return b
 }
+
+// WithRestartPolicy sets the RestartPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartPolicy field is set to the value of the last call.
+func (b *ContainerPatchApplyConfiguration) WithRestartPolicy(value corev1.ContainerRestartPolicy) *ContainerPatchApplyConfiguration {
+	b.RestartPolicy = &value
+	return b
+}
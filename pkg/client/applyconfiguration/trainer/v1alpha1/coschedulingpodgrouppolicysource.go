@@ -28,6 +28,10 @@ type CoschedulingPodGroupPolicySourceApplyConfiguration struct {
 	// If the scheduling timeout is equal to 0, the default value is used.
 	// Defaults to 60 seconds.
 	ScheduleTimeoutSeconds *int32 `json:"scheduleTimeoutSeconds,omitempty"`
+	// queue is the name of the scheduler queue for the gang-scheduling.
+	// It is set as the scheduling.x-k8s.io/queue-name label on the PodGroup and the TrainJob Pods.
+	// Defaults to empty, which means that no queue label is set.
+	Queue *string `json:"queue,omitempty"`
 }
 
 // CoschedulingPodGroupPolicySourceApplyConfiguration constructs a declarative configuration of the CoschedulingPodGroupPolicySource type for use with
@@ -43,3 +47,11 @@ func (b *CoschedulingPodGroupPolicySourceApplyConfiguration) WithScheduleTimeout
 	b.ScheduleTimeoutSeconds = &value
 	return b
 }
+
+// WithQueue sets the Queue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Queue field is set to the value of the last call.
+func (b *CoschedulingPodGroupPolicySourceApplyConfiguration) WithQueue(value string) *CoschedulingPodGroupPolicySourceApplyConfiguration {
+	b.Queue = &value
+	return b
+}
@@ -34,12 +34,25 @@ type DatasetInitializerApplyConfiguration struct {
 	// If set, it may be empty, or it must be a valid URI format
 	// (e.g., s3://bucket/path, gs://bucket/path).
 	StorageUri *string `json:"storageUri,omitempty"`
+	// datasets is the list of datasets to initialize, each mounted at its own path in the trainer Pods.
+	// A dataset initializer Job is created for every dataset from the runtime dataset initializer Job.
+	// This field is mutually exclusive with storageUri.
+	// At most 4 datasets are allowed, since JobSet limits the trainer Job to 5 dependencies, including the model initializer Job.
+	Datasets []DatasetSourceApplyConfiguration `json:"datasets,omitempty"`
 	// env is the list of environment variables to set in the dataset initializer container.
 	// These values will be merged with the TrainingRuntime's dataset initializer environments.
 	Env []v1.EnvVarApplyConfiguration `json:"env,omitempty"`
+	// envFrom is the list of sources to populate environment variables in the dataset initializer container.
+	// These values will be appended to the TrainingRuntime's dataset initializer envFrom sources.
+	EnvFrom []v1.EnvFromSourceApplyConfiguration `json:"envFrom,omitempty"`
 	// secretRef is the reference to the secret with credentials to download dataset.
 	// Secret must be created in the TrainJob's namespace.
 	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// parallelism is the number of dataset initializer Pods running in parallel,
+	// for example to download the dataset shards concurrently.
+	// It overrides the parallelism and completions of the dataset initializer Job.
+	// Defaults to the parallelism of the dataset initializer Job in the runtime.
+	Parallelism *int32 `json:"parallelism,omitempty"`
 }
 
 // DatasetInitializerApplyConfiguration constructs a declarative configuration of the DatasetInitializer type for use with
@@ -56,6 +69,19 @@ func (b *DatasetInitializerApplyConfiguration) WithStorageUri(value string) *Dat
 	return b
 }
 
+// WithDatasets adds the given value to the Datasets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Datasets field.
+func (b *DatasetInitializerApplyConfiguration) WithDatasets(values ...*DatasetSourceApplyConfiguration) *DatasetInitializerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDatasets")
+		}
+		b.Datasets = append(b.Datasets, *values[i])
+	}
+	return b
+}
+
 // WithEnv adds the given value to the Env field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Env field.
@@ -69,6 +95,19 @@ func (b *DatasetInitializerApplyConfiguration) WithEnv(values ...*v1.EnvVarApply
 	return b
 }
 
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *DatasetInitializerApplyConfiguration) WithEnvFrom(values ...*v1.EnvFromSourceApplyConfiguration) *DatasetInitializerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnvFrom")
+		}
+		b.EnvFrom = append(b.EnvFrom, *values[i])
+	}
+	return b
+}
+
 // WithSecretRef sets the SecretRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SecretRef field is set to the value of the last call.
@@ -76,3 +115,11 @@ func (b *DatasetInitializerApplyConfiguration) WithSecretRef(value corev1.LocalO
 	b.SecretRef = &value
 	return b
 }
+
+// WithParallelism sets the Parallelism field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parallelism field is set to the value of the last call.
+func (b *DatasetInitializerApplyConfiguration) WithParallelism(value int32) *DatasetInitializerApplyConfiguration {
+	b.Parallelism = &value
+	return b
+}
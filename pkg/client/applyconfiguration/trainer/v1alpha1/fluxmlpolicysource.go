@@ -25,6 +25,23 @@ package v1alpha1
 type FluxMLPolicySourceApplyConfiguration struct {
 	// numProcPerNode is the number of processes per node.
 	NumProcPerNode *int32 `json:"numProcPerNode,omitempty"`
+	// viewImage is the container image of the Flux view installed into the trainer nodes by the init container.
+	// The OS and version of the view image must match the trainer image.
+	// Defaults to ghcr.io/converged-computing/flux-view-ubuntu:tag-jammy.
+	// It can be overridden by the FLUX_VIEW_IMAGE env in the trainer.
+	ViewImage *string `json:"viewImage,omitempty"`
+	// networkDevice is the network device the Flux brokers bind to for the tree-based overlay network.
+	// Defaults to eth0.
+	// It can be overridden by the FLUX_NETWORK_DEVICE env in the trainer.
+	NetworkDevice *string `json:"networkDevice,omitempty"`
+	// queuePolicy is the queue policy of the Flux scheduler.
+	// Defaults to fcfs.
+	// It can be overridden by the FLUX_QUEUE_POLICY env in the trainer.
+	QueuePolicy *string `json:"queuePolicy,omitempty"`
+	// brokerPort is the port for the Flux brokers communication.
+	// Defaults to 8050.
+	// It can be overridden by the FLUX_BROKER_PORT env in the trainer.
+	BrokerPort *int32 `json:"brokerPort,omitempty"`
 }
 
 // FluxMLPolicySourceApplyConfiguration constructs a declarative configuration of the FluxMLPolicySource type for use with
@@ -40,3 +57,35 @@ func (b *FluxMLPolicySourceApplyConfiguration) WithNumProcPerNode(value int32) *
 	b.NumProcPerNode = &value
 	return b
 }
+
+// WithViewImage sets the ViewImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ViewImage field is set to the value of the last call.
+func (b *FluxMLPolicySourceApplyConfiguration) WithViewImage(value string) *FluxMLPolicySourceApplyConfiguration {
+	b.ViewImage = &value
+	return b
+}
+
+// WithNetworkDevice sets the NetworkDevice field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkDevice field is set to the value of the last call.
+func (b *FluxMLPolicySourceApplyConfiguration) WithNetworkDevice(value string) *FluxMLPolicySourceApplyConfiguration {
+	b.NetworkDevice = &value
+	return b
+}
+
+// WithQueuePolicy sets the QueuePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QueuePolicy field is set to the value of the last call.
+func (b *FluxMLPolicySourceApplyConfiguration) WithQueuePolicy(value string) *FluxMLPolicySourceApplyConfiguration {
+	b.QueuePolicy = &value
+	return b
+}
+
+// WithBrokerPort sets the BrokerPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BrokerPort field is set to the value of the last call.
+func (b *FluxMLPolicySourceApplyConfiguration) WithBrokerPort(value int32) *FluxMLPolicySourceApplyConfiguration {
+	b.BrokerPort = &value
+	return b
+}
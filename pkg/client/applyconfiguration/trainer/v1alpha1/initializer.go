@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	trainerv1alpha1 "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+)
+
 // InitializerApplyConfiguration represents a declarative configuration of the Initializer type for use
 // with apply.
 //
@@ -28,6 +32,11 @@ type InitializerApplyConfiguration struct {
 	Dataset *DatasetInitializerApplyConfiguration `json:"dataset,omitempty"`
 	// model defines the configuration for the pre-trained model initialization
 	Model *ModelInitializerApplyConfiguration `json:"model,omitempty"`
+	// trainerDependency is the status of the initializer Jobs which the trainer Job waits for before it starts.
+	// Ready starts the trainer once the initializer Pods are ready, for example to stream the dataset while the trainer runs.
+	// Complete starts the trainer once the initializers complete.
+	// Defaults to the dependsOn status of the TrainingRuntime.
+	TrainerDependency *trainerv1alpha1.InitializerDependency `json:"trainerDependency,omitempty"`
 }
 
 // InitializerApplyConfiguration constructs a declarative configuration of the Initializer type for use with
@@ -51,3 +60,11 @@ func (b *InitializerApplyConfiguration) WithModel(value *ModelInitializerApplyCo
 	b.Model = value
 	return b
 }
+
+// WithTrainerDependency sets the TrainerDependency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrainerDependency field is set to the value of the last call.
+func (b *InitializerApplyConfiguration) WithTrainerDependency(value trainerv1alpha1.InitializerDependency) *InitializerApplyConfiguration {
+	b.TrainerDependency = &value
+	return b
+}
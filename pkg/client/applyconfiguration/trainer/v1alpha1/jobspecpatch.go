@@ -25,6 +25,9 @@ package v1alpha1
 type JobSpecPatchApplyConfiguration struct {
 	// template patches the Pod template for this Job.
 	Template *PodTemplatePatchApplyConfiguration `json:"template,omitempty"`
+	// activeDeadlineSeconds patches the duration in seconds relative to the start time
+	// that the Job may be active before the system tries to terminate it, for example to bound the initializer Jobs.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
 }
 
 // JobSpecPatchApplyConfiguration constructs a declarative configuration of the JobSpecPatch type for use with
@@ -40,3 +43,11 @@ func (b *JobSpecPatchApplyConfiguration) WithTemplate(value *PodTemplatePatchApp
 	b.Template = value
 	return b
 }
+
+// WithActiveDeadlineSeconds sets the ActiveDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDeadlineSeconds field is set to the value of the last call.
+func (b *JobSpecPatchApplyConfiguration) WithActiveDeadlineSeconds(value int64) *JobSpecPatchApplyConfiguration {
+	b.ActiveDeadlineSeconds = &value
+	return b
+}
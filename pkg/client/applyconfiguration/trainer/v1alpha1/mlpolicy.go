@@ -30,6 +30,20 @@ type MLPolicyApplyConfiguration struct {
 	// numNodes is the number of training nodes.
 	// Defaults to 1.
 	NumNodes *int32 `json:"numNodes,omitempty"`
+	// trainerPort is the port for the trainer nodes communication, for example the PyTorch master port
+	// or the XGBoost tracker port. It can be changed to avoid the port collisions on the nodes with host networking.
+	// Defaults to 29500.
+	TrainerPort *int32 `json:"trainerPort,omitempty"`
+	// trainerPortName is the name of the trainer port in the trainer node container,
+	// so that it can be referenced by name, for example from the Service or the probes.
+	TrainerPortName *string `json:"trainerPortName,omitempty"`
+	// additionalPorts are the named ports exposed by the trainer node container in addition to the trainer port,
+	// for example the metrics port.
+	AdditionalPorts []NamedPortApplyConfiguration `json:"additionalPorts,omitempty"`
+	// singleProcessPerNode declares that the runtime only supports a single training process per node,
+	// for example the legacy frameworks, so the TrainJob can not set numProcPerNode other than 1.
+	// Defaults to false.
+	SingleProcessPerNode *bool `json:"singleProcessPerNode,omitempty"`
 	// Configuration for the runtime-specific parameters, such as Torch, Flux, or MPI.
 	// Only one of its members may be specified.
 	MLPolicySourceApplyConfiguration `json:",inline"`
@@ -49,6 +63,43 @@ func (b *MLPolicyApplyConfiguration) WithNumNodes(value int32) *MLPolicyApplyCon
 	return b
 }
 
+// WithTrainerPort sets the TrainerPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrainerPort field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithTrainerPort(value int32) *MLPolicyApplyConfiguration {
+	b.TrainerPort = &value
+	return b
+}
+
+// WithTrainerPortName sets the TrainerPortName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrainerPortName field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithTrainerPortName(value string) *MLPolicyApplyConfiguration {
+	b.TrainerPortName = &value
+	return b
+}
+
+// WithAdditionalPorts adds the given value to the AdditionalPorts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalPorts field.
+func (b *MLPolicyApplyConfiguration) WithAdditionalPorts(values ...*NamedPortApplyConfiguration) *MLPolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalPorts")
+		}
+		b.AdditionalPorts = append(b.AdditionalPorts, *values[i])
+	}
+	return b
+}
+
+// WithSingleProcessPerNode sets the SingleProcessPerNode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SingleProcessPerNode field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithSingleProcessPerNode(value bool) *MLPolicyApplyConfiguration {
+	b.SingleProcessPerNode = &value
+	return b
+}
+
 // WithTorch sets the Torch field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Torch field is set to the value of the last call.
@@ -84,7 +135,39 @@ func (b *MLPolicyApplyConfiguration) WithJAX(value trainerv1alpha1.JAXMLPolicySo
 // WithXGBoost sets the XGBoost field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the XGBoost field is set to the value of the last call.
-func (b *MLPolicyApplyConfiguration) WithXGBoost(value trainerv1alpha1.XGBoostMLPolicySource) *MLPolicyApplyConfiguration {
-	b.MLPolicySourceApplyConfiguration.XGBoost = &value
+func (b *MLPolicyApplyConfiguration) WithXGBoost(value *XGBoostMLPolicySourceApplyConfiguration) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.XGBoost = value
+	return b
+}
+
+// WithDeepSpeed sets the DeepSpeed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeepSpeed field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithDeepSpeed(value *DeepSpeedMLPolicySourceApplyConfiguration) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.DeepSpeed = value
+	return b
+}
+
+// WithTensorFlow sets the TensorFlow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TensorFlow field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithTensorFlow(value trainerv1alpha1.TensorFlowMLPolicySource) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.TensorFlow = &value
+	return b
+}
+
+// WithRay sets the Ray field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ray field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithRay(value trainerv1alpha1.RayMLPolicySource) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.Ray = &value
+	return b
+}
+
+// WithHorovod sets the Horovod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Horovod field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithHorovod(value *HorovodMLPolicySourceApplyConfiguration) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.Horovod = value
 	return b
 }
@@ -37,7 +37,17 @@ type MLPolicySourceApplyConfiguration struct {
 	// jax defines the configuration for the JAX Runtime
 	JAX *trainerv1alpha1.JAXMLPolicySource `json:"jax,omitempty"`
 	// xgboost defines the configuration for the XGBoost Runtime.
-	XGBoost *trainerv1alpha1.XGBoostMLPolicySource `json:"xgboost,omitempty"`
+	XGBoost *XGBoostMLPolicySourceApplyConfiguration `json:"xgboost,omitempty"`
+	// deepspeed defines the configuration for the DeepSpeed runtime.
+	DeepSpeed *DeepSpeedMLPolicySourceApplyConfiguration `json:"deepspeed,omitempty"`
+	// tensorflow defines the configuration for the TensorFlow runtime.
+	TensorFlow *trainerv1alpha1.TensorFlowMLPolicySource `json:"tensorflow,omitempty"`
+	// ray defines the configuration for the Ray runtime.
+	Ray *trainerv1alpha1.RayMLPolicySource `json:"ray,omitempty"`
+	// horovod defines the configuration for the Horovod runtime.
+	// It must be configured together with mpi of the OpenMPI implementation, which provides
+	// the SSH keys and the hostfile.
+	Horovod *HorovodMLPolicySourceApplyConfiguration `json:"horovod,omitempty"`
 }
 
 // MLPolicySourceApplyConfiguration constructs a declarative configuration of the MLPolicySource type for use with
@@ -81,7 +91,39 @@ func (b *MLPolicySourceApplyConfiguration) WithJAX(value trainerv1alpha1.JAXMLPo
 // WithXGBoost sets the XGBoost field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the XGBoost field is set to the value of the last call.
-func (b *MLPolicySourceApplyConfiguration) WithXGBoost(value trainerv1alpha1.XGBoostMLPolicySource) *MLPolicySourceApplyConfiguration {
-	b.XGBoost = &value
+func (b *MLPolicySourceApplyConfiguration) WithXGBoost(value *XGBoostMLPolicySourceApplyConfiguration) *MLPolicySourceApplyConfiguration {
+	b.XGBoost = value
+	return b
+}
+
+// WithDeepSpeed sets the DeepSpeed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeepSpeed field is set to the value of the last call.
+func (b *MLPolicySourceApplyConfiguration) WithDeepSpeed(value *DeepSpeedMLPolicySourceApplyConfiguration) *MLPolicySourceApplyConfiguration {
+	b.DeepSpeed = value
+	return b
+}
+
+// WithTensorFlow sets the TensorFlow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TensorFlow field is set to the value of the last call.
+func (b *MLPolicySourceApplyConfiguration) WithTensorFlow(value trainerv1alpha1.TensorFlowMLPolicySource) *MLPolicySourceApplyConfiguration {
+	b.TensorFlow = &value
+	return b
+}
+
+// WithRay sets the Ray field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ray field is set to the value of the last call.
+func (b *MLPolicySourceApplyConfiguration) WithRay(value trainerv1alpha1.RayMLPolicySource) *MLPolicySourceApplyConfiguration {
+	b.Ray = &value
+	return b
+}
+
+// WithHorovod sets the Horovod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Horovod field is set to the value of the last call.
+func (b *MLPolicySourceApplyConfiguration) WithHorovod(value *HorovodMLPolicySourceApplyConfiguration) *MLPolicySourceApplyConfiguration {
+	b.Horovod = value
 	return b
 }
@@ -37,6 +37,9 @@ type ModelInitializerApplyConfiguration struct {
 	// env is the list of environment variables to set in the model initializer container.
 	// These values will be merged with the TrainingRuntime's model initializer environments.
 	Env []v1.EnvVarApplyConfiguration `json:"env,omitempty"`
+	// envFrom is the list of sources to populate environment variables in the model initializer container.
+	// These values will be appended to the TrainingRuntime's model initializer envFrom sources.
+	EnvFrom []v1.EnvFromSourceApplyConfiguration `json:"envFrom,omitempty"`
 	// secretRef is the reference to the secret with credentials to download model.
 	// Secret must be created in the TrainJob's namespace.
 	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
@@ -69,6 +72,19 @@ func (b *ModelInitializerApplyConfiguration) WithEnv(values ...*v1.EnvVarApplyCo
 	return b
 }
 
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *ModelInitializerApplyConfiguration) WithEnvFrom(values ...*v1.EnvFromSourceApplyConfiguration) *ModelInitializerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnvFrom")
+		}
+		b.EnvFrom = append(b.EnvFrom, *values[i])
+	}
+	return b
+}
+
 // WithSecretRef sets the SecretRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SecretRef field is set to the value of the last call.
@@ -40,6 +40,14 @@ type MPIMLPolicySourceApplyConfiguration struct {
 	// runLauncherAsNode defines whether to run training process on the launcher Job.
 	// Defaults to false.
 	RunLauncherAsNode *bool `json:"runLauncherAsNode,omitempty"`
+	// launcherBinary is the binary starting the MPI processes on the launcher, for example mpirun or mpiexec.
+	// When set, it replaces the first element of the launcher container command in the runtime,
+	// while the command of the TrainJob trainer is used as is.
+	LauncherBinary *string `json:"launcherBinary,omitempty"`
+	// mountHostfileOnNodes defines whether to mount the generated hostfile on the node Jobs as well as the launcher Job,
+	// for the MPI setups where the training processes on the nodes read the hostfile.
+	// Defaults to false.
+	MountHostfileOnNodes *bool `json:"mountHostfileOnNodes,omitempty"`
 }
 
 // MPIMLPolicySourceApplyConfiguration constructs a declarative configuration of the MPIMLPolicySource type for use with
@@ -79,3 +87,19 @@ func (b *MPIMLPolicySourceApplyConfiguration) WithRunLauncherAsNode(value bool)
 	b.RunLauncherAsNode = &value
 	return b
 }
+
+// WithLauncherBinary sets the LauncherBinary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LauncherBinary field is set to the value of the last call.
+func (b *MPIMLPolicySourceApplyConfiguration) WithLauncherBinary(value string) *MPIMLPolicySourceApplyConfiguration {
+	b.LauncherBinary = &value
+	return b
+}
+
+// WithMountHostfileOnNodes sets the MountHostfileOnNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MountHostfileOnNodes field is set to the value of the last call.
+func (b *MPIMLPolicySourceApplyConfiguration) WithMountHostfileOnNodes(value bool) *MPIMLPolicySourceApplyConfiguration {
+	b.MountHostfileOnNodes = &value
+	return b
+}
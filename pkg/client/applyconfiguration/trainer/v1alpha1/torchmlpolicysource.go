@@ -18,6 +18,12 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	trainerv1alpha1 "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
 // TorchMLPolicySourceApplyConfiguration represents a declarative configuration of the TorchMLPolicySource type for use
 // with apply.
 //
@@ -31,6 +37,40 @@ type TorchMLPolicySourceApplyConfiguration struct {
 	// main trainer container uses command-line rendezvous instead.
 	// Defaults to empty (main container only).
 	EnvInjection *EnvInjectionApplyConfiguration `json:"envInjection,omitempty"`
+	// numProcPerNode is the number of processes per node, which is set to PET_NPROC_PER_NODE.
+	// It can be a positive integer or one of "auto", "cpu", "gpu", and "memory". The "cpu" and "gpu" modes resolve
+	// to the number of CPUs and GPUs requested by the trainer container, defaulting to 1 when none are requested.
+	// The GPUs of any vendor are counted, for example nvidia.com/gpu or amd.com/gpu.
+	// The "memory" mode resolves to the memory requested by the trainer container divided by memoryPerProcess.
+	// The numProcPerNode of the TrainJob trainer takes precedence.
+	// Defaults to "auto".
+	NumProcPerNode *intstr.IntOrString `json:"numProcPerNode,omitempty"`
+	// memoryPerProcess is the memory budget of each training process for the "memory" numProcPerNode mode.
+	// The number of processes per node is the memory requested by the trainer container divided by this budget,
+	// defaulting to 1 when the memory is not requested or the budget is not set.
+	MemoryPerProcess *resource.Quantity `json:"memoryPerProcess,omitempty"`
+	// numProcPerNodeLabel is the Node label holding the number of processes per node,
+	// for example for the clusters labeling the nodes with their GPU count.
+	// The label of the Node which the trainer Pod is scheduled to is copied to the trainer Pod label,
+	// which is set to PET_NPROC_PER_NODE through the downward API.
+	// It takes precedence over numProcPerNode, while the numProcPerNode of the TrainJob trainer takes precedence over it.
+	NumProcPerNodeLabel *string `json:"numProcPerNodeLabel,omitempty"`
+	// minNodes is the minimum number of nodes for the PyTorch elastic training.
+	// When minNodes and maxNodes are set, torchrun uses the c10d rendezvous backend
+	// and the training proceeds with any number of nodes within the range.
+	MinNodes *int32 `json:"minNodes,omitempty"`
+	// maxNodes is the maximum number of nodes for the PyTorch elastic training.
+	// It must be set together with minNodes.
+	MaxNodes *int32 `json:"maxNodes,omitempty"`
+	// rdzvBackend is the torchrun rendezvous backend.
+	// The static backend uses the rank-0 node as the master address, while the other backends
+	// use the rendezvous endpoint and the TrainJob UID as the rendezvous id.
+	// Defaults to c10d when minNodes and maxNodes are set, otherwise to static.
+	RdzvBackend *trainerv1alpha1.RdzvBackend `json:"rdzvBackend,omitempty"`
+	// rdzvEndpoint is the rendezvous endpoint in the host:port format.
+	// It is required for the etcd and etcd-v2 backends.
+	// Defaults to the rank-0 node address for the c10d backend.
+	RdzvEndpoint *string `json:"rdzvEndpoint,omitempty"`
 }
 
 // TorchMLPolicySourceApplyConfiguration constructs a declarative configuration of the TorchMLPolicySource type for use with
@@ -46,3 +86,59 @@ func (b *TorchMLPolicySourceApplyConfiguration) WithEnvInjection(value *EnvInjec
 	b.EnvInjection = value
 	return b
 }
+
+// WithNumProcPerNode sets the NumProcPerNode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NumProcPerNode field is set to the value of the last call.
+func (b *TorchMLPolicySourceApplyConfiguration) WithNumProcPerNode(value intstr.IntOrString) *TorchMLPolicySourceApplyConfiguration {
+	b.NumProcPerNode = &value
+	return b
+}
+
+// WithMemoryPerProcess sets the MemoryPerProcess field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemoryPerProcess field is set to the value of the last call.
+func (b *TorchMLPolicySourceApplyConfiguration) WithMemoryPerProcess(value resource.Quantity) *TorchMLPolicySourceApplyConfiguration {
+	b.MemoryPerProcess = &value
+	return b
+}
+
+// WithNumProcPerNodeLabel sets the NumProcPerNodeLabel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NumProcPerNodeLabel field is set to the value of the last call.
+func (b *TorchMLPolicySourceApplyConfiguration) WithNumProcPerNodeLabel(value string) *TorchMLPolicySourceApplyConfiguration {
+	b.NumProcPerNodeLabel = &value
+	return b
+}
+
+// WithMinNodes sets the MinNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinNodes field is set to the value of the last call.
+func (b *TorchMLPolicySourceApplyConfiguration) WithMinNodes(value int32) *TorchMLPolicySourceApplyConfiguration {
+	b.MinNodes = &value
+	return b
+}
+
+// WithMaxNodes sets the MaxNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxNodes field is set to the value of the last call.
+func (b *TorchMLPolicySourceApplyConfiguration) WithMaxNodes(value int32) *TorchMLPolicySourceApplyConfiguration {
+	b.MaxNodes = &value
+	return b
+}
+
+// WithRdzvBackend sets the RdzvBackend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RdzvBackend field is set to the value of the last call.
+func (b *TorchMLPolicySourceApplyConfiguration) WithRdzvBackend(value trainerv1alpha1.RdzvBackend) *TorchMLPolicySourceApplyConfiguration {
+	b.RdzvBackend = &value
+	return b
+}
+
+// WithRdzvEndpoint sets the RdzvEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RdzvEndpoint field is set to the value of the last call.
+func (b *TorchMLPolicySourceApplyConfiguration) WithRdzvEndpoint(value string) *TorchMLPolicySourceApplyConfiguration {
+	b.RdzvEndpoint = &value
+	return b
+}
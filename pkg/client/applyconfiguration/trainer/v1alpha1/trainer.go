@@ -19,6 +19,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
@@ -38,15 +39,63 @@ type TrainerApplyConfiguration struct {
 	// env is the list of environment variables to set in the training container.
 	// These values will be merged with the TrainingRuntime's trainer environments.
 	Env []v1.EnvVarApplyConfiguration `json:"env,omitempty"`
+	// envFrom is the list of sources to populate environment variables in the training container.
+	// These values will be appended to the TrainingRuntime's trainer envFrom sources.
+	EnvFrom []v1.EnvFromSourceApplyConfiguration `json:"envFrom,omitempty"`
 	// numNodes is the number of training nodes.
 	// TODO (andreyvelich): Do we want to support dynamic num of nodes in TrainJob for PyTorch elastic: `--nnodes=1:4` ?
 	NumNodes *int32 `json:"numNodes,omitempty"`
 	// resourcesPerNode defines the compute resources for each training node.
 	ResourcesPerNode *v1.ResourceRequirementsApplyConfiguration `json:"resourcesPerNode,omitempty"`
+	// resourcesPerContainer defines the compute resources for the containers of each training node
+	// by the container name, for example the sidecar containers. The containers which are not listed
+	// keep the runtime resources. The resources for the node container take precedence over resourcesPerNode.
+	ResourcesPerContainer map[string]v1.ResourceRequirementsApplyConfiguration `json:"resourcesPerContainer,omitempty"`
 	// numProcPerNode is the number of processes/workers/slots on every training node.
 	// For the MPI runtime only int value can be set to represent number of slots per node.
 	// For the Torch runtime the value defaults to `auto` and can be overridden with an int.
 	NumProcPerNode *int32 `json:"numProcPerNode,omitempty"`
+	// addCapabilities is the list of Linux capabilities to add to the training container security context.
+	// Only capabilities required by RDMA/InfiniBand networking are allowed.
+	// For example, `IPC_LOCK` is needed to pin memory for RDMA.
+	AddCapabilities []corev1.Capability `json:"addCapabilities,omitempty"`
+	// pipPackages is the list of extra Python packages to install with pip before training,
+	// for example `transformers==4.46.0`. The packages are installed by an init container
+	// into a volume shared with the training container.
+	// It requires the pip install to be enabled in the Trainer controller configuration.
+	PipPackages []string `json:"pipPackages,omitempty"`
+	// nodeSelector is the node selector to place the training nodes on specific nodes.
+	// These values will be merged with the TrainingRuntime's trainer node selector,
+	// and take precedence over the runtime values with the same keys.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// tolerations is the list of tolerations for the training nodes.
+	// These values will be merged with the TrainingRuntime's trainer tolerations,
+	// and replace the runtime tolerations with the same keys.
+	Tolerations []v1.TolerationApplyConfiguration `json:"tolerations,omitempty"`
+	// imagePullSecrets is the list of Secrets to pull the trainer images, for example from a private registry.
+	// These values will be merged with the TrainingRuntime's trainer image pull secrets without duplicates.
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// gpuTopology requests the topology-aware placement of the training nodes,
+	// for example to place all nodes within the same NVLink domain.
+	// The placement is requested with the Pod annotations consumed by the Kueue
+	// Topology Aware Scheduling.
+	GPUTopology *GPUTopologyApplyConfiguration `json:"gpuTopology,omitempty"`
+	// gpuProduct is the GPU product to place the training nodes on, for example `NVIDIA-H100-80GB-HBM3`.
+	// It is translated into the required node affinity on the `nvidia.com/gpu.product` node label,
+	// and combined with the TrainingRuntime's trainer node affinity.
+	GPUProduct *string `json:"gpuProduct,omitempty"`
+	// canary runs the trainer with a single node as a smoke test before it is scaled to numNodes,
+	// for example to catch the image or entrypoint errors cheaply.
+	// The trainer is scaled to numNodes once the canary succeeds, which is reported
+	// by the Canary condition. The TrainJob fails if the canary fails.
+	// Defaults to false.
+	Canary *bool `json:"canary,omitempty"`
+	// heartbeat configures the liveness probe restarting the trainer container
+	// when the training stalls, for example when the distributed job is deadlocked.
+	Heartbeat *HeartbeatApplyConfiguration `json:"heartbeat,omitempty"`
+	// spot places the training nodes on the spot or preemptible nodes for cost savings,
+	// with an optional fallback to the on-demand nodes.
+	Spot *SpotApplyConfiguration `json:"spot,omitempty"`
 }
 
 // TrainerApplyConfiguration constructs a declarative configuration of the Trainer type for use with
@@ -96,6 +145,19 @@ func (b *TrainerApplyConfiguration) WithEnv(values ...*v1.EnvVarApplyConfigurati
 	return b
 }
 
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *TrainerApplyConfiguration) WithEnvFrom(values ...*v1.EnvFromSourceApplyConfiguration) *TrainerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnvFrom")
+		}
+		b.EnvFrom = append(b.EnvFrom, *values[i])
+	}
+	return b
+}
+
 // WithNumNodes sets the NumNodes field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NumNodes field is set to the value of the last call.
@@ -112,6 +174,20 @@ func (b *TrainerApplyConfiguration) WithResourcesPerNode(value *v1.ResourceRequi
 	return b
 }
 
+// WithResourcesPerContainer puts the entries into the ResourcesPerContainer field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ResourcesPerContainer field,
+// overwriting an existing map entries in ResourcesPerContainer field with the same key.
+func (b *TrainerApplyConfiguration) WithResourcesPerContainer(entries map[string]v1.ResourceRequirementsApplyConfiguration) *TrainerApplyConfiguration {
+	if b.ResourcesPerContainer == nil && len(entries) > 0 {
+		b.ResourcesPerContainer = make(map[string]v1.ResourceRequirementsApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.ResourcesPerContainer[k] = v
+	}
+	return b
+}
+
 // WithNumProcPerNode sets the NumProcPerNode field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NumProcPerNode field is set to the value of the last call.
@@ -119,3 +195,100 @@ func (b *TrainerApplyConfiguration) WithNumProcPerNode(value int32) *TrainerAppl
 	b.NumProcPerNode = &value
 	return b
 }
+
+// WithAddCapabilities adds the given value to the AddCapabilities field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AddCapabilities field.
+func (b *TrainerApplyConfiguration) WithAddCapabilities(values ...corev1.Capability) *TrainerApplyConfiguration {
+	for i := range values {
+		b.AddCapabilities = append(b.AddCapabilities, values[i])
+	}
+	return b
+}
+
+// WithPipPackages adds the given value to the PipPackages field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PipPackages field.
+func (b *TrainerApplyConfiguration) WithPipPackages(values ...string) *TrainerApplyConfiguration {
+	for i := range values {
+		b.PipPackages = append(b.PipPackages, values[i])
+	}
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *TrainerApplyConfiguration) WithNodeSelector(entries map[string]string) *TrainerApplyConfiguration {
+	if b.NodeSelector == nil && len(entries) > 0 {
+		b.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *TrainerApplyConfiguration) WithTolerations(values ...*v1.TolerationApplyConfiguration) *TrainerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTolerations")
+		}
+		b.Tolerations = append(b.Tolerations, *values[i])
+	}
+	return b
+}
+
+// WithImagePullSecrets adds the given value to the ImagePullSecrets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImagePullSecrets field.
+func (b *TrainerApplyConfiguration) WithImagePullSecrets(values ...corev1.LocalObjectReference) *TrainerApplyConfiguration {
+	for i := range values {
+		b.ImagePullSecrets = append(b.ImagePullSecrets, values[i])
+	}
+	return b
+}
+
+// WithGPUTopology sets the GPUTopology field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GPUTopology field is set to the value of the last call.
+func (b *TrainerApplyConfiguration) WithGPUTopology(value *GPUTopologyApplyConfiguration) *TrainerApplyConfiguration {
+	b.GPUTopology = value
+	return b
+}
+
+// WithGPUProduct sets the GPUProduct field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GPUProduct field is set to the value of the last call.
+func (b *TrainerApplyConfiguration) WithGPUProduct(value string) *TrainerApplyConfiguration {
+	b.GPUProduct = &value
+	return b
+}
+
+// WithCanary sets the Canary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Canary field is set to the value of the last call.
+func (b *TrainerApplyConfiguration) WithCanary(value bool) *TrainerApplyConfiguration {
+	b.Canary = &value
+	return b
+}
+
+// WithHeartbeat sets the Heartbeat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Heartbeat field is set to the value of the last call.
+func (b *TrainerApplyConfiguration) WithHeartbeat(value *HeartbeatApplyConfiguration) *TrainerApplyConfiguration {
+	b.Heartbeat = value
+	return b
+}
+
+// WithSpot sets the Spot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spot field is set to the value of the last call.
+func (b *TrainerApplyConfiguration) WithSpot(value *SpotApplyConfiguration) *TrainerApplyConfiguration {
+	b.Spot = value
+	return b
+}
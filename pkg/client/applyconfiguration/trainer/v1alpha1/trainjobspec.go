@@ -28,7 +28,10 @@ type TrainJobSpecApplyConfiguration struct {
 	// initializer defines the configuration of the initializer.
 	Initializer *InitializerApplyConfiguration `json:"initializer,omitempty"`
 	// trainer defines the configuration of the trainer.
+	// The trainer is immutable except numNodes, which can be changed while the TrainJob is suspended.
 	Trainer *TrainerApplyConfiguration `json:"trainer,omitempty"`
+	// checkpoint defines the checkpoint to resume the training from.
+	Checkpoint *CheckpointConfigApplyConfiguration `json:"checkpoint,omitempty"`
 	// runtimePatches defines custom patches applied to the TrainJob's Runtime.
 	// Patches are keyed by manager to provide clear ownership and avoid conflicts between controllers.
 	RuntimePatches []RuntimePatchApplyConfiguration `json:"runtimePatches,omitempty"`
@@ -40,6 +43,8 @@ type TrainJobSpecApplyConfiguration struct {
 	// Once reached, all running Pods are terminated and the TrainJob status becomes
 	// Failed with reason: DeadlineExceeded.
 	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// runPolicy defines the policies applied to the TrainJob resources at runtime.
+	RunPolicy *RunPolicyApplyConfiguration `json:"runPolicy,omitempty"`
 	// managedBy is used to indicate the controller or entity that manages a TrainJob.
 	// The value must be either an empty, `trainer.kubeflow.org/trainjob-controller` or
 	// `kueue.x-k8s.io/multikueue`. The built-in TrainJob controller reconciles TrainJob which
@@ -79,6 +84,14 @@ func (b *TrainJobSpecApplyConfiguration) WithTrainer(value *TrainerApplyConfigur
 	return b
 }
 
+// WithCheckpoint sets the Checkpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Checkpoint field is set to the value of the last call.
+func (b *TrainJobSpecApplyConfiguration) WithCheckpoint(value *CheckpointConfigApplyConfiguration) *TrainJobSpecApplyConfiguration {
+	b.Checkpoint = value
+	return b
+}
+
 // WithRuntimePatches adds the given value to the RuntimePatches field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the RuntimePatches field.
@@ -108,6 +121,14 @@ func (b *TrainJobSpecApplyConfiguration) WithActiveDeadlineSeconds(value int64)
 	return b
 }
 
+// WithRunPolicy sets the RunPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunPolicy field is set to the value of the last call.
+func (b *TrainJobSpecApplyConfiguration) WithRunPolicy(value *RunPolicyApplyConfiguration) *TrainJobSpecApplyConfiguration {
+	b.RunPolicy = value
+	return b
+}
+
 // WithManagedBy sets the ManagedBy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedBy field is set to the value of the last call.
@@ -19,6 +19,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -41,6 +43,23 @@ type TrainJobStatusApplyConfiguration struct {
 	//
 	// This is an alpha feature and requires enabling the TrainJobStatus feature gate.
 	TrainerStatus *TrainerStatusApplyConfiguration `json:"trainerStatus,omitempty"`
+	// effectiveCommand is the final command and arguments of the trainer node container,
+	// as rendered into the runtime resources after all the runtime plugins applied.
+	// It is recorded for audit and reproducibility purposes.
+	EffectiveCommand []string `json:"effectiveCommand,omitempty"`
+	// totalResources is the total amount of compute resources requested by all the Pods of the TrainJob.
+	// For the gang-scheduled TrainJob, it matches the minResources of the PodGroup.
+	TotalResources *corev1.ResourceList `json:"totalResources,omitempty"`
+	// jobSetStatus mirrors the high-level status of the JobSet created for the TrainJob.
+	JobSetStatus *JobSetStatusApplyConfiguration `json:"jobSetStatus,omitempty"`
+	// startTime is the time when the TrainJob was started, or resumed after the suspension.
+	// It is reset when the TrainJob is suspended.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// completionTime is the time when the TrainJob completed.
+	// It is not set if the TrainJob failed.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// restartCount is the number of times the JobSet of the TrainJob has been restarted.
+	RestartCount *int32 `json:"restartCount,omitempty"`
 }
 
 // TrainJobStatusApplyConfiguration constructs a declarative configuration of the TrainJobStatus type for use with
@@ -82,3 +101,53 @@ func (b *TrainJobStatusApplyConfiguration) WithTrainerStatus(value *TrainerStatu
 	b.TrainerStatus = value
 	return b
 }
+
+// WithEffectiveCommand adds the given value to the EffectiveCommand field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EffectiveCommand field.
+func (b *TrainJobStatusApplyConfiguration) WithEffectiveCommand(values ...string) *TrainJobStatusApplyConfiguration {
+	for i := range values {
+		b.EffectiveCommand = append(b.EffectiveCommand, values[i])
+	}
+	return b
+}
+
+// WithTotalResources sets the TotalResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalResources field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithTotalResources(value corev1.ResourceList) *TrainJobStatusApplyConfiguration {
+	b.TotalResources = &value
+	return b
+}
+
+// WithJobSetStatus sets the JobSetStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JobSetStatus field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithJobSetStatus(value *JobSetStatusApplyConfiguration) *TrainJobStatusApplyConfiguration {
+	b.JobSetStatus = value
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithStartTime(value metav1.Time) *TrainJobStatusApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithCompletionTime(value metav1.Time) *TrainJobStatusApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithRestartCount sets the RestartCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartCount field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithRestartCount(value int32) *TrainJobStatusApplyConfiguration {
+	b.RestartCount = &value
+	return b
+}
@@ -32,6 +32,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=trainer.kubeflow.org, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("CheckpointConfig"):
+		return &trainerv1alpha1.CheckpointConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterTrainingRuntime"):
 		return &trainerv1alpha1.ClusterTrainingRuntimeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ContainerPatch"):
@@ -40,16 +42,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &trainerv1alpha1.CoschedulingPodGroupPolicySourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DatasetInitializer"):
 		return &trainerv1alpha1.DatasetInitializerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DatasetSource"):
+		return &trainerv1alpha1.DatasetSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DeepSpeedMLPolicySource"):
+		return &trainerv1alpha1.DeepSpeedMLPolicySourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EnvInjection"):
 		return &trainerv1alpha1.EnvInjectionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EnvInjectionTarget"):
 		return &trainerv1alpha1.EnvInjectionTargetApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FluxMLPolicySource"):
 		return &trainerv1alpha1.FluxMLPolicySourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GPUTopology"):
+		return &trainerv1alpha1.GPUTopologyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Heartbeat"):
+		return &trainerv1alpha1.HeartbeatApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HorovodMLPolicySource"):
+		return &trainerv1alpha1.HorovodMLPolicySourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Initializer"):
 		return &trainerv1alpha1.InitializerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("JobSetSpecPatch"):
 		return &trainerv1alpha1.JobSetSpecPatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JobSetStatus"):
+		return &trainerv1alpha1.JobSetStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("JobSetTemplatePatch"):
 		return &trainerv1alpha1.JobSetTemplatePatchApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("JobSetTemplateSpec"):
@@ -70,6 +84,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &trainerv1alpha1.ModelInitializerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MPIMLPolicySource"):
 		return &trainerv1alpha1.MPIMLPolicySourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamedPort"):
+		return &trainerv1alpha1.NamedPortApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PodGroupPolicy"):
 		return &trainerv1alpha1.PodGroupPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PodGroupPolicySource"):
@@ -80,10 +96,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &trainerv1alpha1.PodTemplatePatchApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ReplicatedJobPatch"):
 		return &trainerv1alpha1.ReplicatedJobPatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RunPolicy"):
+		return &trainerv1alpha1.RunPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RuntimePatch"):
 		return &trainerv1alpha1.RuntimePatchApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RuntimeRef"):
 		return &trainerv1alpha1.RuntimeRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Spot"):
+		return &trainerv1alpha1.SpotApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TorchMLPolicySource"):
 		return &trainerv1alpha1.TorchMLPolicySourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Trainer"):
@@ -104,6 +124,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &trainerv1alpha1.TrainJobStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolcanoPodGroupPolicySource"):
 		return &trainerv1alpha1.VolcanoPodGroupPolicySourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("XGBoostMLPolicySource"):
+		return &trainerv1alpha1.XGBoostMLPolicySourceApplyConfiguration{}
 
 	}
 	return nil
@@ -21,12 +21,18 @@ import (
 	"fmt"
 	"os"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
 	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/util/tlsconfig"
@@ -75,6 +81,8 @@ func addTo(o *ctrl.Options, cfg *configapi.Configuration) {
 
 	o.HealthProbeBindAddress = cfg.Health.HealthProbeBindAddress
 
+	o.Cache = CacheOptions()
+
 	if cfg.LeaderElection != nil {
 		if cfg.LeaderElection.LeaderElect != nil {
 			o.LeaderElection = *cfg.LeaderElection.LeaderElect
@@ -97,6 +105,20 @@ func addTo(o *ctrl.Options, cfg *configapi.Configuration) {
 	}
 }
 
+// CacheOptions returns the manager cache options.
+// The Pods are only looked up by the JobSet name label, so the Pod cache is limited
+// to the JobSet Pods rather than caching all the Pods in the cluster.
+func CacheOptions() cache.Options {
+	jobSetPod, _ := labels.NewRequirement(jobsetv1alpha2.JobSetNameKey, selection.Exists, nil)
+	return cache.Options{
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.Pod{}: {
+				Label: labels.NewSelector().Add(*jobSetPod),
+			},
+		},
+	}
+}
+
 // Load loads configuration from file and returns controller Options and Configuration.
 func Load(scheme *runtime.Scheme, configFile string) (ctrl.Options, configapi.Configuration, error) {
 	options := ctrl.Options{
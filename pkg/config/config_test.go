@@ -26,7 +26,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	componentconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -35,6 +37,7 @@ import (
 	runtimeconfig "sigs.k8s.io/controller-runtime/pkg/config"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
 	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 )
@@ -134,6 +137,68 @@ metrics:
 		t.Fatal(err)
 	}
 
+	reconcileLatencyBucketsConfig := filepath.Join(tmpDir, "reconcile-latency-buckets.yaml")
+	if err := os.WriteFile(reconcileLatencyBucketsConfig, []byte(`
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+metrics:
+  reconcileLatencyBuckets: [0.1, 0.5, 1, 5, 30]
+`), os.FileMode(0600)); err != nil {
+		t.Fatal(err)
+	}
+
+	nonMonotonicReconcileLatencyBucketsConfig := filepath.Join(tmpDir, "non-monotonic-reconcile-latency-buckets.yaml")
+	if err := os.WriteFile(nonMonotonicReconcileLatencyBucketsConfig, []byte(`
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+metrics:
+  reconcileLatencyBuckets: [0.1, 1, 0.5]
+`), os.FileMode(0600)); err != nil {
+		t.Fatal(err)
+	}
+
+	progressConfig := filepath.Join(tmpDir, "progress.yaml")
+	if err := os.WriteFile(progressConfig, []byte(`
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+progress:
+  tokenExpirySeconds: 86400
+`), os.FileMode(0600)); err != nil {
+		t.Fatal(err)
+	}
+
+	shortTokenExpiryProgressConfig := filepath.Join(tmpDir, "short-token-expiry-progress.yaml")
+	if err := os.WriteFile(shortTokenExpiryProgressConfig, []byte(`
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+progress:
+  tokenExpirySeconds: 300
+`), os.FileMode(0600)); err != nil {
+		t.Fatal(err)
+	}
+
+	retryBackoffConfig := filepath.Join(tmpDir, "retry-backoff.yaml")
+	if err := os.WriteFile(retryBackoffConfig, []byte(`
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+retryBackoff:
+  baseDelay: 2s
+  maxDelay: 10m
+`), os.FileMode(0600)); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidRetryBackoffConfig := filepath.Join(tmpDir, "invalid-retry-backoff.yaml")
+	if err := os.WriteFile(invalidRetryBackoffConfig, []byte(`
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+retryBackoff:
+  baseDelay: 10m
+  maxDelay: 2s
+`), os.FileMode(0600)); err != nil {
+		t.Fatal(err)
+	}
+
 	webhookHostConfig := filepath.Join(tmpDir, "webhook-host.yaml")
 	if err := os.WriteFile(webhookHostConfig, []byte(`
 apiVersion: config.trainer.kubeflow.org/v1alpha1
@@ -288,6 +353,10 @@ this is not: valid: yaml: content
 		Burst: ptr.To[int32](10),
 	}
 
+	defaultProgress := &configapi.Progress{
+		TokenExpirySeconds: ptr.To[int64](3600),
+	}
+
 	defaultWebhook := configapi.ControllerWebhook{
 		Port: ptr.To[int32](9443),
 	}
@@ -342,6 +411,7 @@ this is not: valid: yaml: content
 				CertManagement:   defaultCertManagement,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 			},
 			wantOptions: defaultOptions,
 		},
@@ -356,6 +426,7 @@ this is not: valid: yaml: content
 				CertManagement:   defaultCertManagement,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 			},
 			wantOptions: defaultOptions,
 		},
@@ -397,6 +468,7 @@ this is not: valid: yaml: content
 					Burst: ptr.To[int32](200),
 				},
 				StatusServer: defaultStatusServer,
+				Progress:     defaultProgress,
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: ":8082",
@@ -422,6 +494,7 @@ this is not: valid: yaml: content
 				CertManagement:   defaultCertManagement,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 				LeaderElection: &componentconfigv1alpha1.LeaderElectionConfiguration{
 					LeaderElect:       ptr.To(true),
 					ResourceName:      "trainer-leader",
@@ -463,6 +536,7 @@ this is not: valid: yaml: content
 				CertManagement:   defaultCertManagement,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 				Controller: &configapi.ControllerConfigurationSpec{
 					GroupKindConcurrency: map[string]int32{
 						"TrainJob.trainer.kubeflow.org":               10,
@@ -501,6 +575,7 @@ this is not: valid: yaml: content
 				Health:           defaultHealth,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 				CertManagement: &configapi.CertManagement{
 					Enable:             ptr.To(true),
 					WebhookServiceName: "custom-webhook-service",
@@ -519,6 +594,7 @@ this is not: valid: yaml: content
 				Health:           defaultHealth,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 				CertManagement: &configapi.CertManagement{
 					Enable:             ptr.To(false),
 					WebhookServiceName: "kubeflow-trainer-controller-manager",
@@ -541,6 +617,7 @@ this is not: valid: yaml: content
 				CertManagement:   defaultCertManagement,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: ":8081",
@@ -555,6 +632,76 @@ this is not: valid: yaml: content
 				},
 			},
 		},
+		{
+			name:       "reconcile latency buckets config",
+			configFile: reconcileLatencyBucketsConfig,
+			wantConfiguration: configapi.Configuration{
+				TypeMeta: typeMeta,
+				Webhook:  defaultWebhook,
+				Metrics: configapi.ControllerMetrics{
+					BindAddress:             ":8443",
+					SecureServing:           ptr.To(true),
+					ReconcileLatencyBuckets: []float64{0.1, 0.5, 1, 5, 30},
+				},
+				Health:           defaultHealth,
+				CertManagement:   defaultCertManagement,
+				ClientConnection: defaultClientConnection,
+				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
+			},
+			wantOptions: defaultOptions,
+		},
+		{
+			name:       "non-monotonic reconcile latency buckets",
+			configFile: nonMonotonicReconcileLatencyBucketsConfig,
+			wantErr:    true,
+		},
+		{
+			name:       "progress config",
+			configFile: progressConfig,
+			wantConfiguration: configapi.Configuration{
+				TypeMeta:         typeMeta,
+				Webhook:          defaultWebhook,
+				Metrics:          defaultMetrics,
+				Health:           defaultHealth,
+				CertManagement:   defaultCertManagement,
+				ClientConnection: defaultClientConnection,
+				StatusServer:     defaultStatusServer,
+				Progress: &configapi.Progress{
+					TokenExpirySeconds: ptr.To[int64](86400),
+				},
+			},
+			wantOptions: defaultOptions,
+		},
+		{
+			name:       "progress token expiry shorter than 600 seconds",
+			configFile: shortTokenExpiryProgressConfig,
+			wantErr:    true,
+		},
+		{
+			name:       "retry backoff config",
+			configFile: retryBackoffConfig,
+			wantConfiguration: configapi.Configuration{
+				TypeMeta:         typeMeta,
+				Webhook:          defaultWebhook,
+				Metrics:          defaultMetrics,
+				Health:           defaultHealth,
+				CertManagement:   defaultCertManagement,
+				ClientConnection: defaultClientConnection,
+				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
+				RetryBackoff: &configapi.RetryBackoff{
+					BaseDelay: &metav1.Duration{Duration: 2 * time.Second},
+					MaxDelay:  &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+			wantOptions: defaultOptions,
+		},
+		{
+			name:       "retry backoff max delay shorter than base delay",
+			configFile: invalidRetryBackoffConfig,
+			wantErr:    true,
+		},
 		{
 			name:       "webhook host config",
 			configFile: webhookHostConfig,
@@ -569,6 +716,7 @@ this is not: valid: yaml: content
 				CertManagement:   defaultCertManagement,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: ":8081",
@@ -599,6 +747,7 @@ this is not: valid: yaml: content
 					QPS:   ptr.To[float32](1),
 					Burst: ptr.To[int32](2),
 				},
+				Progress: defaultProgress,
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: ":8081",
@@ -628,6 +777,7 @@ this is not: valid: yaml: content
 				CertManagement:   defaultCertManagement,
 				ClientConnection: defaultClientConnection,
 				StatusServer:     defaultStatusServer,
+				Progress:         defaultProgress,
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: ":9090",
@@ -675,6 +825,7 @@ this is not: valid: yaml: content
 					QPS:   ptr.To[float32](1),
 					Burst: ptr.To[int32](2),
 				},
+				Progress: defaultProgress,
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: ":8081",
@@ -800,6 +951,38 @@ func TestIsCertManagementEnabled(t *testing.T) {
 	}
 }
 
+func TestCacheOptions(t *testing.T) {
+	cases := map[string]struct {
+		podLabels map[string]string
+		want      bool
+	}{
+		"JobSet Pod is cached": {
+			podLabels: map[string]string{jobsetv1alpha2.JobSetNameKey: "test-job"},
+			want:      true,
+		},
+		"Pod without the JobSet name label is not cached": {
+			podLabels: map[string]string{"app": "test"},
+		},
+	}
+	opts := CacheOptions()
+	var podSelector labels.Selector
+	for obj, byObject := range opts.ByObject {
+		if _, ok := obj.(*corev1.Pod); ok {
+			podSelector = byObject.Label
+		}
+	}
+	if podSelector == nil {
+		t.Fatal("The Pod cache is not limited by the label selector")
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := podSelector.Matches(labels.Set(tc.podLabels)); got != tc.want {
+				t.Errorf("Pod cache selector matches = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestApplyClientConnection(t *testing.T) {
 	testcases := map[string]struct {
 		cfg          configapi.Configuration
@@ -17,9 +17,17 @@ limitations under the License.
 package config
 
 import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset"
 )
 
 // validate validates the configuration.
@@ -31,6 +39,21 @@ func validate(cfg *configapi.Configuration) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("webhook", "port"), *cfg.Webhook.Port, "must be between 1 and 65535"))
 	}
 
+	// Validate the admin endpoint is only served securely
+	if ptr.Deref(cfg.Metrics.EnableAdminEndpoint, false) && !ptr.Deref(cfg.Metrics.SecureServing, false) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metrics", "enableAdminEndpoint"), true, "requires metrics secureServing"))
+	}
+
+	// Validate the reconcile latency buckets are positive and strictly increasing
+	bucketsPath := field.NewPath("metrics", "reconcileLatencyBuckets")
+	for i, bucket := range cfg.Metrics.ReconcileLatencyBuckets {
+		if bucket <= 0 {
+			allErrs = append(allErrs, field.Invalid(bucketsPath.Index(i), bucket, "must be greater than 0"))
+		} else if i > 0 && bucket <= cfg.Metrics.ReconcileLatencyBuckets[i-1] {
+			allErrs = append(allErrs, field.Invalid(bucketsPath.Index(i), bucket, "must be greater than the previous bucket"))
+		}
+	}
+
 	// Validate client connection QPS and Burst
 	if cfg.ClientConnection != nil {
 		if cfg.ClientConnection.QPS != nil && *cfg.ClientConnection.QPS < 0 {
@@ -63,5 +86,95 @@ func validate(cfg *configapi.Configuration) field.ErrorList {
 		}
 	}
 
+	// Validate resources config
+	if cfg.Resources != nil {
+		ratioPath := field.NewPath("resources", "limitRequestRatio")
+		for name, ratio := range cfg.Resources.LimitRequestRatio {
+			if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+				allErrs = append(allErrs, field.NotSupported(ratioPath, name, []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}))
+				continue
+			}
+			if ratio.Cmp(resource.MustParse("1")) < 0 {
+				allErrs = append(allErrs, field.Invalid(ratioPath.Key(string(name)), ratio.String(), "must be greater than or equal to 1"))
+			}
+		}
+	}
+
+	// Validate pip install config
+	if cfg.PipInstall != nil && len(cfg.PipInstall.Image) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("pipInstall", "image"), "must be set to enable the pip install"))
+	}
+
+	// Validate rendezvous wait config
+	if cfg.RendezvousWait != nil {
+		if len(cfg.RendezvousWait.Image) == 0 {
+			allErrs = append(allErrs, field.Required(field.NewPath("rendezvousWait", "image"), "must be set to enable the rendezvous wait"))
+		}
+		if cfg.RendezvousWait.MaxRetries != nil && *cfg.RendezvousWait.MaxRetries < 1 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("rendezvousWait", "maxRetries"), *cfg.RendezvousWait.MaxRetries, "must be greater than or equal to 1"))
+		}
+	}
+
+	// Validate progress config
+	if cfg.Progress != nil && cfg.Progress.TokenExpirySeconds != nil && *cfg.Progress.TokenExpirySeconds < 600 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("progress", "tokenExpirySeconds"), *cfg.Progress.TokenExpirySeconds, "must be greater than or equal to 600"))
+	}
+
+	// Validate generated secrets config
+	if cfg.GeneratedSecrets != nil && cfg.GeneratedSecrets.TTL != nil && cfg.GeneratedSecrets.TTL.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("generatedSecrets", "ttl"), cfg.GeneratedSecrets.TTL.Duration.String(), "must be greater than 0"))
+	}
+
+	// Validate retry backoff config
+	if cfg.RetryBackoff != nil {
+		backoffPath := field.NewPath("retryBackoff")
+		if cfg.RetryBackoff.BaseDelay != nil && cfg.RetryBackoff.BaseDelay.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(backoffPath.Child("baseDelay"), cfg.RetryBackoff.BaseDelay.Duration.String(), "must be greater than 0"))
+		}
+		if cfg.RetryBackoff.MaxDelay != nil && cfg.RetryBackoff.MaxDelay.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(backoffPath.Child("maxDelay"), cfg.RetryBackoff.MaxDelay.Duration.String(), "must be greater than 0"))
+		} else if cfg.RetryBackoff.BaseDelay != nil && cfg.RetryBackoff.MaxDelay != nil && cfg.RetryBackoff.MaxDelay.Duration < cfg.RetryBackoff.BaseDelay.Duration {
+			allErrs = append(allErrs, field.Invalid(backoffPath.Child("maxDelay"), cfg.RetryBackoff.MaxDelay.Duration.String(), "must be greater than or equal to baseDelay"))
+		}
+	}
+
+	// Validate default numProcPerNode
+	if cfg.DefaultNumProcPerNode != nil && *cfg.DefaultNumProcPerNode < 1 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("defaultNumProcPerNode"), *cfg.DefaultNumProcPerNode, "must be greater than or equal to 1"))
+	}
+
+	// Validate trainer pod labels
+	allErrs = append(allErrs, metav1validation.ValidateLabels(cfg.TrainerPodLabels, field.NewPath("trainerPodLabels"))...)
+
+	// Validate plugins config
+	if cfg.Plugins != nil {
+		allErrs = append(allErrs, validatePlugins(cfg.Plugins, field.NewPath("plugins"))...)
+	}
+
+	return allErrs
+}
+
+// validatePlugins rejects the unknown plugin names, so that the typos are not silently ignored,
+// and disabling the JobSet plugin, which runs the TrainJobs.
+func validatePlugins(cfg *configapi.Plugins, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := plugins.Names()
+	enabledPath := fldPath.Child("enabled")
+	for i, name := range cfg.Enabled {
+		if !slices.Contains(names, name) {
+			allErrs = append(allErrs, field.NotSupported(enabledPath.Index(i), name, names))
+		}
+	}
+	if len(cfg.Enabled) != 0 && !slices.Contains(cfg.Enabled, jobset.Name) {
+		allErrs = append(allErrs, field.Invalid(enabledPath, cfg.Enabled, "must contain the JobSet plugin"))
+	}
+	disabledPath := fldPath.Child("disabled")
+	for i, name := range cfg.Disabled {
+		if !slices.Contains(names, name) {
+			allErrs = append(allErrs, field.NotSupported(disabledPath.Index(i), name, names))
+		} else if name == jobset.Name {
+			allErrs = append(allErrs, field.Invalid(disabledPath.Index(i), name, "must not disable the JobSet plugin"))
+		}
+	}
 	return allErrs
 }
@@ -18,9 +18,13 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -366,3 +370,510 @@ func TestValidateClientConnection(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateResources(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"nil resources": {
+			cfg:     &configapi.Configuration{},
+			wantErr: nil,
+		},
+		"valid cpu and memory limit request ratio": {
+			cfg: &configapi.Configuration{
+				Resources: &configapi.Resources{
+					LimitRequestRatio: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1.5"),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		"invalid limit request ratio less than 1": {
+			cfg: &configapi.Configuration{
+				Resources: &configapi.Resources{
+					LimitRequestRatio: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("500m"),
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "resources.limitRequestRatio[cpu]",
+				},
+			},
+		},
+		"unsupported limit request ratio resource": {
+			cfg: &configapi.Configuration{
+				Resources: &configapi.Resources{
+					LimitRequestRatio: corev1.ResourceList{
+						"nvidia.com/gpu": resource.MustParse("2"),
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "resources.limitRequestRatio",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateTrainerPodLabels(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"valid trainer pod labels": {
+			cfg: &configapi.Configuration{
+				TrainerPodLabels: map[string]string{
+					"network.example.com/policy": "trainer",
+				},
+			},
+			wantErr: nil,
+		},
+		"invalid trainer pod label key": {
+			cfg: &configapi.Configuration{
+				TrainerPodLabels: map[string]string{
+					"invalid key": "trainer",
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "trainerPodLabels",
+					Origin: "format=k8s-label-key",
+				},
+			},
+		},
+		"invalid trainer pod label value": {
+			cfg: &configapi.Configuration{
+				TrainerPodLabels: map[string]string{
+					"network.example.com/policy": "invalid value",
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "trainerPodLabels",
+					Origin: "format=k8s-label-value",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatePipInstall(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"valid pip install image": {
+			cfg: &configapi.Configuration{
+				PipInstall: &configapi.PipInstall{
+					Image: "python:3.12",
+				},
+			},
+			wantErr: nil,
+		},
+		"empty pip install image": {
+			cfg: &configapi.Configuration{
+				PipInstall: &configapi.PipInstall{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "pipInstall.image",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateRendezvousWait(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"valid rendezvous wait": {
+			cfg: &configapi.Configuration{
+				RendezvousWait: &configapi.RendezvousWait{
+					Image:      "busybox:1.37",
+					MaxRetries: ptr.To[int32](30),
+				},
+			},
+			wantErr: nil,
+		},
+		"empty rendezvous wait image": {
+			cfg: &configapi.Configuration{
+				RendezvousWait: &configapi.RendezvousWait{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "rendezvousWait.image",
+				},
+			},
+		},
+		"zero rendezvous wait max retries": {
+			cfg: &configapi.Configuration{
+				RendezvousWait: &configapi.RendezvousWait{
+					Image:      "busybox:1.37",
+					MaxRetries: ptr.To[int32](0),
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "rendezvousWait.maxRetries",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateGeneratedSecrets(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"valid generated secrets ttl": {
+			cfg: &configapi.Configuration{
+				GeneratedSecrets: &configapi.GeneratedSecrets{
+					TTL: &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			wantErr: nil,
+		},
+		"zero generated secrets ttl": {
+			cfg: &configapi.Configuration{
+				GeneratedSecrets: &configapi.GeneratedSecrets{
+					TTL: &metav1.Duration{},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "generatedSecrets.ttl",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateRetryBackoff(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"valid retry backoff": {
+			cfg: &configapi.Configuration{
+				RetryBackoff: &configapi.RetryBackoff{
+					BaseDelay: &metav1.Duration{Duration: time.Second},
+					MaxDelay:  &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			wantErr: nil,
+		},
+		"zero retry backoff base delay": {
+			cfg: &configapi.Configuration{
+				RetryBackoff: &configapi.RetryBackoff{
+					BaseDelay: &metav1.Duration{},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "retryBackoff.baseDelay",
+				},
+			},
+		},
+		"negative retry backoff max delay": {
+			cfg: &configapi.Configuration{
+				RetryBackoff: &configapi.RetryBackoff{
+					MaxDelay: &metav1.Duration{Duration: -time.Second},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "retryBackoff.maxDelay",
+				},
+			},
+		},
+		"retry backoff max delay shorter than base delay": {
+			cfg: &configapi.Configuration{
+				RetryBackoff: &configapi.RetryBackoff{
+					BaseDelay: &metav1.Duration{Duration: time.Minute},
+					MaxDelay:  &metav1.Duration{Duration: time.Second},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "retryBackoff.maxDelay",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateProgress(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"valid progress token expiry": {
+			cfg: &configapi.Configuration{
+				Progress: &configapi.Progress{
+					TokenExpirySeconds: ptr.To[int64](600),
+				},
+			},
+			wantErr: nil,
+		},
+		"progress token expiry shorter than 600 seconds": {
+			cfg: &configapi.Configuration{
+				Progress: &configapi.Progress{
+					TokenExpirySeconds: ptr.To[int64](599),
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "progress.tokenExpirySeconds",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateMetrics(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"admin endpoint with secure serving": {
+			cfg: &configapi.Configuration{
+				Metrics: configapi.ControllerMetrics{
+					SecureServing:       ptr.To(true),
+					EnableAdminEndpoint: ptr.To(true),
+				},
+			},
+			wantErr: nil,
+		},
+		"admin endpoint without secure serving": {
+			cfg: &configapi.Configuration{
+				Metrics: configapi.ControllerMetrics{
+					SecureServing:       ptr.To(false),
+					EnableAdminEndpoint: ptr.To(true),
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metrics.enableAdminEndpoint",
+				},
+			},
+		},
+		"increasing reconcile latency buckets": {
+			cfg: &configapi.Configuration{
+				Metrics: configapi.ControllerMetrics{
+					ReconcileLatencyBuckets: []float64{0.1, 0.5, 1},
+				},
+			},
+			wantErr: nil,
+		},
+		"non-positive and non-increasing reconcile latency buckets": {
+			cfg: &configapi.Configuration{
+				Metrics: configapi.ControllerMetrics{
+					ReconcileLatencyBuckets: []float64{0, 0.5, 0.5, 0.1},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metrics.reconcileLatencyBuckets[0]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metrics.reconcileLatencyBuckets[2]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metrics.reconcileLatencyBuckets[3]",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatePlugins(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"known plugins": {
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Enabled:  []string{"JobSet", "Torch", "TrainJobStatus"},
+					Disabled: []string{"Volcano"},
+				},
+			},
+			wantErr: nil,
+		},
+		"unknown plugins": {
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Enabled:  []string{"JobSet", "Pytorch"},
+					Disabled: []string{"volcano"},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "plugins.enabled[1]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "plugins.disabled[0]",
+				},
+			},
+		},
+		"enabled plugins without JobSet": {
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Enabled: []string{"Torch"},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "plugins.enabled",
+				},
+			},
+		},
+		"disabled JobSet plugin": {
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Disabled: []string{"Volcano", "JobSet"},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "plugins.disabled[1]",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateDefaultNumProcPerNode(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"valid default numProcPerNode": {
+			cfg: &configapi.Configuration{
+				DefaultNumProcPerNode: ptr.To[int32](2),
+			},
+			wantErr: nil,
+		},
+		"zero default numProcPerNode": {
+			cfg: &configapi.Configuration{
+				DefaultNumProcPerNode: ptr.To[int32](0),
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "defaultNumProcPerNode",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -40,12 +40,79 @@ const (
 	// trainer.kubeflow.org/trainjob-ancestor-step: trainer              - trainJob.spec.trainer
 	LabelTrainJobAncestor string = "trainer.kubeflow.org/trainjob-ancestor-step"
 
+	// AnnotationForceRerender is the TrainJob annotation to force the JobSet to be re-rendered
+	// on the next reconcile, for example after a hotfix is pushed to the runtime.
+	// The running JobSet is immutable, so the re-render is deferred until the TrainJob is suspended.
+	// The value is recorded on the re-rendered JobSet, so that it shows which value the JobSet was rendered with.
+	AnnotationForceRerender string = "trainer.kubeflow.org/force-rerender"
+
+	// AnnotationClientVersion is the TrainJob annotation to record the version of the SDK or client
+	// which created the TrainJob. It is propagated to the JobSet to help the support triage.
+	AnnotationClientVersion string = "trainer.kubeflow.org/client-version"
+
+	// AnnotationCanary is the JobSet and PodGroup annotation to mark the JobSet running the single-node canary
+	// of the trainer and its PodGroup, which are replaced by the ones with numNodes once the canary succeeds.
+	AnnotationCanary string = "trainer.kubeflow.org/canary"
+
+	// AnnotationSpot is the JobSet annotation to mark the JobSet placing the trainer on the spot nodes,
+	// which is replaced by the JobSet placing the trainer on the on-demand nodes once it falls back.
+	AnnotationSpot string = "trainer.kubeflow.org/spot"
+
+	// AnnotationSchedulingPreflightFailed is the JobSet annotation holding the reason why the JobSet
+	// is kept suspended by the scheduling preflight, since the TrainJob can never fit the cluster.
+	AnnotationSchedulingPreflightFailed string = "trainer.kubeflow.org/scheduling-preflight-failed"
+
+	// AnnotationSecretTTL is the annotation to record the configured TTL of the Secrets generated
+	// for the TrainJob, e.g. the MPI SSH auth Secret, so external Secret cleaners can remove them early.
+	AnnotationSecretTTL string = "trainer.kubeflow.org/secret-ttl"
+
+	// AnnotationDisableProgressReporting is the TrainJob annotation to disable the progress reporting
+	// to the status server when set to "true", e.g. for the air-gapped TrainJobs which can't reach it.
+	AnnotationDisableProgressReporting string = "trainer.kubeflow.org/disable-progress-reporting"
+
+	// AnnotationNumProcPerNodeNodeLabel is the trainer Pod annotation holding the Node label with the number
+	// of processes per node, which is copied to the LabelNumProcPerNode Pod label once the Pod is scheduled.
+	AnnotationNumProcPerNodeNodeLabel string = "trainer.kubeflow.org/num-proc-per-node-node-label"
+
+	// LabelNumProcPerNode is the trainer Pod label holding the number of processes per node
+	// copied from the label of the Node which the Pod is scheduled to.
+	// It is set to PET_NPROC_PER_NODE through the downward API.
+	LabelNumProcPerNode string = "trainer.kubeflow.org/num-proc-per-node"
+
+	// AnnotationPodSetRequiredTopology is the Kueue Topology Aware Scheduling annotation to require
+	// the Pods of the Pod template to be placed within a single domain of the given topology level.
+	AnnotationPodSetRequiredTopology string = "kueue.x-k8s.io/podset-required-topology"
+
+	// AnnotationPodSetPreferredTopology is the Kueue Topology Aware Scheduling annotation to prefer
+	// the Pods of the Pod template to be placed within a single domain of the given topology level.
+	AnnotationPodSetPreferredTopology string = "kueue.x-k8s.io/podset-preferred-topology"
+
+	// DefaultGPUTopologyLevel is the default topology level for the trainer GPU topology,
+	// which is the node label identifying the NVLink domain of the GPUs.
+	DefaultGPUTopologyLevel string = "nvidia.com/gpu.clique"
+
+	// DefaultHeartbeatTimeoutSeconds is the default duration in seconds after which the trainer
+	// container is restarted if the heartbeat file is not updated.
+	DefaultHeartbeatTimeoutSeconds int32 = 300
+
+	// GPUProductLabel is the node label for the GPU product, which is used to place
+	// the training nodes on the nodes with the requested GPU product.
+	GPUProductLabel string = "nvidia.com/gpu.product"
+
+	// AnnotationValidationRules is the runtime annotation to declare the CEL validation rules
+	// evaluated against the TrainJob at admission, for example:
+	// [{"expression": "has(trainJob.spec.trainer.resourcesPerNode)", "message": "resourcesPerNode must be set"}]
+	AnnotationValidationRules string = "trainer.kubeflow.org/validation-rules"
+
 	// LabelSupport indicates support status for a runtime, e.g. "deprecated".
 	LabelSupport string = "trainer.kubeflow.org/support"
 
 	// SupportDeprecated indicates the runtime is deprecated when used with LabelSupport.
 	SupportDeprecated string = "deprecated"
 
+	// LabelAccelerator declares the accelerator vendor a runtime is built for, e.g. "nvidia" or "amd".
+	LabelAccelerator string = "trainer.kubeflow.org/accelerator"
+
 	// RuntimeDeprecationPolicyURL is the URL to the runtime deprecation policy documentation.
 	RuntimeDeprecationPolicyURL string = "https://trainer.kubeflow.org/en/latest/operator-guides/runtime.html#runtime-deprecation-policy"
 
@@ -61,6 +128,9 @@ const (
 	// ModelMountPath is the volumeMount path for model.
 	ModelMountPath string = "/workspace/model"
 
+	// CheckpointMountPath is the volumeMount path for the checkpoint to resume the training from.
+	CheckpointMountPath string = "/workspace/checkpoint"
+
 	// AncestorTrainer is the ancestor name for Trainer, which is mostly used for the value of
 	// 'trainer.kubeflow.org/trainjob-ancestor-step'.
 	AncestorTrainer string = "trainer"
@@ -74,6 +144,9 @@ const (
 	// PodGroupKind is the Kind name for the PodGroup.
 	PodGroupKind string = "PodGroup"
 
+	// LabelPodGroupQueue is the label for the scheduler queue of the coscheduling PodGroup and its Pods.
+	LabelPodGroupQueue string = "scheduling.x-k8s.io/queue-name"
+
 	// TrainJobSuspendedMessage is status condition message for the
 	// {"type": "Suspended", "status": "True", "reason": "Suspended"} condition.
 	TrainJobSuspendedMessage = "TrainJob is suspended"
@@ -87,10 +160,45 @@ const (
 	// when the TrainJob exceeds its ActiveDeadlineSeconds.
 	TrainJobDeadlineExceededMessage = "TrainJob exceeded its active deadline"
 
+	// TrainJobJobSetConflictMessage is the status condition message for the
+	// {"type": "JobSetConflict", "status": "True", "reason": "JobSetOwnedByOther"} condition.
+	TrainJobJobSetConflictMessage = "JobSet with the TrainJob name exists and is not owned by the TrainJob, it is not adopted"
+
+	// TrainJobCanaryRunningMessage is the status condition message for the
+	// {"type": "Canary", "status": "False", "reason": "CanaryRunning"} condition.
+	TrainJobCanaryRunningMessage = "TrainJob trainer is running as a single-node canary"
+
+	// TrainJobCanarySucceededMessage is the status condition message for the
+	// {"type": "Canary", "status": "True", "reason": "CanarySucceeded"} condition.
+	TrainJobCanarySucceededMessage = "TrainJob trainer canary succeeded, scaling the trainer to numNodes"
+
+	// TrainJobCanaryFailedMessage is the status condition message for the
+	// {"type": "Canary", "status": "False", "reason": "CanaryFailed"} condition.
+	TrainJobCanaryFailedMessage = "TrainJob trainer canary failed"
+
+	// TrainJobSpotFallbackMessage is the status condition message for the
+	// {"type": "SpotFallback", "status": "True", "reason": "SpotSchedulingFailed"} condition.
+	TrainJobSpotFallbackMessage = "TrainJob trainer failed to be scheduled on the spot nodes, falling back to the on-demand nodes"
+
+	// TrainJobRestartingMessage is the status condition message for the
+	// {"type": "Restarting", "status": "True", "reason": "Restarted"} condition.
+	TrainJobRestartingMessage = "TrainJob is restarting after the JobSet restart"
+
+	// TrainJobUnschedulableMessage is the status condition message for the
+	// {"type": "Unschedulable", "status": "True", "reason": "InsufficientClusterCapacity"} condition.
+	TrainJobUnschedulableMessage = "TrainJob requests exceed the allocatable resources of the cluster nodes"
+
+	// TrainJobOOMKilledHintMessage is the hint appended to the "Failed" condition message
+	// when the TrainJob failed because a container was killed for running out of memory.
+	TrainJobOOMKilledHintMessage = "consider increasing the container memory limits"
+
 	// Node is the name of the Job and container for the MPI launcher.
 	// When RunLauncherAsNode: true, for the launcher Job the container name is node.
 	Launcher string = "launcher"
 
+	// XGBoostServer is the name of the Job for the XGBoost parameter servers.
+	XGBoostServer string = "server"
+
 	// Flux Framework
 	// The Flux View container image for the initContainer with Flux pre-installed
 	FluxInstallerImage = "ghcr.io/converged-computing/flux-view-ubuntu:tag-jammy"
@@ -101,6 +209,9 @@ const (
 	// Flux queue policy for scheduler in cluster (first come, first serve)
 	FluxQueuePolicy = "fcfs"
 
+	// Flux broker port for the tree-based overlay network (TBON)
+	FluxBrokerPort int32 = 8050
+
 	// Flux view container image name
 	FluxInstallerContainerName = "flux-installer"
 
@@ -185,6 +296,63 @@ const (
 
 	// OpenMPIEnvDefaultSlots is the OpenMPI default number of slots env key.
 	OpenMPIEnvDefaultSlots string = "OMPI_MCA_orte_set_default_slots"
+
+	// Values for Intel MPI implementation.
+
+	// IntelMPIEnvHostFileLocation is the Intel MPI hydra hostfile env key.
+	IntelMPIEnvHostFileLocation string = "I_MPI_HYDRA_HOST_FILE"
+
+	// IntelMPIEnvHydraBootstrap is the env key for the Intel MPI hydra bootstrap server.
+	IntelMPIEnvHydraBootstrap string = "I_MPI_HYDRA_BOOTSTRAP"
+
+	// IntelMPIEnvDefaultValueHydraBootstrap is the default env value for the Intel MPI hydra bootstrap server.
+	IntelMPIEnvDefaultValueHydraBootstrap string = "ssh"
+
+	// Values for MPICH implementation.
+
+	// MPICHEnvHostFileLocation is the MPICH hydra hostfile env key,
+	// which is equivalent to the hostfile passed by the mpiexec -f argument.
+	MPICHEnvHostFileLocation string = "HYDRA_HOST_FILE"
+
+	// MPICHEnvHydraLauncher is the env key for the MPICH hydra launcher.
+	MPICHEnvHydraLauncher string = "HYDRA_LAUNCHER"
+
+	// MPICHEnvDefaultValueHydraLauncher is the default env value for the MPICH hydra launcher.
+	MPICHEnvDefaultValueHydraLauncher string = "ssh"
+
+	// DeepSpeedSSHAuthSecretSuffix is the name suffix for Secret with DeepSpeed SSH keys.
+	DeepSpeedSSHAuthSecretSuffix string = "-deepspeed-ssh-auth"
+
+	// DeepSpeedSSHAuthVolumeName is the volume name for Secret with DeepSpeed SSH keys.
+	DeepSpeedSSHAuthVolumeName string = "deepspeed-ssh-auth"
+
+	// DeepSpeedHostfileDir is the directory for the DeepSpeed hostfile.
+	DeepSpeedHostfileDir string = "/etc/deepspeed"
+
+	// DeepSpeedHostfileName is the file name for the DeepSpeed hostfile.
+	DeepSpeedHostfileName string = "hostfile"
+
+	// DeepSpeedHostfileConfigMapSuffix is the name suffix for ConfigMap with DeepSpeed hostfile.
+	DeepSpeedHostfileConfigMapSuffix string = "-deepspeed-hostfile"
+
+	// DeepSpeedHostfileVolumeName is the volume name for ConfigMap with DeepSpeed hostfile.
+	DeepSpeedHostfileVolumeName string = "deepspeed-hostfile"
+
+	// Distributed envs for the deepspeed launcher.
+	// DeepSpeedEnvHostfile is the env name for the DeepSpeed hostfile location.
+	DeepSpeedEnvHostfile string = "DEEPSPEED_HOSTFILE"
+
+	// DeepSpeedEnvMasterAddr is the env name for the address of the rank-0 node.
+	DeepSpeedEnvMasterAddr string = "DEEPSPEED_MASTER_ADDR"
+
+	// DeepSpeedEnvMasterPort is the env name for the port of the rank-0 node.
+	DeepSpeedEnvMasterPort string = "DEEPSPEED_MASTER_PORT"
+
+	// DeepSpeedEnvNumNodes is the env name for the number of training nodes.
+	DeepSpeedEnvNumNodes string = "DEEPSPEED_NUM_NODES"
+
+	// DeepSpeedEnvNumProcPerNode is the env name for the number of processes per node.
+	DeepSpeedEnvNumProcPerNode string = "DEEPSPEED_NUM_PROC_PER_NODE"
 	// Distributed envs for torchrun.
 	// Ref: https://github.com/pytorch/pytorch/blob/3a0d0885171376ed610c8175a19ba40411fc6f3f/torch/distributed/argparse_util.py#L45
 	// TorchEnvNumNodes is the env name for the number of training nodes.
@@ -193,6 +361,9 @@ const (
 	// TorchEnvNumProcPerNode is the env name for the number of procs per node (e.g. number of GPUs per Pod).
 	TorchEnvNumProcPerNode string = "PET_NPROC_PER_NODE"
 
+	// TorchEnvWorldSize is the env name for the total number of processes, i.e. numNodes x numProcPerNode.
+	TorchEnvWorldSize string = "WORLD_SIZE"
+
 	// TorchEnvNodeRank is the env name for the node RANK
 	TorchEnvNodeRank string = "PET_NODE_RANK"
 
@@ -202,6 +373,15 @@ const (
 	// TorchEnvMasterPort is the env name for the master node port.
 	TorchEnvMasterPort string = "PET_MASTER_PORT"
 
+	// TorchEnvRdzvBackend is the env name for the rendezvous backend of the PyTorch elastic training.
+	TorchEnvRdzvBackend string = "PET_RDZV_BACKEND"
+
+	// TorchEnvRdzvEndpoint is the env name for the rendezvous endpoint of the PyTorch elastic training.
+	TorchEnvRdzvEndpoint string = "PET_RDZV_ENDPOINT"
+
+	// TorchEnvRdzvID is the env name for the rendezvous id shared by the nodes of the PyTorch training.
+	TorchEnvRdzvID string = "PET_RDZV_ID"
+
 	// TorchTuneArgRdzvEndpoint is the arg name for the rendezvous endpoint.
 	TorchTuneArgRdzvEndpoint string = "--rdzv_endpoint"
 
@@ -276,6 +456,65 @@ const (
 
 	// XGBoostEnvNumWorker is the env name for the total number of workers.
 	XGBoostEnvNumWorker string = "DMLC_NUM_WORKER"
+
+	// XGBoostEnvNumServer is the env name for the total number of parameter servers.
+	XGBoostEnvNumServer string = "DMLC_NUM_SERVER"
+
+	// XGBoostEnvRole is the env name for the role of the process in the parameter-server topology.
+	XGBoostEnvRole string = "DMLC_ROLE"
+
+	// XGBoostRoleWorker is the DMLC_ROLE value for the XGBoost workers.
+	XGBoostRoleWorker string = "worker"
+
+	// XGBoostRoleServer is the DMLC_ROLE value for the XGBoost parameter servers.
+	XGBoostRoleServer string = "server"
+
+	// XGBoostServerTaskIDPrefix is the DMLC_TASK_ID prefix for the XGBoost parameter servers,
+	// so the server task IDs don't collide with the worker ranks.
+	XGBoostServerTaskIDPrefix string = "server-"
+
+	// JobCompletionIndexEnv is the env name for the Job completion index.
+	JobCompletionIndexEnv string = "JOB_COMPLETION_INDEX"
+
+	// Distributed envs for TensorFlow MultiWorkerMirroredStrategy.
+	// Ref: https://www.tensorflow.org/guide/distributed_training#setting_up_the_tf_config_environment_variable
+
+	// TensorFlowEnvTFConfig is the env name for the TensorFlow cluster spec and the task of the node.
+	TensorFlowEnvTFConfig string = "TF_CONFIG"
+
+	// TensorFlowEnvTaskIndex is the env name for the worker index referenced by the TF_CONFIG env.
+	TensorFlowEnvTaskIndex string = "TF_TASK_INDEX"
+
+	// TensorFlowTaskTypeWorker is the task type of the TensorFlow workers in the TF_CONFIG env.
+	TensorFlowTaskTypeWorker string = "worker"
+
+	// Distributed envs and settings for the Ray cluster.
+	// Ref: https://docs.ray.io/en/latest/cluster/cli.html#ray-start
+
+	// RayEnvAddress is the env name for the address of the Ray head, which the drivers connect to.
+	RayEnvAddress string = "RAY_ADDRESS"
+
+	// RayHeadPort is the port of the Ray GCS server on the head node, which the workers join.
+	RayHeadPort int32 = 6379
+
+	// RayHeadPortName is the name of the Ray GCS server port in the trainer node container.
+	RayHeadPortName string = "ray-gcs"
+
+	// RayConfigVolumePath is the path where the Ray entrypoint ConfigMap is mounted.
+	RayConfigVolumePath string = "/etc/ray-config"
+
+	// Distributed envs and settings for Horovod.
+	// Ref: https://horovod.readthedocs.io/en/stable/running_include.html
+
+	// HorovodLauncherBinary is the binary starting the Horovod training processes from the launcher.
+	HorovodLauncherBinary string = "horovodrun"
+
+	// HorovodEnvGlooIface is the env name for the network interface used by the Gloo controller.
+	HorovodEnvGlooIface string = "HOROVOD_GLOO_IFACE"
+
+	// HorovodEnvMPIThreadsDisable is the env name to disable the MPI multi-threading support,
+	// which is not needed when the training processes only communicate through Horovod.
+	HorovodEnvMPIThreadsDisable string = "HOROVOD_MPI_THREADS_DISABLE"
 )
 
 const (
@@ -300,10 +539,30 @@ var (
 	TorchRunReservedEnvNames = sets.New(TorchEnvNumNodes, TorchEnvNumProcPerNode, TorchEnvNodeRank, TorchEnvMasterAddr, TorchEnvMasterPort)
 
 	// XGBoostReservedEnvNames is XGBoost reserved env names that should not be set by users.
-	XGBoostReservedEnvNames = sets.New(XGBoostEnvTrackerURI, XGBoostEnvTrackerPort, XGBoostEnvTaskID, XGBoostEnvNumWorker)
+	XGBoostReservedEnvNames = sets.New(XGBoostEnvTrackerURI, XGBoostEnvTrackerPort, XGBoostEnvTaskID, XGBoostEnvNumWorker, XGBoostEnvNumServer, XGBoostEnvRole)
+
+	// TensorFlowReservedEnvNames is TensorFlow reserved env names that should not be set by users.
+	TensorFlowReservedEnvNames = sets.New(TensorFlowEnvTFConfig, TensorFlowEnvTaskIndex)
+
+	// RayReservedEnvNames is Ray reserved env names that should not be set by users.
+	RayReservedEnvNames = sets.New(RayEnvAddress)
+
+	// HorovodReservedEnvNames is Horovod reserved env names that should not be set by users.
+	HorovodReservedEnvNames = sets.New(HorovodEnvGlooIface, HorovodEnvMPIThreadsDisable)
 
 	// MPIReservedEnvNames is MPI reserved env names that users must not set manually.
-	MPIReservedEnvNames = sets.New(OpenMPIEnvHostFileLocation, OpenMPIEnvKeyRSHArgs, OpenMPIEnvKeepFQDNHostNames, OpenMPIEnvDefaultSlots)
+	MPIReservedEnvNames = sets.New(OpenMPIEnvHostFileLocation, OpenMPIEnvKeyRSHArgs, OpenMPIEnvKeepFQDNHostNames, OpenMPIEnvDefaultSlots,
+		IntelMPIEnvHostFileLocation, IntelMPIEnvHydraBootstrap, MPICHEnvHostFileLocation, MPICHEnvHydraLauncher)
+
+	// DeepSpeedReservedEnvNames is DeepSpeed reserved env names that users must not set manually.
+	DeepSpeedReservedEnvNames = sets.New(DeepSpeedEnvHostfile, DeepSpeedEnvMasterAddr, DeepSpeedEnvMasterPort, DeepSpeedEnvNumNodes, DeepSpeedEnvNumProcPerNode)
+
+	// SensitiveEnvNames is the env names carrying credentials, whose values must not show up in the logs and events.
+	SensitiveEnvNames = sets.New("HF_TOKEN", "ACCESS_TOKEN", "ACCESS_KEY_ID", "SECRET_ACCESS_KEY",
+		"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "SERVICE_ACCOUNT_KEY", "AZURE_STORAGE_ACCOUNT_KEY")
+
+	// RedactedValue replaces the values of the sensitive envs in the logs and events.
+	RedactedValue = "[REDACTED]"
 
 	// ResourceInUseFinalizer is a finalizer for managed resources which is used by other resources.
 	ResourceInUseFinalizer = fmt.Sprintf("%s/resource-in-use", trainer.GroupVersion.Group)
@@ -20,11 +20,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 )
 
-func SetupControllers(mgr ctrl.Manager, runtimes map[string]runtime.Runtime, options controller.Options) (string, error) {
+func SetupControllers(mgr ctrl.Manager, runtimes map[string]runtime.Runtime, cfg *configapi.Configuration, options controller.Options) (string, error) {
 	runtimeRec := NewTrainingRuntimeReconciler(
 		mgr.GetClient(),
 		mgr.GetEventRecorder("trainer-trainingruntime-controller"),
@@ -39,10 +40,15 @@ func SetupControllers(mgr ctrl.Manager, runtimes map[string]runtime.Runtime, opt
 	if err := clRuntimeRec.SetupWithManager(mgr, options); err != nil {
 		return trainer.ClusterTrainingRuntimeKind, err
 	}
+	var retryBackoff *configapi.RetryBackoff
+	if cfg != nil {
+		retryBackoff = cfg.RetryBackoff
+	}
 	if err := NewTrainJobReconciler(
 		mgr.GetClient(),
 		mgr.GetEventRecorder("trainer-trainjob-controller"),
 		runtimes,
+		retryBackoff,
 	).SetupWithManager(mgr, options); err != nil {
 		return trainer.TrainJobKind, err
 	}
@@ -25,9 +25,11 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -41,17 +43,29 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/metrics"
 	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
 )
 
+// errJobSetOwnedByOther is returned when the JobSet with the TrainJob name is controlled by another owner.
+var errJobSetOwnedByOther = errors.New("JobSet with the TrainJob name is owned by another object")
+
+const (
+	defaultRetryBackoffBaseDelay = time.Second
+	defaultRetryBackoffMaxDelay  = 5 * time.Minute
+)
+
 type TrainJobReconciler struct {
 	log      logr.Logger
 	client   client.Client
 	recorder events.EventRecorder
 	runtimes map[string]jobruntimes.Runtime
+	// retryBackoff tracks the consecutive transient errors reconciling the TrainJob resources per TrainJob.
+	retryBackoff workqueue.TypedRateLimiter[reconcile.Request]
 }
 
 var _ reconcile.Reconciler = (*TrainJobReconciler)(nil)
@@ -61,12 +75,23 @@ func NewTrainJobReconciler(
 	client client.Client,
 	recorder events.EventRecorder,
 	runtimes map[string]jobruntimes.Runtime,
+	retryBackoff *configapi.RetryBackoff,
 ) *TrainJobReconciler {
+	baseDelay, maxDelay := defaultRetryBackoffBaseDelay, defaultRetryBackoffMaxDelay
+	if retryBackoff != nil {
+		if retryBackoff.BaseDelay != nil {
+			baseDelay = retryBackoff.BaseDelay.Duration
+		}
+		if retryBackoff.MaxDelay != nil {
+			maxDelay = retryBackoff.MaxDelay.Duration
+		}
+	}
 	return &TrainJobReconciler{
-		log:      ctrl.Log.WithName("trainjob-controller"),
-		client:   client,
-		recorder: recorder,
-		runtimes: runtimes,
+		log:          ctrl.Log.WithName("trainjob-controller"),
+		client:       client,
+		recorder:     recorder,
+		runtimes:     runtimes,
+		retryBackoff: workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](baseDelay, maxDelay),
 	}
 }
 
@@ -76,10 +101,16 @@ func NewTrainJobReconciler(
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainjobs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainjobs/finalizers,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=create;get;list;update
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
 
 func (r *TrainJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveTrainJobReconcileDuration(time.Now())
+
 	var trainJob trainer.TrainJob
 	if err := r.client.Get(ctx, req.NamespacedName, &trainJob); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.retryBackoff.Forget(req)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 	log := ctrl.LoggerFrom(ctx).WithValues("trainJob", klog.KObj(&trainJob))
@@ -92,6 +123,7 @@ func (r *TrainJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	var err error
+	var result ctrl.Result
 	// Keep track of the origin TrainJob status
 	prevTrainJob := trainJob.DeepCopy()
 
@@ -105,7 +137,21 @@ func (r *TrainJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		err = fmt.Errorf("unsupported runtime: %s", runtimeRefGK)
 		setFailedCondition(&trainJob, fmt.Sprintf("unsupported runtime: %s", runtimeRefGK), trainer.TrainJobRuntimeNotSupportedReason)
 	} else if !trainjob.IsTrainJobFinished(&trainJob) {
-		err = r.reconcileObjects(ctx, runtime, &trainJob)
+		// The error may echo the object content, so the credentials are redacted before logged and recorded.
+		err = trainjob.RedactSensitiveEnv(&trainJob, r.reconcileObjects(ctx, runtime, &trainJob))
+		if errors.Is(err, jobruntimes.ErrorTrainerContainerNotFound) {
+			setFailedCondition(&trainJob, err.Error(), trainer.TrainJobRuntimeMisconfiguredReason)
+		}
+		if errors.Is(err, errJobSetOwnedByOther) {
+			meta.SetStatusCondition(&trainJob.Status.Conditions, metav1.Condition{
+				Type:    trainer.TrainJobJobSetConflict,
+				Status:  metav1.ConditionTrue,
+				Reason:  trainer.TrainJobJobSetOwnedByOtherReason,
+				Message: constants.TrainJobJobSetConflictMessage,
+			})
+		} else if err == nil {
+			meta.RemoveStatusCondition(&trainJob.Status.Conditions, trainer.TrainJobJobSetConflict)
+		}
 		if err != nil {
 			// TODO (astefanutti): the error should be surfaced in the TrainJob status to indicate
 			//  the creation of the runtime resources failed and the TrainJob is backed off until
@@ -117,39 +163,105 @@ func (r *TrainJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			}
 			r.recorder.Eventf(&trainJob, nil, corev1.EventTypeWarning, "TrainJobResourcesCreationFailed", "Reconciling", message)
 		}
+		if isTransientError(err) {
+			// The transient errors are retried with the exponential backoff rather than returned,
+			// so the retries are not immediate and the errors do not flood the logs.
+			result.RequeueAfter = r.retryBackoff.When(req)
+			log.V(2).Info("Retrying the TrainJob resources reconciliation after a transient error",
+				"error", err, "requeueAfter", result.RequeueAfter)
+			err = nil
+		} else {
+			r.retryBackoff.Forget(req)
+		}
 	}
 
-	setSuspendedCondition(&trainJob)
+	if setSuspendedCondition(&trainJob) {
+		// Record the suspend and resume transitions, so they show up in the TrainJob events timeline.
+		suspendedCond := meta.FindStatusCondition(trainJob.Status.Conditions, trainer.TrainJobSuspended)
+		r.recorder.Eventf(&trainJob, nil, corev1.EventTypeNormal, suspendedCond.Reason, "Reconciling", suspendedCond.Message)
+	}
 
 	if statusErr := setTrainJobStatus(ctx, runtime, &trainJob); statusErr != nil {
 		err = errors.Join(err, statusErr)
 	}
 
+	// Record the completion and the failure, so they show up in the TrainJob events timeline.
+	if cond := terminalConditionTransition(prevTrainJob, &trainJob, trainer.TrainJobComplete); cond != nil {
+		r.recorder.Eventf(&trainJob, nil, corev1.EventTypeNormal, cond.Reason, "Reconciling", cond.Message)
+	}
+	if cond := terminalConditionTransition(prevTrainJob, &trainJob, trainer.TrainJobFailed); cond != nil {
+		r.recorder.Eventf(&trainJob, nil, corev1.EventTypeWarning, cond.Reason, "Reconciling", cond.Message)
+	}
+
+	if cleanErr := r.reconcileCleanPodPolicy(ctx, &trainJob); cleanErr != nil {
+		err = errors.Join(err, cleanErr)
+	}
+
+	if canaryErr := r.reconcileCanary(ctx, &trainJob); canaryErr != nil {
+		err = errors.Join(err, canaryErr)
+	}
+
+	if spotErr := r.reconcileSpotFallback(ctx, &trainJob); spotErr != nil {
+		err = errors.Join(err, spotErr)
+	}
+
 	if deadlineResult, deadlineErr := r.reconcileDeadline(ctx, &trainJob); deadlineErr != nil || deadlineResult.RequeueAfter > 0 {
+		if deadlineResult.RequeueAfter > 0 && (result.RequeueAfter == 0 || deadlineResult.RequeueAfter < result.RequeueAfter) {
+			result = deadlineResult
+		}
 		if !equality.Semantic.DeepEqual(&trainJob.Status, &prevTrainJob.Status) {
-			return deadlineResult, errors.Join(err, r.client.Status().Patch(ctx, &trainJob, client.MergeFrom(prevTrainJob)))
+			return result, errors.Join(err, r.client.Status().Patch(ctx, &trainJob, client.MergeFrom(prevTrainJob)))
 		}
-		return deadlineResult, errors.Join(err, deadlineErr)
+		return result, errors.Join(err, deadlineErr)
 	}
 
 	if !equality.Semantic.DeepEqual(&trainJob.Status, prevTrainJob.Status) {
 		// TODO(astefanutti): Consider using SSA once controller-runtime client has SSA support
 		// for sub-resources. See: https://github.com/kubernetes-sigs/controller-runtime/issues/3183
-		return ctrl.Result{}, errors.Join(err, r.client.Status().Patch(ctx, &trainJob, client.MergeFrom(prevTrainJob)))
+		return result, errors.Join(err, r.client.Status().Patch(ctx, &trainJob, client.MergeFrom(prevTrainJob)))
 	}
-	return ctrl.Result{}, err
+	return result, err
+}
+
+// isTransientError reports whether the error is a transient API server error, which is expected to resolve on retry.
+func isTransientError(err error) bool {
+	return apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) || apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) || apierrors.IsInternalError(err)
 }
 
 func (r *TrainJobReconciler) reconcileObjects(ctx context.Context, runtime jobruntimes.Runtime, trainJob *trainer.TrainJob) error {
+	if trainjob.IsCanaryPending(trainJob) {
+		// The canary runs the trainer with a single node, before the trainer is scaled to numNodes.
+		trainJob = trainJob.DeepCopy()
+		trainJob.Spec.Trainer.NumNodes = ptr.To[int32](1)
+	}
 	objects, err := runtime.NewObjects(ctx, trainJob)
 	if err != nil {
 		return err
 	}
+	jobSet := &jobsetv1alpha2.JobSet{}
+	jobSetCreated := false
+	switch err := r.client.Get(ctx, client.ObjectKeyFromObject(trainJob), jobSet); {
+	case apierrors.IsNotFound(err):
+		jobSetCreated = true
+	case err == nil:
+		// The JobSet is left to its owner, e.g. the previous TrainJob with the same name, rather than adopted.
+		if owner := metav1.GetControllerOf(jobSet); owner != nil && owner.UID != trainJob.UID {
+			return errJobSetOwnedByOther
+		}
+	default:
+		// The JobSet must not be force-applied before its owner is checked.
+		return err
+	}
 	for _, object := range objects {
 		if err := r.client.Apply(ctx, object, client.FieldOwner("trainer"), client.ForceOwnership); err != nil {
 			return err
 		}
 	}
+	if jobSetCreated {
+		r.recorder.Eventf(trainJob, nil, corev1.EventTypeNormal, "JobSetCreated", "Reconciling",
+			fmt.Sprintf("Created JobSet: %s", trainJob.Name))
+	}
 	return nil
 }
 
@@ -191,6 +303,81 @@ func (r *TrainJobReconciler) reconcileDeadline(ctx context.Context, trainJob *tr
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// reconcileCleanPodPolicy deletes the Pods selected by the TrainJob cleanPodPolicy once the TrainJob is finished.
+func (r *TrainJobReconciler) reconcileCleanPodPolicy(ctx context.Context, trainJob *trainer.TrainJob) error {
+	if !trainjob.IsTrainJobFinished(trainJob) || trainJob.Spec.RunPolicy == nil {
+		return nil
+	}
+	policy := ptr.Deref(trainJob.Spec.RunPolicy.CleanPodPolicy, trainer.CleanPodPolicyNone)
+	if policy == trainer.CleanPodPolicyNone {
+		return nil
+	}
+	var pods corev1.PodList
+	if err := r.client.List(ctx, &pods, client.InNamespace(trainJob.Namespace), client.MatchingLabels{
+		jobsetv1alpha2.JobSetNameKey: trainJob.Name,
+	}); err != nil {
+		return err
+	}
+	var errs []error
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if policy == trainer.CleanPodPolicyRunning && (pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed) {
+			continue
+		}
+		if err := client.IgnoreNotFound(r.client.Delete(ctx, pod)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ctrl.LoggerFrom(ctx).V(2).Info("Deleted Pod per the TrainJob cleanPodPolicy", "pod", klog.KObj(pod), "cleanPodPolicy", policy)
+	}
+	return errors.Join(errs...)
+}
+
+// reconcileCanary deletes the JobSet running the trainer canary once the canary succeeded,
+// so the JobSet with numNodes is created on the next reconcile.
+func (r *TrainJobReconciler) reconcileCanary(ctx context.Context, trainJob *trainer.TrainJob) error {
+	if !meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobCanary) || trainjob.IsTrainJobFinished(trainJob) {
+		return nil
+	}
+	jobSet := &jobsetv1alpha2.JobSet{}
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(trainJob), jobSet); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if _, ok := jobSet.Annotations[constants.AnnotationCanary]; !ok || jobSet.DeletionTimestamp != nil {
+		return nil
+	}
+	// The canary Jobs are deleted first, since the Jobs with numNodes reuse their names.
+	if err := client.IgnoreNotFound(r.client.Delete(ctx, jobSet, client.PropagationPolicy(metav1.DeletePropagationForeground))); err != nil {
+		return err
+	}
+	ctrl.LoggerFrom(ctx).V(2).Info("Deleted the canary JobSet to scale the trainer to numNodes", "jobSet", klog.KObj(jobSet))
+	return nil
+}
+
+// reconcileSpotFallback deletes the JobSet placing the trainer on the spot nodes once the TrainJob falls back,
+// so the JobSet placing the trainer on the on-demand nodes is created on the next reconcile.
+func (r *TrainJobReconciler) reconcileSpotFallback(ctx context.Context, trainJob *trainer.TrainJob) error {
+	if !meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobSpotFallback) || trainjob.IsTrainJobFinished(trainJob) {
+		return nil
+	}
+	jobSet := &jobsetv1alpha2.JobSet{}
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(trainJob), jobSet); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if _, ok := jobSet.Annotations[constants.AnnotationSpot]; !ok || jobSet.DeletionTimestamp != nil {
+		return nil
+	}
+	// The spot Jobs are deleted first, since the on-demand Jobs reuse their names.
+	if err := client.IgnoreNotFound(r.client.Delete(ctx, jobSet, client.PropagationPolicy(metav1.DeletePropagationForeground))); err != nil {
+		return err
+	}
+	ctrl.LoggerFrom(ctx).V(2).Info("Deleted the spot JobSet to fall back to the on-demand nodes", "jobSet", klog.KObj(jobSet))
+	return nil
+}
+
 func (r *TrainJobReconciler) Create(e event.TypedCreateEvent[*trainer.TrainJob]) bool {
 	r.log.WithValues("trainJob", klog.KObj(e.Object)).Info("TrainJob create event")
 	return true
@@ -211,7 +398,8 @@ func (r *TrainJobReconciler) Generic(e event.TypedGenericEvent[*trainer.TrainJob
 	return true
 }
 
-func setSuspendedCondition(trainJob *trainer.TrainJob) {
+// setSuspendedCondition sets the Suspended condition and reports whether its status transitioned.
+func setSuspendedCondition(trainJob *trainer.TrainJob) bool {
 	var newCond metav1.Condition
 	switch {
 	case ptr.Deref(trainJob.Spec.Suspend, false):
@@ -229,9 +417,25 @@ func setSuspendedCondition(trainJob *trainer.TrainJob) {
 			Reason:  trainer.TrainJobResumedReason,
 		}
 	default:
-		return
+		return false
+	}
+	// The previous status is copied, since SetStatusCondition updates the existing condition in place.
+	var prevStatus metav1.ConditionStatus
+	if prevCond := meta.FindStatusCondition(trainJob.Status.Conditions, trainer.TrainJobSuspended); prevCond != nil {
+		prevStatus = prevCond.Status
 	}
 	meta.SetStatusCondition(&trainJob.Status.Conditions, newCond)
+	return prevStatus != newCond.Status
+}
+
+// terminalConditionTransition returns the condition of the given type when it transitioned to true
+// since the previous TrainJob, or nil otherwise.
+func terminalConditionTransition(prevTrainJob, trainJob *trainer.TrainJob, condType string) *metav1.Condition {
+	if meta.IsStatusConditionTrue(prevTrainJob.Status.Conditions, condType) ||
+		!meta.IsStatusConditionTrue(trainJob.Status.Conditions, condType) {
+		return nil
+	}
+	return meta.FindStatusCondition(trainJob.Status.Conditions, condType)
 }
 
 func setFailedCondition(trainJob *trainer.TrainJob, message, reason string) {
@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+// fakeRuntime builds an empty JobSet and reports the TrainJob status with the conditions set by the test.
+type fakeRuntime struct {
+	conditions []metav1.Condition
+}
+
+var _ jobruntimes.Runtime = (*fakeRuntime)(nil)
+
+func (f *fakeRuntime) NewObjects(_ context.Context, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
+	return []apiruntime.ApplyConfiguration{
+		jobsetv1alpha2ac.JobSet(trainJob.Name, trainJob.Namespace).WithSpec(jobsetv1alpha2ac.JobSetSpec()),
+	}, nil
+}
+
+func (f *fakeRuntime) DryRunObjects(ctx context.Context, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
+	return f.NewObjects(ctx, trainJob)
+}
+
+func (f *fakeRuntime) RuntimeInfo(*trainer.TrainJob, any, *trainer.MLPolicy, *trainer.PodGroupPolicy) (*jobruntimes.Info, error) {
+	return nil, nil
+}
+
+func (f *fakeRuntime) TrainJobStatus(_ context.Context, trainJob *trainer.TrainJob) (*trainer.TrainJobStatus, error) {
+	status := trainJob.Status.DeepCopy()
+	for _, cond := range f.conditions {
+		meta.SetStatusCondition(&status.Conditions, cond)
+	}
+	return status, nil
+}
+
+func (f *fakeRuntime) EventHandlerRegistrars() []jobruntimes.ReconcilerBuilder {
+	return nil
+}
+
+func (f *fakeRuntime) ValidateObjects(context.Context, *trainer.TrainJob, *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	return nil, nil
+}
+
+func TestReconcile_TrainJobReconcilerEvents(t *testing.T) {
+	type step struct {
+		suspend    bool
+		conditions []metav1.Condition
+		wantEvents []string
+	}
+	cases := map[string]struct {
+		steps []step
+	}{
+		"events are recorded for the JobSet creation, suspension, resumption and completion": {
+			steps: []step{
+				{
+					suspend: true,
+					wantEvents: []string{
+						"Normal JobSetCreated Created JobSet: test",
+						"Normal Suspended " + constants.TrainJobSuspendedMessage,
+					},
+				},
+				{
+					wantEvents: []string{
+						"Normal Resumed " + constants.TrainJobResumedMessage,
+					},
+				},
+				{
+					conditions: []metav1.Condition{{
+						Type:    trainer.TrainJobComplete,
+						Status:  metav1.ConditionTrue,
+						Reason:  "AllJobsCompleted",
+						Message: "jobset completed successfully",
+					}},
+					wantEvents: []string{
+						"Normal AllJobsCompleted jobset completed successfully",
+					},
+				},
+				{
+					conditions: []metav1.Condition{{
+						Type:    trainer.TrainJobComplete,
+						Status:  metav1.ConditionTrue,
+						Reason:  "AllJobsCompleted",
+						Message: "jobset completed successfully",
+					}},
+				},
+			},
+		},
+		"event is recorded for the failure": {
+			steps: []step{
+				{
+					wantEvents: []string{
+						"Normal JobSetCreated Created JobSet: test",
+					},
+				},
+				{
+					conditions: []metav1.Condition{{
+						Type:    trainer.TrainJobFailed,
+						Status:  metav1.ConditionTrue,
+						Reason:  "FailedJobs",
+						Message: "jobset failed",
+					}},
+					wantEvents: []string{
+						"Warning FailedJobs jobset failed",
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj()
+			cli := utiltesting.NewClientBuilder().
+				WithObjects(trainJob).
+				WithStatusSubresource(trainJob).
+				Build()
+			recorder := events.NewFakeRecorder(10)
+			runtime := &fakeRuntime{}
+			r := NewTrainJobReconciler(cli, recorder, map[string]jobruntimes.Runtime{
+				jobruntimes.RuntimeRefToRuntimeRegistryKey(trainJob.Spec.RuntimeRef): runtime,
+			}, nil)
+			trainJobKey := client.ObjectKeyFromObject(trainJob)
+			for i, s := range tc.steps {
+				var gotTrainJob trainer.TrainJob
+				if err := cli.Get(ctx, trainJobKey, &gotTrainJob); err != nil {
+					t.Fatalf("Step %d: Get() returned error: %v", i, err)
+				}
+				gotTrainJob.Spec.Suspend = ptr.To(s.suspend)
+				if err := cli.Update(ctx, &gotTrainJob); err != nil {
+					t.Fatalf("Step %d: Update() returned error: %v", i, err)
+				}
+				runtime.conditions = s.conditions
+				if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: trainJobKey}); err != nil {
+					t.Fatalf("Step %d: Reconcile() returned error: %v", i, err)
+				}
+				var gotEvents []string
+				for len(recorder.Events) > 0 {
+					gotEvents = append(gotEvents, <-recorder.Events)
+				}
+				if diff := cmp.Diff(s.wantEvents, gotEvents); len(diff) != 0 {
+					t.Errorf("Step %d: Unexpected events (-want, +got): %s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcile_TrainJobReconcilerRetryBackoff(t *testing.T) {
+	cases := map[string]struct {
+		applyErr         error
+		wantRequeueAfter []time.Duration
+		wantErr          bool
+	}{
+		"transient errors are retried with the exponential backoff capped to the max delay": {
+			applyErr:         apierrors.NewServiceUnavailable("apiserver is unavailable"),
+			wantRequeueAfter: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second},
+		},
+		"non-transient errors are returned without the backoff": {
+			applyErr:         apierrors.NewBadRequest("invalid JobSet"),
+			wantRequeueAfter: []time.Duration{0, 0},
+			wantErr:          true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj()
+			failing := true
+			cli := utiltesting.NewClientBuilder().
+				WithObjects(trainJob).
+				WithStatusSubresource(trainJob).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Apply: func(ctx context.Context, c client.WithWatch, obj apiruntime.ApplyConfiguration, opts ...client.ApplyOption) error {
+						if failing {
+							return tc.applyErr
+						}
+						return c.Apply(ctx, obj, opts...)
+					},
+				}).
+				Build()
+			r := NewTrainJobReconciler(cli, events.NewFakeRecorder(10), map[string]jobruntimes.Runtime{
+				jobruntimes.RuntimeRefToRuntimeRegistryKey(trainJob.Spec.RuntimeRef): &fakeRuntime{},
+			}, &configapi.RetryBackoff{
+				BaseDelay: &metav1.Duration{Duration: time.Second},
+				MaxDelay:  &metav1.Duration{Duration: 4 * time.Second},
+			})
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(trainJob)}
+			var gotRequeueAfter []time.Duration
+			for range tc.wantRequeueAfter {
+				result, err := r.Reconcile(ctx, req)
+				if gotErr := err != nil; gotErr != tc.wantErr {
+					t.Fatalf("Reconcile() returned error: %v, want error: %t", err, tc.wantErr)
+				}
+				gotRequeueAfter = append(gotRequeueAfter, result.RequeueAfter)
+			}
+			if diff := cmp.Diff(tc.wantRequeueAfter, gotRequeueAfter); len(diff) != 0 {
+				t.Errorf("Unexpected requeueAfter (-want, +got): %s", diff)
+			}
+
+			// The backoff is reset once the TrainJob resources are reconciled successfully.
+			failing = false
+			if result, err := r.Reconcile(ctx, req); err != nil || result.RequeueAfter != 0 {
+				t.Fatalf("Reconcile() returned result: %v, error: %v, want no requeue", result, err)
+			}
+			failing = true
+			result, _ := r.Reconcile(ctx, req)
+			if diff := cmp.Diff(tc.wantRequeueAfter[0], result.RequeueAfter); len(diff) != 0 {
+				t.Errorf("Unexpected requeueAfter after the backoff reset (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile_TrainJobReconcilerJobSetGetError(t *testing.T) {
+	_, ctx := ktesting.NewTestContext(t)
+	var cancel func()
+	ctx, cancel = context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+		RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+		Obj()
+	errGetJobSet := errors.New("failed to get JobSet")
+	applied := false
+	cli := utiltesting.NewClientBuilder().
+		WithObjects(trainJob).
+		WithStatusSubresource(trainJob).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*jobsetv1alpha2.JobSet); ok {
+					return errGetJobSet
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+			Apply: func(ctx context.Context, c client.WithWatch, obj apiruntime.ApplyConfiguration, opts ...client.ApplyOption) error {
+				applied = true
+				return c.Apply(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := NewTrainJobReconciler(cli, events.NewFakeRecorder(10), map[string]jobruntimes.Runtime{
+		jobruntimes.RuntimeRefToRuntimeRegistryKey(trainJob.Spec.RuntimeRef): &fakeRuntime{},
+	}, nil)
+	_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(trainJob)})
+	if !errors.Is(err, errGetJobSet) {
+		t.Errorf("Reconcile() returned error: %v, want: %v", err, errGetJobSet)
+	}
+	if applied {
+		t.Error("JobSet was applied although its owner could not be checked")
+	}
+}
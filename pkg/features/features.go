@@ -36,6 +36,12 @@ const (
 	//
 	// Enables status server allowing TrainJob pods to update their status.
 	TrainJobStatus featuregate.Feature = "TrainJobStatus"
+
+	// owner: kubeflow/trainer
+	//
+	// Enables the scheduling preflight keeping the TrainJob suspended when its requests
+	// exceed the allocatable resources of the cluster nodes.
+	SchedulingPreflight featuregate.Feature = "SchedulingPreflight"
 )
 
 // defaultFeatureGates consists of all known Trainer-specific feature keys.
@@ -46,6 +52,8 @@ const (
 // when adding or removing one entry.
 var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	TrainJobStatus: {Default: false, PreRelease: featuregate.Alpha},
+
+	SchedulingPreflight: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 func SetFeatureGateDuringTest(tb testing.TB, f featuregate.Feature, value bool) {
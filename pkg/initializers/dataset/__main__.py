@@ -54,10 +54,24 @@ def main():
             s3 = S3()
             s3.load_config()
             s3.download_dataset()
+        case utils.AZ_SCHEME:
+            from pkg.initializers.dataset.azure import AzureBlob
+
+            azure = AzureBlob()
+            azure.load_config()
+            azure.download_dataset()
+        case utils.HTTP_SCHEME | utils.HTTPS_SCHEME:
+            from pkg.initializers.dataset.http import HTTP
+
+            http = HTTP()
+            http.load_config()
+            http.download_dataset()
         case _:
             logging.error("STORAGE_URI must have the valid dataset provider")
             raise Exception
 
+    utils.write_metadata(utils.DATASET_PATH, storage_uri)
+
 
 if __name__ == "__main__":
     main()
@@ -0,0 +1,54 @@
+# Copyright The Kubeflow Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import logging
+from urllib.parse import urlparse
+
+import pkg.initializers.types.types as types
+import pkg.initializers.utils.opendal as opendal_utils
+import pkg.initializers.utils.utils as utils
+
+logging.basicConfig(
+    format="%(asctime)s %(levelname)-8s [%(filename)s:%(lineno)d] %(message)s",
+    datefmt="%Y-%m-%dT%H:%M:%SZ",
+    level=logging.INFO,
+)
+
+
+class AzureBlob(utils.DatasetProvider):
+    def load_config(self):
+        config_dict = utils.get_config_from_env(types.AzureBlobDatasetInitializer)
+        self.config = types.AzureBlobDatasetInitializer(**config_dict)
+
+    def download_dataset(self):
+        if not self.config.azure_storage_account_name:
+            raise ValueError(
+                "AZURE_STORAGE_ACCOUNT_NAME env variable must be set for the az STORAGE_URI."
+            )
+
+        storage_uri_parsed = urlparse(self.config.storage_uri)
+        container = storage_uri_parsed.netloc
+        prefix = storage_uri_parsed.path.lstrip("/")
+
+        azure_storage = opendal_utils.AzureBlobStorage(
+            container=container,
+            account_name=self.config.azure_storage_account_name,
+            account_key=self.config.azure_storage_account_key,
+        )
+
+        azure_storage.download(
+            prefix=prefix,
+            destination_path=utils.DATASET_PATH,
+            ignore_patterns=self.config.ignore_patterns,
+        )
@@ -0,0 +1,137 @@
+# Copyright The Kubeflow Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import os
+import tempfile
+from unittest.mock import MagicMock, patch
+
+import pytest
+
+import pkg.initializers.utils.utils as utils
+from pkg.initializers.dataset.azure import AzureBlob
+
+
+# Test cases for config loading
+@pytest.mark.parametrize(
+    "test_name, test_config, expected",
+    [
+        (
+            "Full config with storage account key",
+            {
+                "storage_uri": "az://container/dataset",
+                "ignore_patterns": ["*.log"],
+                "azure_storage_account_name": "account",
+                "azure_storage_account_key": "key",
+            },
+            {
+                "storage_uri": "az://container/dataset",
+                "ignore_patterns": ["*.log"],
+                "azure_storage_account_name": "account",
+                "azure_storage_account_key": "key",
+            },
+        ),
+        (
+            "Minimal config without credentials",
+            {"storage_uri": "az://container/dataset"},
+            {
+                "storage_uri": "az://container/dataset",
+                "ignore_patterns": None,
+                "azure_storage_account_name": None,
+                "azure_storage_account_key": None,
+            },
+        ),
+    ],
+)
+def test_load_config(test_name, test_config, expected):
+    """Test config loading with different configurations"""
+    print(f"Running test: {test_name}")
+
+    azure_dataset_instance = AzureBlob()
+
+    with patch.object(utils, "get_config_from_env", return_value=test_config):
+        azure_dataset_instance.load_config()
+        assert azure_dataset_instance.config.__dict__ == expected
+
+    print("Test execution completed")
+
+
+@pytest.mark.parametrize(
+    "test_name, test_case",
+    [
+        (
+            "Successful download with storage account key",
+            {
+                "config": {
+                    "storage_uri": "az://container/path/subpath",
+                    "ignore_patterns": ["*.log"],
+                    "azure_storage_account_name": "account",
+                    "azure_storage_account_key": "key",
+                },
+                "expected_container": "container",
+                "expected_prefix": "path/subpath",
+                "expected_error": None,
+            },
+        ),
+        (
+            "Missing storage account name",
+            {
+                "config": {
+                    "storage_uri": "az://container/path",
+                    "ignore_patterns": None,
+                    "azure_storage_account_name": None,
+                    "azure_storage_account_key": None,
+                },
+                "expected_error": ValueError,
+            },
+        ),
+    ],
+)
+def test_download_dataset(test_name, test_case):
+    """Test dataset download with different configurations"""
+    print(f"Running test: {test_name}")
+
+    azure_dataset_instance = AzureBlob()
+    azure_dataset_instance.config = MagicMock(**test_case["config"])
+
+    with tempfile.TemporaryDirectory() as temp_dir:
+        dataset_path = os.path.join(temp_dir, "dataset")
+
+        mock_storage = MagicMock()
+
+        with (
+            patch(
+                "pkg.initializers.utils.opendal.AzureBlobStorage",
+                return_value=mock_storage,
+            ) as mock_azure_storage,
+            patch.object(utils, "DATASET_PATH", dataset_path),
+        ):
+            if test_case["expected_error"]:
+                with pytest.raises(test_case["expected_error"]):
+                    azure_dataset_instance.download_dataset()
+                mock_azure_storage.assert_not_called()
+            else:
+                azure_dataset_instance.download_dataset()
+
+                mock_azure_storage.assert_called_once_with(
+                    container=test_case["expected_container"],
+                    account_name=test_case["config"]["azure_storage_account_name"],
+                    account_key=test_case["config"]["azure_storage_account_key"],
+                )
+                mock_storage.download.assert_called_once_with(
+                    prefix=test_case["expected_prefix"],
+                    destination_path=dataset_path,
+                    ignore_patterns=test_case["config"]["ignore_patterns"],
+                )
+
+    print("Test execution completed")
@@ -0,0 +1,70 @@
+# Copyright The Kubeflow Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import logging
+import os
+import shutil
+import tarfile
+import tempfile
+import urllib.request
+import zipfile
+from urllib.parse import urlparse
+
+import pkg.initializers.types.types as types
+import pkg.initializers.utils.utils as utils
+
+logging.basicConfig(
+    format="%(asctime)s %(levelname)-8s [%(filename)s:%(lineno)d] %(message)s",
+    datefmt="%Y-%m-%dT%H:%M:%SZ",
+    level=logging.INFO,
+)
+
+
+class HTTP(utils.DatasetProvider):
+    def load_config(self):
+        config_dict = utils.get_config_from_env(types.HTTPDatasetInitializer)
+        self.config = types.HTTPDatasetInitializer(**config_dict)
+
+    def download_dataset(self):
+        file_name = os.path.basename(urlparse(self.config.storage_uri).path)
+        if not file_name:
+            raise ValueError(
+                f"STORAGE_URI must point to a file. STORAGE_URI: {self.config.storage_uri}"
+            )
+        logging.info(f"Downloading dataset from: {self.config.storage_uri}")
+
+        os.makedirs(utils.DATASET_PATH, exist_ok=True)
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            file_path = os.path.join(tmp_dir, file_name)
+            with urllib.request.urlopen(self.config.storage_uri) as response, open(
+                file_path, "wb"
+            ) as f:
+                shutil.copyfileobj(response, f)
+
+            if (self.config.initializer_extract or "").lower() != "true":
+                shutil.move(file_path, os.path.join(utils.DATASET_PATH, file_name))
+            elif file_name.endswith(".zip"):
+                with zipfile.ZipFile(file_path) as archive:
+                    archive.extractall(utils.DATASET_PATH)
+            elif file_name.endswith(".tar.gz"):
+                with tarfile.open(file_path, "r:gz") as archive:
+                    # Reject the members escaping the dataset path or linking outside of it.
+                    archive.extractall(utils.DATASET_PATH, filter="data")
+            else:
+                raise ValueError(
+                    "Only .tar.gz and .zip archives can be extracted. "
+                    f"STORAGE_URI: {self.config.storage_uri}"
+                )
+
+        logging.info("Dataset has been downloaded")
@@ -0,0 +1,171 @@
+# Copyright The Kubeflow Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import io
+import os
+import tarfile
+import tempfile
+import zipfile
+from unittest.mock import MagicMock, patch
+
+import pytest
+
+import pkg.initializers.utils.utils as utils
+from pkg.initializers.dataset.http import HTTP
+
+
+def make_tar_gz(files):
+    buffer = io.BytesIO()
+    with tarfile.open(fileobj=buffer, mode="w:gz") as archive:
+        for name, content in files.items():
+            info = tarfile.TarInfo(name)
+            info.size = len(content)
+            archive.addfile(info, io.BytesIO(content))
+    return buffer.getvalue()
+
+
+def make_zip(files):
+    buffer = io.BytesIO()
+    with zipfile.ZipFile(buffer, mode="w") as archive:
+        for name, content in files.items():
+            archive.writestr(name, content)
+    return buffer.getvalue()
+
+
+@pytest.mark.parametrize(
+    "test_name, test_config, expected",
+    [
+        (
+            "Config with the extract flag",
+            {
+                "storage_uri": "https://example.com/dataset.tar.gz",
+                "initializer_extract": "true",
+            },
+            {
+                "storage_uri": "https://example.com/dataset.tar.gz",
+                "initializer_extract": "true",
+            },
+        ),
+        (
+            "Minimal config without the extract flag",
+            {"storage_uri": "https://example.com/dataset.csv"},
+            {
+                "storage_uri": "https://example.com/dataset.csv",
+                "initializer_extract": None,
+            },
+        ),
+    ],
+)
+def test_load_config(test_name, test_config, expected):
+    """Test config loading with different configurations"""
+    print(f"Running test: {test_name}")
+
+    http_dataset_instance = HTTP()
+
+    with patch.object(utils, "get_config_from_env", return_value=test_config):
+        http_dataset_instance.load_config()
+        assert http_dataset_instance.config.__dict__ == expected
+
+    print("Test execution completed")
+
+
+@pytest.mark.parametrize(
+    "test_name, test_case",
+    [
+        (
+            "Download and extract the tarball",
+            {
+                "storage_uri": "https://example.com/datasets/alpaca.tar.gz",
+                "initializer_extract": "true",
+                "content": make_tar_gz({"alpaca/train.json": b"train"}),
+                "expected_files": {"alpaca/train.json": b"train"},
+                "expected_error": None,
+            },
+        ),
+        (
+            "Download and extract the zip archive",
+            {
+                "storage_uri": "http://example.com/datasets/alpaca.zip?version=1",
+                "initializer_extract": "true",
+                "content": make_zip({"train.json": b"train", "test.json": b"test"}),
+                "expected_files": {"train.json": b"train", "test.json": b"test"},
+                "expected_error": None,
+            },
+        ),
+        (
+            "Download the file without the extract flag",
+            {
+                "storage_uri": "https://example.com/datasets/alpaca.tar.gz",
+                "initializer_extract": None,
+                "content": b"archive",
+                "expected_files": {"alpaca.tar.gz": b"archive"},
+                "expected_error": None,
+            },
+        ),
+        (
+            "Extract the unsupported archive",
+            {
+                "storage_uri": "https://example.com/datasets/alpaca.rar",
+                "initializer_extract": "true",
+                "content": b"archive",
+                "expected_files": {},
+                "expected_error": ValueError,
+            },
+        ),
+        (
+            "Download the URI without the file",
+            {
+                "storage_uri": "https://example.com/",
+                "initializer_extract": None,
+                "content": b"",
+                "expected_files": {},
+                "expected_error": ValueError,
+            },
+        ),
+    ],
+)
+def test_download_dataset(test_name, test_case):
+    """Test dataset download with different configurations"""
+    print(f"Running test: {test_name}")
+
+    http_dataset_instance = HTTP()
+    http_dataset_instance.config = MagicMock(
+        storage_uri=test_case["storage_uri"],
+        initializer_extract=test_case["initializer_extract"],
+    )
+
+    # Create a temporary directory for downloads
+    with tempfile.TemporaryDirectory() as temp_dir:
+        dataset_path = os.path.join(temp_dir, "dataset")
+
+        with (
+            patch(
+                "urllib.request.urlopen",
+                return_value=io.BytesIO(test_case["content"]),
+            ) as mock_urlopen,
+            patch.object(utils, "DATASET_PATH", dataset_path),
+        ):
+            if test_case["expected_error"]:
+                with pytest.raises(test_case["expected_error"]):
+                    http_dataset_instance.download_dataset()
+            else:
+                http_dataset_instance.download_dataset()
+
+                # Verify the dataset was downloaded from the storage URI
+                mock_urlopen.assert_called_once_with(test_case["storage_uri"])
+                for name, content in test_case["expected_files"].items():
+                    with open(os.path.join(dataset_path, name), "rb") as f:
+                        assert f.read() == content
+
+    print("Test execution completed")
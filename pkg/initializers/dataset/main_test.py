@@ -37,6 +37,20 @@ from pkg.initializers.dataset.__main__ import main
                 "expected_error": None,
             },
         ),
+        (
+            "Successful download with HTTP provider",
+            {
+                "storage_uri": "https://example.com/dataset.tar.gz",
+                "expected_error": None,
+            },
+        ),
+        (
+            "Successful download with Azure Blob provider",
+            {
+                "storage_uri": "az://container/dataset",
+                "expected_error": None,
+            },
+        ),
         (
             "Missing storage URI environment variable",
             {
@@ -69,6 +83,8 @@ def test_dataset_main(test_name, test_case, mock_env_vars):
     # Setup mock instances
     mock_hf_instance = MagicMock()
     mock_s3_instance = MagicMock()
+    mock_http_instance = MagicMock()
+    mock_azure_instance = MagicMock()
 
     with patch(
         "pkg.initializers.dataset.huggingface.HuggingFace",
@@ -76,7 +92,15 @@ def test_dataset_main(test_name, test_case, mock_env_vars):
     ) as mock_hf, patch(
         "pkg.initializers.dataset.s3.S3",
         return_value=mock_s3_instance,
-    ) as mock_s3:
+    ) as mock_s3, patch(
+        "pkg.initializers.dataset.http.HTTP",
+        return_value=mock_http_instance,
+    ) as mock_http, patch(
+        "pkg.initializers.dataset.azure.AzureBlob",
+        return_value=mock_azure_instance,
+    ) as mock_azure, patch(
+        "pkg.initializers.utils.utils.write_metadata",
+    ) as mock_write_metadata:
 
         # Execute test
         if test_case["expected_error"]:
@@ -98,5 +122,22 @@ def test_dataset_main(test_name, test_case, mock_env_vars):
                 mock_s3_instance.load_config.assert_called_once()
                 mock_s3_instance.download_dataset.assert_called_once()
                 mock_s3.assert_called_once()
+            elif test_case["storage_uri"] and test_case["storage_uri"].startswith(
+                "https://"
+            ):
+                mock_http_instance.load_config.assert_called_once()
+                mock_http_instance.download_dataset.assert_called_once()
+                mock_http.assert_called_once()
+            elif test_case["storage_uri"] and test_case["storage_uri"].startswith(
+                "az://"
+            ):
+                mock_azure_instance.load_config.assert_called_once()
+                mock_azure_instance.download_dataset.assert_called_once()
+                mock_azure.assert_called_once()
+
+            # Verify the metadata is written next to the downloaded dataset
+            mock_write_metadata.assert_called_once_with(
+                "/workspace/dataset", test_case["storage_uri"]
+            )
 
     print("Test execution completed")
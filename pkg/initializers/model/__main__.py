@@ -27,30 +27,40 @@ logging.basicConfig(
 )
 
 
-def main():
-    logging.info("Starting pre-trained model initialization")
-
-    try:
-        storage_uri = os.environ[utils.STORAGE_URI_ENV]
-    except Exception as e:
-        logging.error("STORAGE_URI env variable must be set.")
-        raise e
-
+def get_model_provider(storage_uri: str) -> utils.ModelProvider:
     match urlparse(storage_uri).scheme:
         # TODO (andreyvelich): Implement more model providers.
         case utils.HF_SCHEME:
-            hf = HuggingFace()
-            hf.load_config()
-            hf.download_model()
+            provider = HuggingFace()
         case utils.S3_SCHEME:
-            s3 = S3()
-            s3.load_config()
-            s3.download_model()
+            provider = S3()
         case _:
             logging.error(
-                f"STORAGE_URI must have the valid model provider. STORAGE_URI: {storage_uri}"
+                f"Storage URI must have the valid model provider. Storage URI: {storage_uri}"
             )
             raise Exception
+    provider.load_config()
+    return provider
+
+
+def main():
+    logging.info("Starting pre-trained model initialization")
+
+    storage_uri = os.getenv(utils.STORAGE_URI_ENV)
+    checkpoint_storage_uri = os.getenv(utils.CHECKPOINT_STORAGE_URI_ENV)
+    # The TrainJob might resume the training from the checkpoint without the model.
+    if not storage_uri and not checkpoint_storage_uri:
+        logging.error("STORAGE_URI or CHECKPOINT_STORAGE_URI env variable must be set.")
+        raise Exception
+
+    if storage_uri:
+        get_model_provider(storage_uri).download_model()
+        utils.write_metadata(utils.MODEL_PATH, storage_uri)
+
+    if checkpoint_storage_uri:
+        get_model_provider(checkpoint_storage_uri).download_checkpoint(
+            checkpoint_storage_uri
+        )
 
 
 if __name__ == "__main__":
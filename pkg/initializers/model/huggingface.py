@@ -55,3 +55,21 @@ class HuggingFace(utils.ModelProvider):
         )
 
         logging.info("Model has been downloaded")
+
+    def download_checkpoint(self, storage_uri: str):
+        storage_uri_parsed = urlparse(storage_uri)
+        checkpoint_uri = storage_uri_parsed.netloc + storage_uri_parsed.path
+
+        logging.info(f"Downloading checkpoint: {checkpoint_uri}")
+        logging.info("-" * 40)
+
+        if self.config.access_token:
+            huggingface_hub.login(self.config.access_token)
+
+        # The checkpoint is downloaded as is, since it also contains the optimizer states.
+        huggingface_hub.snapshot_download(
+            repo_id=checkpoint_uri,
+            local_dir=utils.CHECKPOINT_PATH,
+        )
+
+        logging.info("Checkpoint has been downloaded")
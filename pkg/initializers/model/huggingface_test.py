@@ -114,3 +114,26 @@ def test_download_model(test_name, test_case):
             ignore_patterns=test_case["config"]["ignore_patterns"],
         )
     print("Test execution completed")
+
+
+def test_download_checkpoint():
+    """Test checkpoint download into the checkpoint path without the file patterns"""
+
+    huggingface_model_instance = HuggingFace()
+    huggingface_model_instance.config = MagicMock(
+        storage_uri=None,
+        ignore_patterns=["*.msgpack", "*.h5", "*.bin", "*.pt", "*.pth"],
+        access_token="test_token",
+    )
+
+    with patch("huggingface_hub.login") as mock_login, patch(
+        "huggingface_hub.snapshot_download"
+    ) as mock_download:
+
+        huggingface_model_instance.download_checkpoint("hf://org/checkpoint-1000")
+
+        mock_login.assert_called_once_with("test_token")
+        mock_download.assert_called_once_with(
+            repo_id="org/checkpoint-1000",
+            local_dir=utils.CHECKPOINT_PATH,
+        )
@@ -37,6 +37,30 @@ from pkg.initializers.model.__main__ import main
                 "expected_error": None,
             },
         ),
+        (
+            "Successful download of model and checkpoint with S3 provider",
+            {
+                "storage_uri": "s3://model/path",
+                "checkpoint_storage_uri": "s3://checkpoints/step-1000",
+                "expected_error": None,
+            },
+        ),
+        (
+            "Successful download of checkpoint without model",
+            {
+                "storage_uri": None,
+                "checkpoint_storage_uri": "hf://org/checkpoint",
+                "expected_error": None,
+            },
+        ),
+        (
+            "Invalid checkpoint storage URI scheme",
+            {
+                "storage_uri": None,
+                "checkpoint_storage_uri": "invalid://checkpoint/path",
+                "expected_error": Exception,
+            },
+        ),
         (
             "Missing storage URI environment variable",
             {
@@ -62,6 +86,7 @@ def test_model_main(test_name, test_case, mock_env_vars):
     # Setup mock environment variables
     env_vars = {
         "STORAGE_URI": test_case["storage_uri"],
+        "CHECKPOINT_STORAGE_URI": test_case.get("checkpoint_storage_uri"),
         "ACCESS_TOKEN": test_case.get("access_token"),
     }
     mock_env_vars(**env_vars)
@@ -76,7 +101,9 @@ def test_model_main(test_name, test_case, mock_env_vars):
     ) as mock_hf, patch(
         "pkg.initializers.model.__main__.S3",
         return_value=mock_s3_instance,
-    ) as mock_s3:
+    ) as mock_s3, patch(
+        "pkg.initializers.utils.utils.write_metadata",
+    ) as mock_write_metadata:
 
         # Execute test
         if test_case["expected_error"]:
@@ -89,14 +116,40 @@ def test_model_main(test_name, test_case, mock_env_vars):
             if test_case["storage_uri"] and test_case["storage_uri"].startswith(
                 "hf://"
             ):
-                mock_hf_instance.load_config.assert_called_once()
+                mock_hf_instance.load_config.assert_called()
                 mock_hf_instance.download_model.assert_called_once()
-                mock_hf.assert_called_once()
+                mock_hf.assert_called()
             elif test_case["storage_uri"] and test_case["storage_uri"].startswith(
                 "s3://"
             ):
-                mock_s3_instance.load_config.assert_called_once()
+                mock_s3_instance.load_config.assert_called()
                 mock_s3_instance.download_model.assert_called_once()
-                mock_s3.assert_called_once()
+                mock_s3.assert_called()
+
+            # Verify the checkpoint was downloaded by the provider of its storage URI
+            checkpoint_storage_uri = test_case.get("checkpoint_storage_uri")
+            if checkpoint_storage_uri and checkpoint_storage_uri.startswith("hf://"):
+                mock_hf_instance.download_checkpoint.assert_called_once_with(
+                    checkpoint_storage_uri
+                )
+            elif checkpoint_storage_uri and checkpoint_storage_uri.startswith(
+                "s3://"
+            ):
+                mock_s3_instance.download_checkpoint.assert_called_once_with(
+                    checkpoint_storage_uri
+                )
+            else:
+                mock_hf_instance.download_checkpoint.assert_not_called()
+                mock_s3_instance.download_checkpoint.assert_not_called()
+
+            # Verify the metadata is written next to the downloaded model
+            if test_case["storage_uri"]:
+                mock_write_metadata.assert_called_once_with(
+                    "/workspace/model", test_case["storage_uri"]
+                )
+            else:
+                mock_hf_instance.download_model.assert_not_called()
+                mock_s3_instance.download_model.assert_not_called()
+                mock_write_metadata.assert_not_called()
 
     print("Test execution completed")
@@ -32,7 +32,22 @@ class S3(utils.ModelProvider):
         self.config = types.S3ModelInitializer(**config_dict)
 
     def download_model(self):
-        storage_uri_parsed = urlparse(self.config.storage_uri)
+        self._download(
+            storage_uri=self.config.storage_uri,
+            destination_path=utils.MODEL_PATH,
+            ignore_patterns=self.config.ignore_patterns,
+        )
+
+    def download_checkpoint(self, storage_uri: str):
+        # The checkpoint is downloaded as is, since the ignore patterns apply to the model files.
+        self._download(
+            storage_uri=storage_uri,
+            destination_path=utils.CHECKPOINT_PATH,
+            ignore_patterns=None,
+        )
+
+    def _download(self, storage_uri, destination_path, ignore_patterns):
+        storage_uri_parsed = urlparse(storage_uri)
         bucket = storage_uri_parsed.netloc
         prefix = storage_uri_parsed.path.lstrip("/")
 
@@ -47,6 +62,6 @@ class S3(utils.ModelProvider):
 
         s3_storage.download(
             prefix=prefix,
-            destination_path=utils.MODEL_PATH,
-            ignore_patterns=self.config.ignore_patterns,
+            destination_path=destination_path,
+            ignore_patterns=ignore_patterns,
         )
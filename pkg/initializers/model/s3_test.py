@@ -155,3 +155,48 @@ def test_download_model(test_name, test_case):
             )
 
     print("Test execution completed")
+
+
+def test_download_checkpoint():
+    """Test checkpoint download into the checkpoint path without the ignore patterns"""
+
+    s3_model_instance = S3()
+    s3_model_instance.config = MagicMock(
+        storage_uri=None,
+        ignore_patterns=["*.pt"],
+        endpoint=None,
+        access_key_id="test_access_key",
+        secret_access_key="test_secret_key",
+        region="us-east-1",
+        role_arn=None,
+    )
+
+    with tempfile.TemporaryDirectory() as temp_dir:
+        checkpoint_path = os.path.join(temp_dir, "checkpoint")
+
+        mock_storage = MagicMock()
+
+        with (
+            patch(
+                "pkg.initializers.utils.opendal.S3Storage", return_value=mock_storage
+            ),
+            patch.object(utils, "CHECKPOINT_PATH", checkpoint_path),
+        ):
+            s3_model_instance.download_checkpoint("s3://checkpoints/run/step-1000")
+
+            from pkg.initializers.utils.opendal import S3Storage
+
+            S3Storage.assert_called_once_with(
+                bucket="checkpoints",
+                endpoint=None,
+                access_key_id="test_access_key",
+                secret_access_key="test_secret_key",
+                region="us-east-1",
+                role_arn=None,
+            )
+
+            mock_storage.download.assert_called_once_with(
+                prefix="run/step-1000",
+                destination_path=checkpoint_path,
+                ignore_patterns=None,
+            )
@@ -37,6 +37,22 @@ class S3DatasetInitializer:
     role_arn: Optional[str] = None
 
 
+# Configuration for the HTTP(S) dataset initializer.
+@dataclass
+class HTTPDatasetInitializer:
+    storage_uri: str
+    initializer_extract: Optional[str] = None
+
+
+# Configuration for the Azure Blob Storage dataset initializer.
+@dataclass
+class AzureBlobDatasetInitializer:
+    storage_uri: str
+    ignore_patterns: Optional[list[str]] = None
+    azure_storage_account_name: Optional[str] = None
+    azure_storage_account_key: Optional[str] = None
+
+
 # Configuration for the HuggingFace model initializer.
 @dataclass
 class HuggingFaceModelInitializer:
@@ -16,7 +16,7 @@
 
 import fnmatch
 import logging
-from abc import ABC, abstractmethod
+from abc import ABC
 from pathlib import Path
 from typing import Optional
 
@@ -24,14 +24,56 @@ import opendal
 
 
 class OpenDALStorage(ABC):
-    @abstractmethod
+    """Downloads the files with the OpenDAL operator set by the subclasses."""
+
     def download(
         self,
         prefix: str,
         destination_path: str,
         ignore_patterns: Optional[list[str]] = None,
     ):
-        raise NotImplementedError()
+        logging.info(f"Downloading files from {self.location}, prefix: {prefix}")
+        logging.info("-" * 40)
+
+        try:
+            destination = Path(destination_path)
+            destination.mkdir(parents=True, exist_ok=True)
+
+            # List all objects with the given prefix
+            entries = self.op.list(prefix, recursive=True)
+
+            for entry in entries:
+                if entry.metadata.is_dir:
+                    continue
+
+                key = entry.path
+                if ignore_patterns:
+                    if any(fnmatch.fnmatch(key, p) for p in ignore_patterns):
+                        logging.info(f"Skipping ignored file: {key}")
+                        continue
+
+                # Create relative path from the prefix
+                relative_path = key[len(prefix) :].lstrip("/")
+                if not relative_path:
+                    # If prefix matches exactly, use the filename
+                    relative_path = Path(key).name
+
+                file_destination_path = destination / relative_path
+
+                # Create directory if needed
+                file_destination_path.parent.mkdir(parents=True, exist_ok=True)
+
+                # Download the file via OpenDAL
+                logging.info(f"Downloading {key} to {file_destination_path}")
+                data = self.op.read(key)
+                with open(file_destination_path, "wb") as f:
+                    f.write(data)
+
+        except Exception as e:
+            logging.error(f"Unexpected error downloading files: {e}")
+            raise
+
+        logging.info("Files have been downloaded")
 
 
 class S3Storage(OpenDALStorage):
@@ -70,54 +112,28 @@ class S3Storage(OpenDALStorage):
         self.op = opendal.Operator("s3", **config).layer(retry_layer)
 
         self.bucket = bucket
+        self.location = f"S3 bucket: {bucket}"
 
-    def download(
+
+class AzureBlobStorage(OpenDALStorage):
+    def __init__(
         self,
-        prefix: str,
-        destination_path: str,
-        ignore_patterns: Optional[list[str]] = None,
+        container: str,
+        account_name: str,
+        account_key: Optional[str] = None,
     ):
-        logging.info(
-            f"Downloading files from S3 bucket: {self.bucket}, prefix: {prefix}"
-        )
-        logging.info("-" * 40)
-
-        try:
-            destination = Path(destination_path)
-            destination.mkdir(parents=True, exist_ok=True)
-
-            # List all objects with the given prefix
-            entries = self.op.list(prefix, recursive=True)
-
-            for entry in entries:
-                if entry.metadata.is_dir:
-                    continue
-
-                key = entry.path
-                if ignore_patterns:
-                    if any(fnmatch.fnmatch(key, p) for p in ignore_patterns):
-                        logging.info(f"Skipping ignored file: {key}")
-                        continue
-
-                # Create relative path from the prefix
-                relative_path = key[len(prefix) :].lstrip("/")
-                if not relative_path:
-                    # If prefix matches exactly, use the filename
-                    relative_path = Path(key).name
-
-                file_destination_path = destination / relative_path
-
-                # Create directory if needed
-                file_destination_path.parent.mkdir(parents=True, exist_ok=True)
+        config = {
+            "root": "/",
+            "container": container,
+            "endpoint": f"https://{account_name}.blob.core.windows.net",
+            "account_name": account_name,
+        }
 
-                # Download the file via OpenDAL
-                logging.info(f"Downloading {key} to {file_destination_path}")
-                data = self.op.read(key)
-                with open(file_destination_path, "wb") as f:
-                    f.write(data)
+        if account_key:
+            config["account_key"] = account_key
 
-        except Exception as e:
-            logging.error(f"Unexpected error downloading files: {e}")
-            raise
+        retry_layer = opendal.layers.RetryLayer(max_times=3, factor=2.0, jitter=True)
+        self.op = opendal.Operator("azblob", **config).layer(retry_layer)
 
-        logging.info("Files have been downloaded")
+        self.container = container
+        self.location = f"Azure Blob container: {container}"
@@ -21,7 +21,7 @@ from unittest.mock import MagicMock, Mock, patch
 
 import pytest
 
-from pkg.initializers.utils.opendal import S3Storage
+from pkg.initializers.utils.opendal import AzureBlobStorage, S3Storage
 
 
 class TestS3Storage:
@@ -162,3 +162,40 @@ class TestS3Storage:
                             assert (
                                 actual_content == expected_content
                             ), f"Content mismatch for {relative_path}"
+
+
+class TestAzureBlobStorage:
+    """Test suite for AzureBlobStorage class."""
+
+    @pytest.mark.parametrize(
+        "config",
+        [
+            {"container": "test-container", "account_name": "account"},
+            {
+                "container": "test-container",
+                "account_name": "account",
+                "account_key": "key",
+            },
+        ],
+    )
+    def test_init(self, config):
+        """Test AzureBlobStorage initialization with various configurations."""
+        with patch("pkg.initializers.utils.opendal.opendal") as mock_opendal:
+            mock_operator = MagicMock()
+            mock_opendal.Operator.return_value = mock_operator
+            mock_operator.layer.return_value = mock_operator
+
+            storage = AzureBlobStorage(**config)
+
+            assert storage.container == config["container"]
+
+            # Verify Operator was called with the azblob scheme and correct parameters
+            assert mock_opendal.Operator.call_args[0] == ("azblob",)
+            call_kwargs = mock_opendal.Operator.call_args[1]
+            assert call_kwargs["container"] == config["container"]
+            assert call_kwargs["account_name"] == config["account_name"]
+            assert (
+                call_kwargs["endpoint"]
+                == f"https://{config['account_name']}.blob.core.windows.net"
+            )
+            assert call_kwargs.get("account_key") == config.get("account_key")
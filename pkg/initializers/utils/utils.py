@@ -12,15 +12,20 @@
 # See the License for the specific language governing permissions and
 # limitations under the License.
 
+import json
 import os
 from abc import ABC, abstractmethod
 from dataclasses import fields
 from typing import Dict
 
 STORAGE_URI_ENV = "STORAGE_URI"
+CHECKPOINT_STORAGE_URI_ENV = "CHECKPOINT_STORAGE_URI"
 HF_SCHEME = "hf"
 CACHE_SCHEME = "cache"
 S3_SCHEME = "s3"
+AZ_SCHEME = "az"
+HTTP_SCHEME = "http"
+HTTPS_SCHEME = "https"
 
 # The default path to the users' workspace.
 # TODO (andreyvelich): Discuss how to keep this path is sync with Kubeflow SDK constants.
@@ -32,6 +37,13 @@ DATASET_PATH = os.path.join(WORKSPACE_PATH, "dataset")
 # The path where initializer downloads model.
 MODEL_PATH = os.path.join(WORKSPACE_PATH, "model")
 
+# The path where initializer downloads checkpoint to resume the training from.
+CHECKPOINT_PATH = os.path.join(WORKSPACE_PATH, "checkpoint")
+
+# The file name of the metadata which the initializers write next to the downloaded files.
+# The trainer reads it from the DATASET_METADATA_PATH and MODEL_METADATA_PATH envs.
+METADATA_FILE_NAME = ".metadata.json"
+
 
 class ModelProvider(ABC):
     @abstractmethod
@@ -42,6 +54,10 @@ class ModelProvider(ABC):
     def download_model(self):
         raise NotImplementedError()
 
+    @abstractmethod
+    def download_checkpoint(self, storage_uri: str):
+        raise NotImplementedError()
+
 
 class DatasetProvider(ABC):
     @abstractmethod
@@ -53,6 +69,30 @@ class DatasetProvider(ABC):
         raise NotImplementedError()
 
 
+def write_metadata(path: str, storage_uri: str):
+    """Write the metadata of the files downloaded into the path for the trainer."""
+    metadata_file = os.path.join(path, METADATA_FILE_NAME)
+    # The metadata is written to the temporary file first,
+    # so that the trainer never reads the partially written metadata.
+    tmp_file = metadata_file + ".tmp"
+
+    files, size_bytes = 0, 0
+    for root, _, names in os.walk(path):
+        for name in names:
+            file = os.path.join(root, name)
+            if file in (metadata_file, tmp_file):
+                continue
+            files += 1
+            size_bytes += os.path.getsize(file)
+
+    os.makedirs(path, exist_ok=True)
+    with open(tmp_file, "w") as f:
+        json.dump(
+            {"storageUri": storage_uri, "files": files, "sizeBytes": size_bytes}, f
+        )
+    os.replace(tmp_file, metadata_file)
+
+
 # Get DataClass config from the environment variables.
 # Env names must be equal to the DataClass parameters.
 def get_config_from_env(config) -> Dict:
@@ -12,6 +12,9 @@
 # See the License for the specific language governing permissions and
 # limitations under the License.
 
+import json
+import os
+
 import pytest
 
 import pkg.initializers.types.types as types
@@ -151,3 +154,23 @@ def test_get_config_from_env(mock_env_vars, config_class, env_vars, expected):
     mock_env_vars(**env_vars)
     result = utils.get_config_from_env(config_class)
     assert result == expected
+
+
+def test_write_metadata(tmp_path):
+    (tmp_path / "train.csv").write_text("a,b\n1,2\n")
+    (tmp_path / "shards").mkdir()
+    (tmp_path / "shards" / "shard-0.parquet").write_bytes(b"0123456789")
+    # The stale metadata is not counted as the downloaded file.
+    (tmp_path / utils.METADATA_FILE_NAME).write_text("{}")
+
+    utils.write_metadata(str(tmp_path), "s3://bucket/path")
+
+    with open(os.path.join(tmp_path, utils.METADATA_FILE_NAME)) as f:
+        assert json.load(f) == {
+            "storageUri": "s3://bucket/path",
+            "files": 2,
+            "sizeBytes": 18,
+        }
+    assert not os.path.exists(
+        os.path.join(tmp_path, utils.METADATA_FILE_NAME + ".tmp")
+    )
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	subsystemName = "kubeflow_trainer"
+
+	// ExtensionPointEnforceMLPolicy is the label value for the EnforceMLPolicy plugin extension point.
+	ExtensionPointEnforceMLPolicy = "EnforceMLPolicy"
+	// ExtensionPointBuild is the label value for the ComponentBuilder plugin extension point.
+	ExtensionPointBuild = "Build"
+)
+
+// DefaultReconcileLatencyBuckets are the controller-runtime reconcile time histogram buckets.
+var DefaultReconcileLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.15, 0.2, 0.25, 0.3, 0.35, 0.4, 0.45, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0,
+	1.25, 1.5, 1.75, 2.0, 2.5, 3.0, 3.5, 4.0, 4.5, 5, 6, 7, 8, 9, 10, 15, 20, 25, 30, 40, 50, 60}
+
+var (
+	// TrainJobReconcileDuration records the duration of each TrainJob reconciliation.
+	TrainJobReconcileDuration = newTrainJobReconcileDuration(DefaultReconcileLatencyBuckets)
+
+	// PluginExecutionDuration records the duration of each plugin execution, labeled by
+	// the plugin name and the extension point.
+	PluginExecutionDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: subsystemName,
+			Name:      "plugin_execution_duration_seconds",
+			Help:      "The duration of the plugin execution per extension point in seconds",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 15),
+		}, []string{"plugin", "extension_point"},
+	)
+)
+
+func newTrainJobReconcileDuration(buckets []float64) prometheus.Histogram {
+	return prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Subsystem: subsystemName,
+			Name:      "trainjob_reconcile_duration_seconds",
+			Help:      "The duration of the TrainJob reconciliation in seconds",
+			Buckets:   buckets,
+		},
+	)
+}
+
+// ObserveTrainJobReconcileDuration records the time elapsed since start for the TrainJob reconciliation.
+func ObserveTrainJobReconcileDuration(start time.Time) {
+	TrainJobReconcileDuration.Observe(time.Since(start).Seconds())
+}
+
+// ObservePluginExecutionDuration records the time elapsed since start for the given plugin and extension point.
+func ObservePluginExecutionDuration(plugin, extensionPoint string, start time.Time) {
+	PluginExecutionDuration.WithLabelValues(plugin, extensionPoint).Observe(time.Since(start).Seconds())
+}
+
+// Register registers the Trainer metrics with the controller-runtime metrics registry.
+// The reconcile latency buckets default to DefaultReconcileLatencyBuckets when empty.
+func Register(reconcileLatencyBuckets []float64) {
+	if len(reconcileLatencyBuckets) != 0 {
+		TrainJobReconcileDuration = newTrainJobReconcileDuration(reconcileLatencyBuckets)
+	}
+	metrics.Registry.MustRegister(
+		TrainJobReconcileDuration,
+		PluginExecutionDuration,
+	)
+}
@@ -21,17 +21,22 @@ import (
 	"errors"
 	"fmt"
 
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
 	trainingruntime "github.com/kubeflow/trainer/v2/pkg/util/trainingruntime"
 )
 
@@ -57,22 +62,18 @@ func NewClusterTrainingRuntime(context.Context, client.Client, client.FieldIndex
 }
 
 func (r *ClusterTrainingRuntime) NewObjects(ctx context.Context, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
-	var clTrainingRuntime trainer.ClusterTrainingRuntime
-	// Try to get runtime from snapshot first
-	if err := getRuntimeSnapshot(ctx, r.client, trainJob, &clTrainingRuntime); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return nil, fmt.Errorf("getting runtime snapshot: %w", err)
-		}
+	return r.newObjects(ctx, trainJob, false)
+}
 
-		// Snapshot doesn't exist, load runtime from API server
-		if err := r.client.Get(ctx, client.ObjectKey{Name: trainJob.Spec.RuntimeRef.Name}, &clTrainingRuntime); err != nil {
-			return nil, fmt.Errorf("%w: %w", errorNotFoundSpecifiedClusterTrainingRuntime, err)
-		}
+func (r *ClusterTrainingRuntime) DryRunObjects(ctx context.Context, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
+	return r.newObjects(ctx, trainJob, true)
+}
 
-		// Create snapshot for future reconciliations
-		if err := createRuntimeSnapshot(ctx, r.client, trainJob, &clTrainingRuntime); err != nil {
-			return nil, fmt.Errorf("creating runtime snapshot: %w", err)
-		}
+func (r *ClusterTrainingRuntime) newObjects(ctx context.Context, trainJob *trainer.TrainJob, dryRun bool) ([]apiruntime.ApplyConfiguration, error) {
+	clTrainingRuntime, err := loadRuntime[trainer.ClusterTrainingRuntime](ctx, r.client, trainJob,
+		client.ObjectKey{Name: trainJob.Spec.RuntimeRef.Name}, errorNotFoundSpecifiedClusterTrainingRuntime, dryRun)
+	if err != nil {
+		return nil, err
 	}
 
 	info, err := r.RuntimeInfo(trainJob, clTrainingRuntime.Spec.Template, clTrainingRuntime.Spec.MLPolicy, clTrainingRuntime.Spec.PodGroupPolicy)
@@ -93,7 +94,17 @@ func (r *ClusterTrainingRuntime) TrainJobStatus(ctx context.Context, trainJob *t
 }
 
 func (r *ClusterTrainingRuntime) EventHandlerRegistrars() []runtime.ReconcilerBuilder {
-	return nil
+	return []runtime.ReconcilerBuilder{
+		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
+			return b.Watches(
+				&trainer.ClusterTrainingRuntime{},
+				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+					return trainJobRequestsForRuntime(ctx, cl, obj, idxer.TrainJobClusterRuntimeRefKey)
+				}),
+				builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+			)
+		},
+	}
 }
 
 func (r *ClusterTrainingRuntime) ValidateObjects(ctx context.Context, old, new *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
@@ -119,5 +130,7 @@ func (r *ClusterTrainingRuntime) ValidateObjects(ctx context.Context, old, new *
 	if len(fwWarnings) != 0 {
 		warnings = append(warnings, fwWarnings...)
 	}
+	errs = append(errs, trainingruntime.ValidateTrainJob(clusterTrainingRuntime.Annotations, new)...)
+	errs = append(errs, trainingruntime.ValidateAccelerator(clusterTrainingRuntime.Labels, new)...)
 	return warnings, errs
 }
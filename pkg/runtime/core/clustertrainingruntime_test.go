@@ -111,6 +111,7 @@ func TestClusterTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 				testingutil.MakeSchedulerPluginsPodGroup(metav1.NamespaceDefault, "test-job").
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
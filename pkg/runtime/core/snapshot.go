@@ -21,10 +21,13 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/yaml"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
@@ -35,6 +38,50 @@ const (
 	runtimeDataKey        = "runtime"
 )
 
+// loadRuntime returns the runtime referenced by the TrainJob. The running TrainJob uses the runtime snapshot,
+// which is created from the runtime on the first reconciliation. The suspended TrainJob follows the runtime
+// changes instead, so its snapshot is refreshed once the runtime generation diverges from the snapshot one.
+// If the runtime no longer exists, the snapshot is used as is. errNotFound wraps the error of getting the
+// runtime when no snapshot is available. With dryRun, the snapshot is neither created nor refreshed.
+func loadRuntime[T any, PT interface {
+	*T
+	client.Object
+}](ctx context.Context, c client.Client, trainJob *trainer.TrainJob, key client.ObjectKey, errNotFound error, dryRun bool) (PT, error) {
+	snapshot := PT(new(T))
+	err := getRuntimeSnapshot(ctx, c, trainJob, snapshot)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("getting runtime snapshot: %w", err)
+	}
+	hasSnapshot := err == nil
+	if hasSnapshot && !ptr.Deref(trainJob.Spec.Suspend, false) {
+		return snapshot, nil
+	}
+
+	current := PT(new(T))
+	if err := c.Get(ctx, key, current); err != nil {
+		if hasSnapshot && apierrors.IsNotFound(err) {
+			return snapshot, nil
+		}
+		return nil, fmt.Errorf("%w: %w", errNotFound, err)
+	}
+	if hasSnapshot && snapshot.GetGeneration() == current.GetGeneration() {
+		return snapshot, nil
+	}
+	if dryRun {
+		return current, nil
+	}
+	// The snapshot is verified against the RuntimeRef by the kind, which is not always set by the client.
+	gvk, err := apiutil.GVKForObject(current, c.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	current.GetObjectKind().SetGroupVersionKind(gvk)
+	if err := createRuntimeSnapshot(ctx, c, trainJob, current); err != nil {
+		return nil, fmt.Errorf("creating runtime snapshot: %w", err)
+	}
+	return current, nil
+}
+
 // getRuntimeSnapshot retrieves the runtime snapshot from the ConfigMap and unmarshalls the value into runtimeObj.
 // Returns an error if the snapshot ConfigMap doesn't exist or if the data is invalid. The value of runtimeObj is
 // only valid if no error was returned.
@@ -96,7 +143,7 @@ func getRuntimeSnapshot(ctx context.Context, c client.Client, trainJob *trainer.
 
 // createRuntimeSnapshot creates a ConfigMap containing a YAML-serialized snapshot of the runtime configuration.
 // The ConfigMap is owned by the TrainJob and will be automatically deleted when the TrainJob is deleted.
-// An existing configmap will be overwritten. Callers must only invoke this when no snapshot exists.
+// An existing configmap will be overwritten, e.g. when the snapshot of the suspended TrainJob is refreshed.
 func createRuntimeSnapshot(ctx context.Context, c client.Client, trainJob *trainer.TrainJob, runtimeObj client.Object) error {
 	// Serialize the runtime object to YAML
 	runtimeYAML, err := yaml.Marshal(runtimeObj)
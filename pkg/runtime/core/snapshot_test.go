@@ -18,6 +18,7 @@ package core
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
@@ -605,3 +606,97 @@ func TestRuntimeSnapshotRoundTrip(t *testing.T) {
 		t.Errorf("Runtime name mismatch: expected %s, got %s", originalRuntime.Name, retrievedRuntime.Name)
 	}
 }
+
+func TestLoadRuntime(t *testing.T) {
+	makeRuntime := func(generation int64, marker string) *trainer.TrainingRuntime {
+		trainingRuntime := testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Obj()
+		trainingRuntime.Generation = generation
+		trainingRuntime.Labels = map[string]string{"snapshot-test-marker": marker}
+		return trainingRuntime
+	}
+
+	cases := map[string]struct {
+		suspend      bool
+		snapshot     *trainer.TrainingRuntime
+		runtime      *trainer.TrainingRuntime
+		wantMarker   string
+		wantSnapshot string
+		wantError    error
+	}{
+		"creates the snapshot from the runtime if no snapshot exists": {
+			runtime:      makeRuntime(1, "v1"),
+			wantMarker:   "v1",
+			wantSnapshot: "v1",
+		},
+		"running TrainJob uses the snapshot even if the runtime is updated": {
+			snapshot:     makeRuntime(1, "v1"),
+			runtime:      makeRuntime(2, "v2"),
+			wantMarker:   "v1",
+			wantSnapshot: "v1",
+		},
+		"suspended TrainJob uses the snapshot if the runtime is not updated": {
+			suspend:      true,
+			snapshot:     makeRuntime(1, "v1"),
+			runtime:      makeRuntime(1, "v1"),
+			wantMarker:   "v1",
+			wantSnapshot: "v1",
+		},
+		"suspended TrainJob refreshes the snapshot if the runtime is updated": {
+			suspend:      true,
+			snapshot:     makeRuntime(1, "v1"),
+			runtime:      makeRuntime(2, "v2"),
+			wantMarker:   "v2",
+			wantSnapshot: "v2",
+		},
+		"suspended TrainJob uses the snapshot if the runtime is deleted": {
+			suspend:      true,
+			snapshot:     makeRuntime(1, "v1"),
+			wantMarker:   "v1",
+			wantSnapshot: "v1",
+		},
+		"fails if neither the snapshot nor the runtime exists": {
+			suspend:   true,
+			wantError: errorNotFoundSpecifiedTrainingRuntime,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			trainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("test-uid").
+				Suspend(tc.suspend).
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj()
+			clientBuilder := testingutil.NewClientBuilder()
+			if tc.runtime != nil {
+				clientBuilder = clientBuilder.WithObjects(tc.runtime)
+			}
+			c := clientBuilder.Build()
+			if tc.snapshot != nil {
+				if err := createRuntimeSnapshot(ctx, c, trainJob, tc.snapshot); err != nil {
+					t.Fatalf("Failed to create snapshot: %v", err)
+				}
+			}
+
+			got, err := loadRuntime[trainer.TrainingRuntime](ctx, c, trainJob, client.ObjectKeyFromObject(makeRuntime(0, "")), errorNotFoundSpecifiedTrainingRuntime, false)
+			if !errors.Is(err, tc.wantError) {
+				t.Fatalf("Unexpected error (want %v): %v", tc.wantError, err)
+			}
+			if tc.wantError != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.wantMarker, got.Labels["snapshot-test-marker"]); len(diff) != 0 {
+				t.Errorf("Unexpected runtime (-want,+got):\n%s", diff)
+			}
+			snapshot := &trainer.TrainingRuntime{}
+			if err := getRuntimeSnapshot(ctx, c, trainJob, snapshot); err != nil {
+				t.Fatalf("Failed to get snapshot: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantSnapshot, snapshot.Labels["snapshot-test-marker"]); len(diff) != 0 {
+				t.Errorf("Unexpected snapshot (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
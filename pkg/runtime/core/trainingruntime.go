@@ -25,15 +25,21 @@ import (
 	"sort"
 
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
@@ -86,23 +92,19 @@ func NewTrainingRuntime(ctx context.Context, c client.Client, indexer client.Fie
 }
 
 func (r *TrainingRuntime) NewObjects(ctx context.Context, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
-	var trainingRuntime trainer.TrainingRuntime
-	// Try to get runtime from snapshot first
-	if err := getRuntimeSnapshot(ctx, r.client, trainJob, &trainingRuntime); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return nil, fmt.Errorf("unable to get runtime snapshot: %w", err)
-		}
+	return r.newObjects(ctx, trainJob, false)
+}
 
-		// Snapshot doesn't exist, load runtime from API server
-		err := r.client.Get(ctx, client.ObjectKey{Namespace: trainJob.Namespace, Name: trainJob.Spec.RuntimeRef.Name}, &trainingRuntime)
-		if err != nil {
-			return nil, fmt.Errorf("%w: %w", errorNotFoundSpecifiedTrainingRuntime, err)
-		}
+// DryRunObjects builds the objects like NewObjects, but the runtime snapshot is not persisted.
+func (r *TrainingRuntime) DryRunObjects(ctx context.Context, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
+	return r.newObjects(ctx, trainJob, true)
+}
 
-		// Create snapshot for future reconciliations
-		if err := createRuntimeSnapshot(ctx, r.client, trainJob, &trainingRuntime); err != nil {
-			return nil, fmt.Errorf("creating runtime snapshot: %w", err)
-		}
+func (r *TrainingRuntime) newObjects(ctx context.Context, trainJob *trainer.TrainJob, dryRun bool) ([]apiruntime.ApplyConfiguration, error) {
+	trainingRuntime, err := loadRuntime[trainer.TrainingRuntime](ctx, r.client, trainJob,
+		client.ObjectKey{Namespace: trainJob.Namespace, Name: trainJob.Spec.RuntimeRef.Name}, errorNotFoundSpecifiedTrainingRuntime, dryRun)
+	if err != nil {
+		return nil, err
 	}
 
 	info, err := r.RuntimeInfo(trainJob, trainingRuntime.Spec.Template, trainingRuntime.Spec.MLPolicy, trainingRuntime.Spec.PodGroupPolicy)
@@ -193,45 +195,39 @@ func (r *TrainingRuntime) newRuntimeInfo(
 				if labelAncestor == constants.AncestorTrainer && mlPolicy != nil {
 					count = ptr.Deref(mlPolicy.NumNodes, 1)
 				}
+				if labelAncestor == constants.DatasetInitializer && trainJob.Spec.Initializer != nil &&
+					trainJob.Spec.Initializer.Dataset != nil && trainJob.Spec.Initializer.Dataset.Parallelism != nil {
+					count = *trainJob.Spec.Initializer.Dataset.Parallelism
+				}
 				ancestor = &labelAncestor
 			}
 		}
-		if trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.ResourcesPerNode != nil {
+		if jobTrainer := trainJob.Spec.Trainer; jobTrainer != nil && (jobTrainer.ResourcesPerNode != nil || len(jobTrainer.ResourcesPerContainer) != 0) {
 			isTrainerAncestor := ancestor != nil && *ancestor == constants.AncestorTrainer && mlPolicy != nil
 			isMPILauncherAsNode := mlPolicy != nil && mlPolicy.MPI != nil &&
 				ptr.Deref(mlPolicy.MPI.RunLauncherAsNode, false) && *rJob.Name == constants.Node
 			if isTrainerAncestor || isMPILauncherAsNode {
 				if applyPodSpec := jobSetSpecApply.ReplicatedJobs[i].Template.Spec.Template.Spec; applyPodSpec != nil {
+					podSpec := &jobSetTemplateSpec.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec
 					for k := range applyPodSpec.Containers {
-						if ptr.Deref(applyPodSpec.Containers[k].Name, "") != constants.Node {
-							continue
-						}
-						var baseRes corev1.ResourceRequirements
-						if r := applyPodSpec.Containers[k].Resources; r != nil {
-							if r.Limits != nil {
-								baseRes.Limits = *r.Limits
+						name := ptr.Deref(applyPodSpec.Containers[k].Name, "")
+						if name == constants.Node && jobTrainer.ResourcesPerNode != nil {
+							if err := mergeContainerResources(&applyPodSpec.Containers[k], &podSpec.Containers[k], *jobTrainer.ResourcesPerNode); err != nil {
+								return nil, err
 							}
-							if r.Requests != nil {
-								baseRes.Requests = *r.Requests
-							}
-						}
-						mergedRes, mergeErr := trainingruntimeutil.MergeResourceRequirements(
-							baseRes, *trainJob.Spec.Trainer.ResourcesPerNode)
-						if mergeErr != nil {
-							return nil, mergeErr
 						}
-						applyRes := &corev1ac.ResourceRequirementsApplyConfiguration{}
-						if mergedRes.Limits != nil {
-							limits := maps.Clone(mergedRes.Limits)
-							applyRes.Limits = &limits
+						if resources, ok := jobTrainer.ResourcesPerContainer[name]; ok {
+							if err := mergeContainerResources(&applyPodSpec.Containers[k], &podSpec.Containers[k], resources); err != nil {
+								return nil, err
+							}
 						}
-						if mergedRes.Requests != nil {
-							requests := maps.Clone(mergedRes.Requests)
-							applyRes.Requests = &requests
+					}
+					for k := range applyPodSpec.InitContainers {
+						if resources, ok := jobTrainer.ResourcesPerContainer[ptr.Deref(applyPodSpec.InitContainers[k].Name, "")]; ok {
+							if err := mergeContainerResources(&applyPodSpec.InitContainers[k], &podSpec.InitContainers[k], resources); err != nil {
+								return nil, err
+							}
 						}
-						applyPodSpec.Containers[k].Resources = applyRes
-						jobSetTemplateSpec.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[k].Resources = mergedRes
-						break
 					}
 				}
 			}
@@ -248,6 +244,36 @@ func (r *TrainingRuntime) newRuntimeInfo(
 	return runtime.NewInfo(opts...), nil
 }
 
+// mergeContainerResources merges the TrainJob resources into the runtime container resources,
+// and sets the merged resources to both the container apply configuration and the typed container.
+func mergeContainerResources(applyContainer *corev1ac.ContainerApplyConfiguration, container *corev1.Container, resources corev1.ResourceRequirements) error {
+	var baseRes corev1.ResourceRequirements
+	if r := applyContainer.Resources; r != nil {
+		if r.Limits != nil {
+			baseRes.Limits = *r.Limits
+		}
+		if r.Requests != nil {
+			baseRes.Requests = *r.Requests
+		}
+	}
+	mergedRes, err := trainingruntimeutil.MergeResourceRequirements(baseRes, resources)
+	if err != nil {
+		return err
+	}
+	applyRes := &corev1ac.ResourceRequirementsApplyConfiguration{}
+	if mergedRes.Limits != nil {
+		limits := maps.Clone(mergedRes.Limits)
+		applyRes.Limits = &limits
+	}
+	if mergedRes.Requests != nil {
+		requests := maps.Clone(mergedRes.Requests)
+		applyRes.Requests = &requests
+	}
+	applyContainer.Resources = applyRes
+	container.Resources = mergedRes
+	return nil
+}
+
 func (r *TrainingRuntime) mergeRuntimePatches(trainJob *trainer.TrainJob, jobSetTemplateSpec *trainer.JobSetTemplateSpec) error {
 	// Capture the original ReplicatedJobs ordering since SMP may reorder the list.
 	order := make(map[string]int, len(jobSetTemplateSpec.Spec.ReplicatedJobs))
@@ -294,13 +320,43 @@ func (r *TrainingRuntime) TrainJobStatus(ctx context.Context, trainJob *trainer.
 }
 
 func (r *TrainingRuntime) EventHandlerRegistrars() []runtime.ReconcilerBuilder {
-	var builders []runtime.ReconcilerBuilder
+	builders := []runtime.ReconcilerBuilder{
+		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
+			return b.Watches(
+				&trainer.TrainingRuntime{},
+				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+					return trainJobRequestsForRuntime(ctx, cl, obj, idxer.TrainJobRuntimeRefKey)
+				}),
+				builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+			)
+		},
+	}
 	for _, ex := range r.framework.WatchExtensionPlugins() {
 		builders = append(builders, ex.ReconcilerBuilders()...)
 	}
 	return builders
 }
 
+// trainJobRequestsForRuntime returns the reconcile requests for the TrainJobs referencing the runtime,
+// so that the suspended TrainJobs pick up the runtime changes. The running TrainJobs are enqueued as well,
+// but they keep using the runtime snapshot.
+func trainJobRequestsForRuntime(ctx context.Context, c client.Client, runtimeObj client.Object, indexKey string) []reconcile.Request {
+	opts := []client.ListOption{client.MatchingFields{indexKey: runtimeObj.GetName()}}
+	if runtimeObj.GetNamespace() != "" {
+		opts = append(opts, client.InNamespace(runtimeObj.GetNamespace()))
+	}
+	var trainJobs trainer.TrainJobList
+	if err := c.List(ctx, &trainJobs, opts...); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list TrainJobs referencing the runtime", "runtime", klog.KObj(runtimeObj))
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(trainJobs.Items))
+	for _, trainJob := range trainJobs.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&trainJob)})
+	}
+	return requests
+}
+
 func (r *TrainingRuntime) ValidateObjects(ctx context.Context, old, new *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
 	trainingRuntime := &trainer.TrainingRuntime{}
 	if err := r.client.Get(ctx, client.ObjectKey{
@@ -313,5 +369,8 @@ func (r *TrainingRuntime) ValidateObjects(ctx context.Context, old, new *trainer
 		}
 	}
 	info, _ := r.newRuntimeInfo(new, trainingRuntime.Spec.Template, trainingRuntime.Spec.MLPolicy, trainingRuntime.Spec.PodGroupPolicy) // ignoring the error here as the runtime configured should be valid
-	return r.framework.RunCustomValidationPlugins(ctx, info, old, new)
+	warnings, errs := r.framework.RunCustomValidationPlugins(ctx, info, old, new)
+	errs = append(errs, trainingruntimeutil.ValidateTrainJob(trainingRuntime.Annotations, new)...)
+	errs = append(errs, trainingruntimeutil.ValidateAccelerator(trainingRuntime.Labels, new)...)
+	return warnings, errs
 }
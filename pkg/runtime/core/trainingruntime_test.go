@@ -19,22 +19,29 @@ package core
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2/ktesting"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
 	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
 	testingutil "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
@@ -48,12 +55,14 @@ func torchTuneResWithCPU(gpu string) corev1.ResourceList {
 
 func wantJobSetWithMergedGPU(ns, name, uid string, requests corev1.ResourceList, gpu string) *jobsetv1alpha2.JobSet {
 	jobSet := testingutil.MakeJobSetWrapper(ns, name).
+		InitializerDependsOn(constants.Node).
 		ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), name, uid).
 		Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node, constants.Launcher).
 		Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
 		Completions(1, constants.DatasetInitializer, constants.ModelInitializer).
 		NumNodes(1).
 		Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, requests).
+		TrainJobIdentityEnv(constants.Node, types.UID(uid)).
 		Obj()
 	for i := range jobSet.Spec.ReplicatedJobs {
 		if jobSet.Spec.ReplicatedJobs[i].Name != constants.Node {
@@ -78,6 +87,16 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 	resRequests := corev1.ResourceList{
 		corev1.ResourceCPU: resource.MustParse("1"),
 	}
+	podFailurePolicy := &batchv1.PodFailurePolicy{
+		Rules: []batchv1.PodFailurePolicyRule{{
+			Action: batchv1.PodFailurePolicyActionIgnore,
+			OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+				ContainerName: ptr.To(constants.Node),
+				Operator:      batchv1.PodFailurePolicyOnExitCodesOpIn,
+				Values:        []int32{143},
+			},
+		}},
+	}
 
 	// TODO (andreyvelich): Add more test cases.
 	cases := map[string]struct {
@@ -128,10 +147,12 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Suspend(true).
 					Label("conflictLabel", "override").
 					Annotation("conflictAnnotation", "override").
+					ReplicatedJobAnnotation("conflictAnnotation", "override", constants.DatasetInitializer, constants.ModelInitializer, constants.Node, constants.Launcher).
 					PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, "test-job").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node, constants.Launcher).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
@@ -140,6 +161,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 					Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
 					Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
 					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 				testingutil.MakeSchedulerPluginsPodGroup(metav1.NamespaceDefault, "test-job").
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
@@ -198,6 +220,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
@@ -220,6 +243,32 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
+					Obj(),
+			},
+		},
+		"succeeded to build JobSet with the trainer podFailurePolicy from the Runtime.": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").RuntimeSpec(
+				testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					PodFailurePolicy(constants.Node, podFailurePolicy).
+					Obj(),
+			).Obj(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj(),
+			wantObjs: []runtime.Object{
+				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
+					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
+					Completions(1, constants.DatasetInitializer, constants.ModelInitializer).
+					NumNodes(1).
+					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					PodFailurePolicy(constants.Node, podFailurePolicy).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -498,6 +547,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					ServiceAccountName(constants.Node, "override-sa").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
@@ -668,6 +718,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							MountPath: "node_claim_mount_path",
 						},
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -744,6 +795,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
@@ -753,6 +805,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 					InitContainer(constants.Node, "override-init-container", "test:runtime").
 					Container(constants.Node, constants.Node, "test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
 					Container(constants.Node, "override-container", "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -818,6 +871,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
@@ -835,6 +889,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 						AllowPrivilegeEscalation: ptr.To(false),
 						ReadOnlyRootFilesystem:   ptr.To(true),
 					}).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -919,6 +974,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
@@ -934,6 +990,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 					ReplicatedJobLabel("job-k2", "job-v2", constants.Node).
 					PodLabelForJobs("k2", "v2", constants.Node).
 					PodAnnotationForJobs("a2", "v2", constants.Node).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -999,6 +1056,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
@@ -1009,6 +1067,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							"node.kubernetes.io/instance-type": "p5.48xlarge",
 						}).
 					ServiceAccountName(constants.Node, "test-sa").
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -1076,6 +1135,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
@@ -1085,6 +1145,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 						map[string]string{
 							"node.kubernetes.io/instance-type": "p5en.48xlarge",
 						}).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -1143,6 +1204,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
@@ -1197,6 +1259,19 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					Env(constants.Node, constants.Node,
+						[]corev1.EnvVar{
+							{
+								Name:  jobsetplgconsts.EnvDatasetMetadataPath,
+								Value: "/workspace/dataset/.metadata.json",
+							},
+							{
+								Name:  jobsetplgconsts.EnvModelMetadataPath,
+								Value: "/workspace/model/.metadata.json",
+							},
+						}...,
+					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -1270,6 +1345,10 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 									},
 								},
 							},
+							{
+								Name:  constants.TorchEnvWorldSize,
+								Value: "90",
+							},
 							{
 								Name:  constants.TorchEnvMasterAddr,
 								Value: fmt.Sprintf("test-job-%s-0-0.test-job", constants.Node),
@@ -1292,6 +1371,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -1345,6 +1425,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 				Obj(),
 			wantObjs: []runtime.Object{
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node, constants.Launcher).
 					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
@@ -1378,6 +1459,10 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 									},
 								},
 							},
+							{
+								Name:  constants.TorchEnvWorldSize,
+								Value: "100",
+							},
 							{
 								Name:  constants.TorchEnvMasterAddr,
 								Value: fmt.Sprintf("test-job-%s-0-0.test-job", constants.Node),
@@ -1392,6 +1477,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -1512,6 +1598,10 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 									},
 								},
 							},
+							{
+								Name:  constants.TorchEnvWorldSize,
+								Value: "90",
+							},
 						}...,
 					).
 					DependsOn(constants.Node,
@@ -1526,6 +1616,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -1666,6 +1757,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -1807,6 +1899,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -1951,6 +2044,7 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 							},
 						}...,
 					).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -2005,6 +2099,7 @@ test-job-node-0-1.test-job slots=8
 					WithType(corev1.SecretTypeSSHAuth).
 					Obj(),
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					LauncherReplica().
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node, constants.Launcher).
@@ -2108,6 +2203,7 @@ test-job-node-0-1.test-job slots=8
 						},
 					).
 					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -2205,6 +2301,142 @@ test-job-node-0-1.test-job slots=8
 	}
 }
 
+func TestRender(t *testing.T) {
+	resRequests := corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("1"),
+	}
+	torchRuntime := testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").RuntimeSpec(
+		testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+			WithMLPolicy(
+				testingutil.MakeMLPolicyWrapper().
+					WithNumNodes(100).
+					WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+					).
+					Obj(),
+			).
+			Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+			Obj(),
+	).Obj()
+
+	cases := map[string]struct {
+		trainingRuntime *trainer.TrainingRuntime
+		trainJob        *trainer.TrainJob
+		wantJobSet      *jobsetv1alpha2.JobSet
+		wantObjs        []client.Object
+		wantError       error
+	}{
+		"succeeded to render JobSet with Torch values from the TrainJob": {
+			trainingRuntime: torchRuntime,
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Trainer(
+					testingutil.MakeTrainJobTrainerWrapper().
+						NumNodes(30).
+						NumProcPerNode(3).
+						Obj(),
+				).
+				Obj(),
+			wantJobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+				InitializerDependsOn(constants.Node).
+				ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+				Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node, constants.Launcher).
+				Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
+				Completions(1, constants.DatasetInitializer, constants.ModelInitializer).
+				NumNodes(30).
+				Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+				ContainerTrainerPorts([]corev1.ContainerPort{{ContainerPort: constants.ContainerTrainerPort}}).
+				Env(constants.Node, constants.Node,
+					[]corev1.EnvVar{
+						{
+							Name:  constants.TorchEnvNumNodes,
+							Value: "30",
+						},
+						{
+							Name:  constants.TorchEnvNumProcPerNode,
+							Value: "3",
+						},
+						{
+							Name: constants.TorchEnvNodeRank,
+							ValueFrom: &corev1.EnvVarSource{
+								FieldRef: &corev1.ObjectFieldSelector{
+									FieldPath: constants.JobCompletionIndexFieldPath,
+								},
+							},
+						},
+						{
+							Name:  constants.TorchEnvWorldSize,
+							Value: "90",
+						},
+						{
+							Name:  constants.TorchEnvMasterAddr,
+							Value: fmt.Sprintf("test-job-%s-0-0.test-job", constants.Node),
+						},
+						{
+							Name:  constants.TorchEnvMasterPort,
+							Value: fmt.Sprintf("%d", constants.ContainerTrainerPort),
+						},
+					}...,
+				).
+				TrainJobIdentityEnv(constants.Node, "uid").
+				Obj(),
+		},
+		"missing trainingRuntime resource": {
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj(),
+			wantError: errorNotFoundSpecifiedTrainingRuntime,
+		},
+	}
+	cmpOpts := []cmp.Option{
+		cmpopts.SortSlices(func(a, b corev1.EnvVar) bool {
+			return a.Name < b.Name
+		}),
+		cmpopts.IgnoreFields(metav1.TypeMeta{}, "Kind", "APIVersion"),
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			clientBuilder := testingutil.NewClientBuilder()
+			if tc.trainingRuntime != nil {
+				clientBuilder.WithObjects(tc.trainingRuntime)
+			}
+			c := clientBuilder.Build()
+
+			trainingRuntime, err := NewTrainingRuntime(ctx, c, testingutil.AsIndex(clientBuilder), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			trainJob := tc.trainJob.DeepCopy()
+			jobSet, objs, err := jobruntimes.Render(ctx, trainingRuntime, trainJob)
+			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantJobSet, jobSet, cmpOpts...); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantObjs, objs); len(diff) != 0 {
+				t.Errorf("Unexpected objects (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.trainJob, trainJob); len(diff) != 0 {
+				t.Errorf("Unexpected TrainJob mutation (-want,+got):\n%s", diff)
+			}
+			snapshot := &corev1.ConfigMap{}
+			snapshotKey := client.ObjectKey{Namespace: tc.trainJob.Namespace, Name: tc.trainJob.Name + runtimeSnapshotSuffix}
+			if err = c.Get(ctx, snapshotKey, snapshot); !apierrors.IsNotFound(err) {
+				t.Errorf("Expected the runtime snapshot not to be persisted, got: %v", err)
+			}
+		})
+	}
+}
+
 func TestRuntimeInfo(t *testing.T) {
 	tests := map[string]struct {
 		templateType string
@@ -2239,3 +2471,182 @@ func TestRuntimeInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRuntimeInfoSidecarRuntimePatch(t *testing.T) {
+	cpu := func(v string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(v)}}
+	}
+	jobSetTemplateSpec := trainer.JobSetTemplateSpec{
+		Spec: jobsetv1alpha2.JobSetSpec{
+			ReplicatedJobs: []jobsetv1alpha2.ReplicatedJob{{
+				Name: constants.Node,
+				Template: batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{constants.LabelTrainJobAncestor: constants.AncestorTrainer},
+					},
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								InitContainers: []corev1.Container{{Name: "sidecar", Resources: cpu("5")}},
+								Containers:     []corev1.Container{{Name: constants.Node, Resources: cpu("10")}},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}
+	sidecarPatch := trainer.RuntimePatch{
+		Manager: "test.io/manager",
+		TrainingRuntimeSpec: &trainer.TrainingRuntimeSpecPatch{
+			Template: &trainer.JobSetTemplatePatch{
+				Spec: &trainer.JobSetSpecPatch{
+					ReplicatedJobs: []trainer.ReplicatedJobPatch{{
+						Name: constants.Node,
+						Template: &trainer.JobTemplatePatch{
+							Spec: &trainer.JobSpecPatch{
+								Template: &trainer.PodTemplatePatch{
+									Spec: &trainer.PodSpecPatch{
+										InitContainers: []trainer.ContainerPatch{{
+											Name:          "sidecar",
+											RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+										}},
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+	cases := map[string]struct {
+		runtimePatches        []trainer.RuntimePatch
+		wantSinglePodRequests corev1.ResourceList
+	}{
+		"init container is not counted in the running resource sum": {
+			wantSinglePodRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")},
+		},
+		"init container marked as sidecar by runtimePatches is counted in the running resource sum": {
+			runtimePatches:        []trainer.RuntimePatch{sidecarPatch},
+			wantSinglePodRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("15")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			trainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimePatches(tc.runtimePatches).
+				Obj()
+			info, err := (&TrainingRuntime{}).newRuntimeInfo(trainJob, *jobSetTemplateSpec.DeepCopy(), nil, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			ps := info.FindPodSetByName(constants.Node)
+			if ps == nil {
+				t.Fatalf("PodSet %s not found", constants.Node)
+			}
+			if diff := cmp.Diff(tc.wantSinglePodRequests, ps.SinglePodRequests); len(diff) != 0 {
+				t.Errorf("Unexpected SinglePodRequests (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewRuntimeInfoResourcesPerContainer(t *testing.T) {
+	cpu := func(v string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(v)}}
+	}
+	jobSetTemplateSpec := trainer.JobSetTemplateSpec{
+		Spec: jobsetv1alpha2.JobSetSpec{
+			ReplicatedJobs: []jobsetv1alpha2.ReplicatedJob{{
+				Name: constants.Node,
+				Template: batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{constants.LabelTrainJobAncestor: constants.AncestorTrainer},
+					},
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								InitContainers: []corev1.Container{{Name: "sidecar", Resources: cpu("5"), RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways)}},
+								Containers: []corev1.Container{
+									{Name: constants.Node, Resources: cpu("10")},
+									{Name: "metrics", Resources: cpu("1")},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}
+	cases := map[string]struct {
+		trainer               *trainer.Trainer
+		wantResources         map[string]corev1.ResourceList
+		wantSinglePodRequests corev1.ResourceList
+	}{
+		"resources are overridden for the named container only": {
+			trainer: &trainer.Trainer{
+				ResourcesPerContainer: map[string]corev1.ResourceRequirements{"metrics": cpu("2")},
+			},
+			wantResources: map[string]corev1.ResourceList{
+				"sidecar":      cpu("5").Requests,
+				constants.Node: cpu("10").Requests,
+				"metrics":      cpu("2").Requests,
+			},
+			wantSinglePodRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("17")},
+		},
+		"resources are overridden for the sidecar init container": {
+			trainer: &trainer.Trainer{
+				ResourcesPerContainer: map[string]corev1.ResourceRequirements{"sidecar": cpu("3")},
+			},
+			wantResources: map[string]corev1.ResourceList{
+				"sidecar":      cpu("3").Requests,
+				constants.Node: cpu("10").Requests,
+				"metrics":      cpu("1").Requests,
+			},
+			wantSinglePodRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("14")},
+		},
+		"resources for the node container take precedence over resourcesPerNode": {
+			trainer: &trainer.Trainer{
+				ResourcesPerNode:      ptr.To(cpu("20")),
+				ResourcesPerContainer: map[string]corev1.ResourceRequirements{constants.Node: cpu("30")},
+			},
+			wantResources: map[string]corev1.ResourceList{
+				"sidecar":      cpu("5").Requests,
+				constants.Node: cpu("30").Requests,
+				"metrics":      cpu("1").Requests,
+			},
+			wantSinglePodRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("36")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			trainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(tc.trainer).
+				Obj()
+			info, err := (&TrainingRuntime{}).newRuntimeInfo(trainJob, *jobSetTemplateSpec.DeepCopy(), &trainer.MLPolicy{}, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			jobSetSpec, ok := info.TemplateSpec.ObjApply.(*jobsetv1alpha2ac.JobSetSpecApplyConfiguration)
+			if !ok {
+				t.Fatal("JobSet spec is not found in the runtime info")
+			}
+			gotResources := make(map[string]corev1.ResourceList)
+			podSpec := jobSetSpec.ReplicatedJobs[0].Template.Spec.Template.Spec
+			for _, c := range slices.Concat(podSpec.InitContainers, podSpec.Containers) {
+				gotResources[*c.Name] = *c.Resources.Requests
+			}
+			if diff := cmp.Diff(tc.wantResources, gotResources); len(diff) != 0 {
+				t.Errorf("Unexpected container resources (-want, +got): %s", diff)
+			}
+			ps := info.FindPodSetByName(constants.Node)
+			if ps == nil {
+				t.Fatalf("PodSet %s not found", constants.Node)
+			}
+			if diff := cmp.Diff(tc.wantSinglePodRequests, ps.SinglePodRequests); len(diff) != 0 {
+				t.Errorf("Unexpected SinglePodRequests (-want, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,8 @@ package core
 import (
 	"context"
 	"errors"
+	"slices"
+	"time"
 
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -27,6 +29,7 @@ import (
 
 	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/metrics"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
@@ -57,6 +60,9 @@ func New(ctx context.Context, c client.Client, r fwkplugins.Registry, indexer cl
 	}
 
 	for name, factory := range r {
+		if !isPluginEnabled(cfg, name) {
+			continue
+		}
 		plugin, err := factory(ctx, c, indexer, cfg)
 		if err != nil {
 			return nil, err
@@ -91,9 +97,23 @@ func New(ctx context.Context, c client.Client, r fwkplugins.Registry, indexer cl
 	return f, nil
 }
 
+// isPluginEnabled returns whether the plugin is enabled by the plugins configuration.
+func isPluginEnabled(cfg *configapi.Configuration, name string) bool {
+	if cfg == nil || cfg.Plugins == nil {
+		return true
+	}
+	if slices.Contains(cfg.Plugins.Disabled, name) {
+		return false
+	}
+	return len(cfg.Plugins.Enabled) == 0 || slices.Contains(cfg.Plugins.Enabled, name)
+}
+
 func (f *Framework) RunEnforceMLPolicyPlugins(info *runtime.Info, trainJob *trainer.TrainJob) error {
 	for _, plugin := range f.enforceMLPlugins {
-		if err := plugin.EnforceMLPolicy(info, trainJob); err != nil {
+		start := time.Now()
+		err := plugin.EnforceMLPolicy(info, trainJob)
+		metrics.ObservePluginExecutionDuration(plugin.Name(), metrics.ExtensionPointEnforceMLPolicy, start)
+		if err != nil {
 			return err
 		}
 	}
@@ -141,7 +161,9 @@ func (f *Framework) RunComponentBuilderPlugins(ctx context.Context, info *runtim
 	}
 	var objs []apiruntime.ApplyConfiguration
 	for _, plugin := range f.componentBuilderPlugins {
+		start := time.Now()
 		components, err := plugin.Build(ctx, info, trainJob)
+		metrics.ObservePluginExecutionDuration(plugin.Name(), metrics.ExtensionPointBuild, start)
 		if err != nil {
 			return nil, err
 		}
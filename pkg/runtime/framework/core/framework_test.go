@@ -24,6 +24,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -46,16 +48,23 @@ import (
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/apply"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/metrics"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/coscheduling"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/deepspeed"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/flux"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/gpuenv"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/horovod"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jax"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset"
 	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/mpi"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/pipinstall"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/plainml"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/ray"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/tensorflow"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/torch"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/volcano"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/xgboost"
@@ -89,6 +98,12 @@ func TestNew(t *testing.T) {
 					jobset.Name:       &jobset.JobSet{},
 					jax.Name:          &jax.Jax{},
 					xgboost.Name:      &xgboost.XGBoost{},
+					gpuenv.Name:       &gpuenv.GPUEnv{},
+					pipinstall.Name:   &pipinstall.PipInstall{},
+					deepspeed.Name:    &deepspeed.DeepSpeed{},
+					tensorflow.Name:   &tensorflow.TensorFlow{},
+					ray.Name:          &ray.Ray{},
+					horovod.Name:      &horovod.Horovod{},
 				},
 				enforceMLPlugins: []framework.EnforceMLPolicyPlugin{
 					&flux.Flux{},
@@ -97,6 +112,12 @@ func TestNew(t *testing.T) {
 					&torch.Torch{},
 					&jax.Jax{},
 					&xgboost.XGBoost{},
+					&gpuenv.GPUEnv{},
+					&pipinstall.PipInstall{},
+					&deepspeed.DeepSpeed{},
+					&tensorflow.TensorFlow{},
+					&ray.Ray{},
+					&horovod.Horovod{},
 				},
 				enforcePodGroupPolicyPlugins: []framework.EnforcePodGroupPolicyPlugin{
 					&coscheduling.CoScheduling{},
@@ -110,6 +131,11 @@ func TestNew(t *testing.T) {
 					&volcano.Volcano{},
 					&jax.Jax{},
 					&xgboost.XGBoost{},
+					&pipinstall.PipInstall{},
+					&deepspeed.DeepSpeed{},
+					&tensorflow.TensorFlow{},
+					&ray.Ray{},
+					&horovod.Horovod{},
 				},
 				watchExtensionPlugins: []framework.WatchExtensionPlugin{
 					&flux.Flux{},
@@ -117,6 +143,7 @@ func TestNew(t *testing.T) {
 					&volcano.Volcano{},
 					&jobset.JobSet{},
 					&mpi.MPI{},
+					&deepspeed.DeepSpeed{},
 				},
 				podNetworkPlugins: []framework.PodNetworkPlugin{
 					&jobset.JobSet{},
@@ -127,6 +154,8 @@ func TestNew(t *testing.T) {
 					&volcano.Volcano{},
 					&jobset.JobSet{},
 					&mpi.MPI{},
+					&deepspeed.DeepSpeed{},
+					&ray.Ray{},
 				},
 				trainJobStatusPlugin: &jobset.JobSet{},
 			},
@@ -148,7 +177,7 @@ func TestNew(t *testing.T) {
 	}
 	cmpOpts := []cmp.Option{
 		cmp.AllowUnexported(Framework{}),
-		cmpopts.IgnoreUnexported(coscheduling.CoScheduling{}, flux.Flux{}, volcano.Volcano{}, mpi.MPI{}, plainml.PlainML{}, torch.Torch{}, jobset.JobSet{}, xgboost.XGBoost{}),
+		cmpopts.IgnoreUnexported(coscheduling.CoScheduling{}, flux.Flux{}, volcano.Volcano{}, mpi.MPI{}, plainml.PlainML{}, torch.Torch{}, jobset.JobSet{}, xgboost.XGBoost{}, gpuenv.GPUEnv{}, pipinstall.PipInstall{}, deepspeed.DeepSpeed{}),
 		cmpopts.IgnoreFields(flux.Flux{}, "client", "scheme"),
 		cmpopts.IgnoreFields(coscheduling.CoScheduling{}, "client"),
 		cmpopts.IgnoreFields(volcano.Volcano{}, "client"),
@@ -191,6 +220,7 @@ func TestNew(t *testing.T) {
 func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 	cases := map[string]struct {
 		registry        fwkplugins.Registry
+		cfg             *configapi.Configuration
 		runtimeInfo     *runtime.Info
 		trainJob        *trainer.TrainJob
 		wantRuntimeInfo *runtime.Info
@@ -322,6 +352,81 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
+		"disabled plainml plugin doesn't apply the MLPolicy to runtime.Info": {
+			registry: fwkplugins.NewRegistry(),
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Disabled: []string{plainml.Name},
+				},
+			},
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(testingutil.MakeMLPolicyWrapper().Obj()),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 10, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						NumNodes: ptr.To[int32](30),
+						Env:      []corev1.EnvVar{{Name: "TEST_ENV", Value: "value"}},
+					},
+				},
+			},
+			wantRuntimeInfo: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: testingutil.MakeMLPolicySourceWrapper().Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:     constants.Node,
+						Ancestor: ptr.To(constants.AncestorTrainer),
+						Count:    ptr.To[int32](10),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"plainml plugin not in the enabled plugins doesn't apply the MLPolicy to runtime.Info": {
+			registry: fwkplugins.NewRegistry(),
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Enabled: []string{torch.Name},
+				},
+			},
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(testingutil.MakeMLPolicyWrapper().Obj()),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 10, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						NumNodes: ptr.To[int32](30),
+					},
+				},
+			},
+			wantRuntimeInfo: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: testingutil.MakeMLPolicySourceWrapper().Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:     constants.Node,
+						Ancestor: ptr.To(constants.AncestorTrainer),
+						Count:    ptr.To[int32](10),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
 		"registry is empty": {
 			runtimeInfo: &runtime.Info{
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
@@ -337,7 +442,7 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 			t.Cleanup(cancel)
 			clientBuilder := testingutil.NewClientBuilder()
 
-			fwk, err := New(ctx, clientBuilder.Build(), tc.registry, testingutil.AsIndex(clientBuilder), nil)
+			fwk, err := New(ctx, clientBuilder.Build(), tc.registry, testingutil.AsIndex(clientBuilder), tc.cfg)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -849,6 +954,10 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 							jobsetv1alpha2ac.ReplicatedJob().
 								WithName(constants.Launcher).
 								WithReplicas(1).
+								WithDependsOn(
+									jobsetv1alpha2ac.DependsOn().WithName(constants.DatasetInitializer).WithStatus(jobsetv1alpha2.DependencyComplete),
+									jobsetv1alpha2ac.DependsOn().WithName(constants.ModelInitializer).WithStatus(jobsetv1alpha2.DependencyComplete),
+								).
 								WithGroupName("default").
 								WithTemplate(batchv1ac.JobTemplateSpec().
 									WithLabels(map[string]string{
@@ -895,6 +1004,11 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 														WithEnv(corev1ac.EnvVar().
 															WithName(constants.OpenMPIEnvKeyRSHArgs).
 															WithValue(constants.OpenMPIEnvDefaultValueRSHArgs),
+														).
+														WithEnv(
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobName).WithValue("test-job"),
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobNamespace).WithValue(metav1.NamespaceDefault),
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobUID).WithValue("uid"),
 														),
 												).
 												WithVolumes(
@@ -1185,6 +1299,7 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
 					NumNodes(99).
 					LauncherReplica().
+					InitializerDependsOn(constants.Launcher).
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher, constants.Node).
 					VolumeMounts(constants.Launcher, constants.Node,
 						corev1.VolumeMount{
@@ -1309,6 +1424,7 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 							Value: constants.OpenMPIEnvDefaultValueRSHArgs,
 						}}...,
 					).
+					TrainJobIdentityEnv(constants.Launcher, "uid").
 					Parallelism(1, constants.Launcher, constants.ModelInitializer, constants.DatasetInitializer).
 					Completions(1, constants.Launcher, constants.ModelInitializer, constants.DatasetInitializer).
 					ReplicatedJobLabel(constants.LabelTrainJobAncestor, "trainer", constants.Launcher).
@@ -1624,6 +1740,10 @@ test-job-node-0-1.test-job slots=1
 								WithName(constants.Node).
 								WithGroupName("default").
 								WithReplicas(1).
+								WithDependsOn(
+									jobsetv1alpha2ac.DependsOn().WithName(constants.DatasetInitializer).WithStatus(jobsetv1alpha2.DependencyComplete),
+									jobsetv1alpha2ac.DependsOn().WithName(constants.ModelInitializer).WithStatus(jobsetv1alpha2.DependencyComplete),
+								).
 								WithTemplate(batchv1ac.JobTemplateSpec().
 									WithLabels(map[string]string{
 										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
@@ -1643,6 +1763,11 @@ test-job-node-0-1.test-job slots=1
 														WithCommand("trainjob").
 														WithArgs("trainjob").
 														WithResources(nodeContainerRequests("2", "8Gi")).
+														WithEnv(
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobName).WithValue("test-job"),
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobNamespace).WithValue(metav1.NamespaceDefault),
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobUID).WithValue("uid"),
+														).
 														WithVolumeMounts(
 															corev1ac.VolumeMount().
 																WithName(jobsetplgconsts.VolumeNameInitializer).
@@ -1749,6 +1874,7 @@ test-job-node-0-1.test-job slots=1
 					ControllerReference(trainer.SchemeGroupVersion.WithKind("TrainJob"), "test-job", "uid").
 					Obj(),
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind("TrainJob"), "test-job", "uid").
 					PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, "test-job").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
@@ -1759,6 +1885,7 @@ test-job-node-0-1.test-job slots=1
 						corev1.ResourceCPU:    resource.MustParse("2"),
 						corev1.ResourceMemory: resource.MustParse("8Gi"),
 					}).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -2064,6 +2191,9 @@ test-job-node-0-1.test-job slots=1
 									WithLabels(map[string]string{
 										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
 									}).
+									WithAnnotations(map[string]string{
+										volcanov1beta1.QueueNameAnnotationKey: "q1",
+									}).
 									WithSpec(batchv1ac.JobSpec().
 										WithParallelism(1).
 										WithCompletions(1).
@@ -2100,6 +2230,9 @@ test-job-node-0-1.test-job slots=1
 									WithLabels(map[string]string{
 										constants.LabelTrainJobAncestor: constants.ModelInitializer,
 									}).
+									WithAnnotations(map[string]string{
+										volcanov1beta1.QueueNameAnnotationKey: "q1",
+									}).
 									WithSpec(batchv1ac.JobSpec().
 										WithParallelism(1).
 										WithCompletions(1).
@@ -2132,10 +2265,17 @@ test-job-node-0-1.test-job slots=1
 								WithName(constants.Node).
 								WithGroupName("default").
 								WithReplicas(1).
+								WithDependsOn(
+									jobsetv1alpha2ac.DependsOn().WithName(constants.DatasetInitializer).WithStatus(jobsetv1alpha2.DependencyComplete),
+									jobsetv1alpha2ac.DependsOn().WithName(constants.ModelInitializer).WithStatus(jobsetv1alpha2.DependencyComplete),
+								).
 								WithTemplate(batchv1ac.JobTemplateSpec().
 									WithLabels(map[string]string{
 										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
 									}).
+									WithAnnotations(map[string]string{
+										volcanov1beta1.QueueNameAnnotationKey: "q1",
+									}).
 									WithSpec(batchv1ac.JobSpec().
 										WithParallelism(100).
 										WithCompletions(100).
@@ -2153,6 +2293,11 @@ test-job-node-0-1.test-job slots=1
 														WithCommand("trainjob").
 														WithArgs("trainjob").
 														WithResources(nodeContainerRequests("1", "4Gi")).
+														WithEnv(
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobName).WithValue("test-volcano-job"),
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobNamespace).WithValue(metav1.NamespaceDefault),
+															corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobUID).WithValue("uid"),
+														).
 														WithVolumeMounts(
 															corev1ac.VolumeMount().
 																WithName(jobsetplgconsts.VolumeNameInitializer).
@@ -2192,8 +2337,10 @@ test-job-node-0-1.test-job slots=1
 					ControllerReference(trainer.SchemeGroupVersion.WithKind("TrainJob"), "test-volcano-job", "uid").
 					Obj(),
 				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-volcano-job").
+					InitializerDependsOn(constants.Node).
 					ControllerReference(trainer.SchemeGroupVersion.WithKind("TrainJob"), "test-volcano-job", "uid").
 					Annotation(volcanov1beta1.QueueNameAnnotationKey, "q1").
+					ReplicatedJobAnnotation(volcanov1beta1.QueueNameAnnotationKey, "q1", constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 					PodAnnotation(volcanov1beta1.KubeGroupNameAnnotationKey, "test-volcano-job").
 					PodPriorityClassName("system-node-critical").
 					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
@@ -2204,6 +2351,7 @@ test-job-node-0-1.test-job slots=1
 						corev1.ResourceCPU:    resource.MustParse("1"),
 						corev1.ResourceMemory: resource.MustParse("4Gi"),
 					}).
+					TrainJobIdentityEnv(constants.Node, "uid").
 					Obj(),
 			},
 		},
@@ -2282,12 +2430,57 @@ test-job-node-0-1.test-job slots=1
 	}
 }
 
+func TestPluginExecutionDurationMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	clientBuilder := testingutil.NewClientBuilder()
+
+	fwk, err := New(ctx, clientBuilder.Build(), fwkplugins.NewRegistry(), testingutil.AsIndex(clientBuilder), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics.PluginExecutionDuration.Reset()
+	t.Cleanup(metrics.PluginExecutionDuration.Reset)
+
+	info := runtime.NewInfo(
+		runtime.WithMLPolicySource(testingutil.MakeMLPolicyWrapper().Obj()),
+		runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+			WithContainers(corev1ac.Container().WithName(constants.Node)),
+		),
+	)
+	trainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").Obj()
+	if err = fwk.RunEnforceMLPolicyPlugins(info, trainJob); err != nil {
+		t.Fatalf("Unexpected error from RunEnforceMLPolicyPlugins: %v", err)
+	}
+	if _, err = fwk.RunComponentBuilderPlugins(ctx, info, trainJob); err != nil {
+		t.Fatalf("Unexpected error from RunComponentBuilderPlugins: %v", err)
+	}
+
+	sampleCount := func(plugin, extensionPoint string) uint64 {
+		m := &dto.Metric{}
+		if err := metrics.PluginExecutionDuration.WithLabelValues(plugin, extensionPoint).(prometheus.Metric).Write(m); err != nil {
+			t.Fatalf("Failed to read the plugin execution duration metric: %v", err)
+		}
+		return m.GetHistogram().GetSampleCount()
+	}
+	for _, p := range fwk.enforceMLPlugins {
+		if got := sampleCount(p.Name(), metrics.ExtensionPointEnforceMLPolicy); got != 1 {
+			t.Errorf("Unexpected number of %s observations for plugin %s, want 1, got %d", metrics.ExtensionPointEnforceMLPolicy, p.Name(), got)
+		}
+	}
+	for _, p := range fwk.componentBuilderPlugins {
+		if got := sampleCount(p.Name(), metrics.ExtensionPointBuild); got != 1 {
+			t.Errorf("Unexpected number of %s observations for plugin %s, want 1, got %d", metrics.ExtensionPointBuild, p.Name(), got)
+		}
+	}
+}
+
 func TestWatchExtensionPlugins(t *testing.T) {
 	cases := map[string]struct {
 		registry    fwkplugins.Registry
 		wantPlugins []framework.WatchExtensionPlugin
 	}{
-		"coscheduling, jobset, and mpi are performed": {
+		"coscheduling, jobset, mpi, and deepspeed are performed": {
 			registry: fwkplugins.NewRegistry(),
 			wantPlugins: []framework.WatchExtensionPlugin{
 				&flux.Flux{},
@@ -2295,6 +2488,7 @@ func TestWatchExtensionPlugins(t *testing.T) {
 				&volcano.Volcano{},
 				&jobset.JobSet{},
 				&mpi.MPI{},
+				&deepspeed.DeepSpeed{},
 			},
 		},
 		"an empty registry": {
@@ -2303,7 +2497,7 @@ func TestWatchExtensionPlugins(t *testing.T) {
 	}
 	cmpOpts := []cmp.Option{
 		cmpopts.SortSlices(func(a, b framework.Plugin) bool { return a.Name() < b.Name() }),
-		cmpopts.IgnoreUnexported(coscheduling.CoScheduling{}, volcano.Volcano{}, jobset.JobSet{}, mpi.MPI{}, flux.Flux{}),
+		cmpopts.IgnoreUnexported(coscheduling.CoScheduling{}, volcano.Volcano{}, jobset.JobSet{}, mpi.MPI{}, flux.Flux{}, deepspeed.DeepSpeed{}),
 		cmpopts.IgnoreFields(flux.Flux{}, "client", "scheme"),
 	}
 	for name, tc := range cases {
@@ -2365,13 +2559,20 @@ func TestTrainJobStatusPlugins(t *testing.T) {
 				Obj(),
 			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
 				Conditions(metav1.Condition{
-					Type:    string(jobsetv1alpha2.JobSetSuspended),
-					Reason:  jobsetconsts.JobSetSuspendedReason,
-					Message: jobsetconsts.JobSetSuspendedMessage,
-					Status:  metav1.ConditionFalse,
+					Type:               string(jobsetv1alpha2.JobSetSuspended),
+					LastTransitionTime: lastTransitionTime,
+					Reason:             jobsetconsts.JobSetSuspendedReason,
+					Message:            jobsetconsts.JobSetSuspendedMessage,
+					Status:             metav1.ConditionFalse,
 				}).
 				Obj(),
-			wantStatus: &trainer.TrainJobStatus{},
+			wantStatus: &trainer.TrainJobStatus{
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseRunning,
+				},
+				StartTime: &lastTransitionTime,
+			},
 		},
 		"succeeded to obtain completed terminal condition": {
 			registry: fwkplugins.NewRegistry(),
@@ -2396,6 +2597,12 @@ func TestTrainJobStatusPlugins(t *testing.T) {
 						Status:             metav1.ConditionTrue,
 					},
 				},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseCompleted,
+				},
+				StartTime:      &metav1.Time{},
+				CompletionTime: &lastTransitionTime,
 			},
 		},
 		"succeeded to obtain failed terminal condition": {
@@ -2421,6 +2628,118 @@ func TestTrainJobStatusPlugins(t *testing.T) {
 						Status:             metav1.ConditionTrue,
 					},
 				},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseFailed,
+				},
+				StartTime: &metav1.Time{},
+			},
+		},
+		"startTime is reset when JobSet is suspended": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				StartTime(lastTransitionTime).
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Suspend(true).
+				Obj(),
+			wantStatus: &trainer.TrainJobStatus{
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseSuspended,
+				},
+			},
+		},
+		"succeeded to obtain Canary condition from running canary JobSet": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Annotation(constants.AnnotationCanary, "true").
+				Suspend(false).
+				Obj(),
+			wantStatus: &trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCanary,
+						Status:  metav1.ConditionFalse,
+						Reason:  trainer.TrainJobCanaryRunningReason,
+						Message: constants.TrainJobCanaryRunningMessage,
+					},
+				},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseRunning,
+				},
+				StartTime: &metav1.Time{},
+			},
+		},
+		"completed canary JobSet does not complete TrainJob": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Annotation(constants.AnnotationCanary, "true").
+				Suspend(false).
+				Conditions(metav1.Condition{
+					Type:               string(jobsetv1alpha2.JobSetCompleted),
+					LastTransitionTime: lastTransitionTime,
+					Message:            jobsetconsts.AllJobsCompletedMessage,
+					Reason:             jobsetconsts.AllJobsCompletedReason,
+					Status:             metav1.ConditionTrue,
+				}).
+				Obj(),
+			wantStatus: &trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCanary,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobCanarySucceededReason,
+						Message: constants.TrainJobCanarySucceededMessage,
+					},
+				},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseCompleted,
+				},
+				StartTime: &metav1.Time{},
+			},
+		},
+		"failed canary JobSet fails TrainJob": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Annotation(constants.AnnotationCanary, "true").
+				Suspend(false).
+				Conditions(metav1.Condition{
+					Type:               string(jobsetv1alpha2.JobSetFailed),
+					LastTransitionTime: lastTransitionTime,
+					Message:            jobsetconsts.FailedJobsMessage,
+					Reason:             jobsetconsts.FailedJobsReason,
+					Status:             metav1.ConditionTrue,
+				}).
+				Obj(),
+			wantStatus: &trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCanary,
+						Status:  metav1.ConditionFalse,
+						Reason:  trainer.TrainJobCanaryFailedReason,
+						Message: constants.TrainJobCanaryFailedMessage,
+					},
+					{
+						Type:    trainer.TrainJobFailed,
+						Status:  metav1.ConditionTrue,
+						Reason:  jobsetconsts.FailedJobsReason,
+						Message: jobsetconsts.FailedJobsMessage,
+					},
+				},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseFailed,
+				},
+				StartTime: &metav1.Time{},
 			},
 		},
 		"failed to obtain TrainJob status due to multiple trainJobStatus plugin": {
@@ -2436,7 +2755,13 @@ func TestTrainJobStatusPlugins(t *testing.T) {
 				Obj(),
 			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
 				Obj(),
-			wantStatus: &trainer.TrainJobStatus{},
+			wantStatus: &trainer.TrainJobStatus{
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseRunning,
+				},
+				StartTime: &metav1.Time{},
+			},
 		},
 		"succeeded to obtain JobsStatus from JobSet with multiple replicated jobs": {
 			registry: fwkplugins.NewRegistry(),
@@ -2497,6 +2822,11 @@ func TestTrainJobStatusPlugins(t *testing.T) {
 						Suspended: ptr.To(int32(0)),
 					},
 				},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseRunning,
+				},
+				StartTime: &metav1.Time{},
 			},
 		},
 		"succeeded to obtain JobsStatus from JobSet with failed job": {
@@ -2526,6 +2856,55 @@ func TestTrainJobStatusPlugins(t *testing.T) {
 						Suspended: ptr.To(int32(0)),
 					},
 				},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseRunning,
+				},
+				StartTime: &metav1.Time{},
+			},
+		},
+		"succeeded to obtain the effective command of the trainer node container": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Container(constants.Node, constants.Node, "test:trainjob", []string{"torchrun"}, []string{"train.py", "--epochs=3"}, nil).
+				Obj(),
+			wantStatus: &trainer.TrainJobStatus{
+				EffectiveCommand: []string{"torchrun", "train.py", "--epochs=3"},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseRunning,
+				},
+				StartTime: &metav1.Time{},
+			},
+		},
+		"succeeded to obtain the total resources requested by all the Pods": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+				NumNodes(2).
+				Container(constants.Node, constants.Node, "test:trainjob", []string{"torchrun"}, nil, corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				}).
+				Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", nil, nil, corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+				}).
+				Obj(),
+			wantStatus: &trainer.TrainJobStatus{
+				EffectiveCommand: []string{"torchrun"},
+				TotalResources: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("5"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				JobSetStatus: &trainer.JobSetStatus{
+					CreationTimestamp: &metav1.Time{},
+					Phase:             trainer.JobSetPhaseRunning,
+				},
+				StartTime: &metav1.Time{},
 			},
 		},
 		"failed to obtain JobsStatus due to multiple JobsStatusPlugins": {
@@ -2559,7 +2938,7 @@ func TestTrainJobStatusPlugins(t *testing.T) {
 				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
 			}
 
-			if diff := cmp.Diff(tc.wantStatus, gotStatus); len(diff) != 0 {
+			if diff := cmp.Diff(tc.wantStatus, gotStatus, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); len(diff) != 0 {
 				t.Errorf("Unexpected TrainJob status (-want,+got):\n%s", diff)
 			}
 		})
@@ -25,6 +25,7 @@ import (
 	nodev1 "k8s.io/api/node/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -44,9 +45,11 @@ import (
 
 	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
+	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
 )
 
 type CoScheduling struct {
@@ -87,6 +90,9 @@ func (c *CoScheduling) EnforcePodGroupPolicy(info *runtime.Info, trainJob *train
 		info.Scheduler.PodLabels = make(map[string]string, 1)
 	}
 	info.Scheduler.PodLabels[schedulerpluginsv1alpha1.PodGroupLabel] = trainJob.Name
+	if queue := info.RuntimePolicy.PodGroupPolicy.Coscheduling.Queue; len(queue) != 0 {
+		info.Scheduler.PodLabels[constants.LabelPodGroupQueue] = queue
+	}
 	return nil
 }
 
@@ -97,7 +103,7 @@ func (c *CoScheduling) Build(ctx context.Context, info *runtime.Info, trainJob *
 		return nil, nil
 	}
 
-	// Do not update the PodGroup if it already exists and the TrainJob is not suspended
+	// Do not update the PodGroup if it already exists and the TrainJob is running.
 	oldPodGroup := &schedulerpluginsv1alpha1.PodGroup{}
 	if err := c.client.Get(ctx, client.ObjectKeyFromObject(trainJob), oldPodGroup); err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -105,24 +111,42 @@ func (c *CoScheduling) Build(ctx context.Context, info *runtime.Info, trainJob *
 		}
 		oldPodGroup = nil
 	}
-	if oldPodGroup != nil && !ptr.Deref(trainJob.Spec.Suspend, false) {
+	suspended := ptr.Deref(trainJob.Spec.Suspend, false)
+	scaledDown := oldPodGroup != nil && oldPodGroup.Spec.MinMember == 0
+	// The PodGroup of the single-node canary is recomputed once the trainer is scaled to numNodes.
+	canaryReplaced := oldPodGroup != nil && metav1.HasAnnotation(oldPodGroup.ObjectMeta, constants.AnnotationCanary) &&
+		!trainjob.IsCanaryPending(trainJob)
+	if oldPodGroup != nil && !suspended && !scaledDown && !canaryReplaced {
 		return nil, nil
 	}
 
+	// Scale the PodGroup down to zero when the started TrainJob is suspended to release the gang reservation.
+	// The PodGroup is restored once the TrainJob is resumed.
+	scaleDown := suspended && oldPodGroup != nil &&
+		(scaledDown || !meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobSuspended))
+
 	var totalMembers int32
 	totalResources := make(corev1.ResourceList)
-	for _, ps := range info.TemplateSpec.PodSets {
-		count := *ps.Count
-		totalMembers += count
-		for resName, quantity := range ps.SinglePodRequests {
-			quantity.Mul(int64(count))
-			current := totalResources[resName]
-			current.Add(quantity)
-			totalResources[resName] = current
+	if !scaleDown {
+		for _, ps := range info.TemplateSpec.PodSets {
+			count := *ps.Count
+			totalMembers += count
+			for resName, quantity := range ps.SinglePodRequests {
+				quantity.Mul(int64(count))
+				current := totalResources[resName]
+				current.Add(quantity)
+				totalResources[resName] = current
+			}
 		}
 	}
 
 	podGroup := schedulerpluginsv1alpha1ac.PodGroup(trainJob.Name, trainJob.Namespace)
+	if queue := info.RuntimePolicy.PodGroupPolicy.Coscheduling.Queue; len(queue) != 0 {
+		podGroup.WithLabels(map[string]string{constants.LabelPodGroupQueue: queue})
+	}
+	if trainjob.IsCanaryPending(trainJob) {
+		podGroup.WithAnnotations(map[string]string{constants.AnnotationCanary: "true"})
+	}
 
 	podGroup.WithSpec(schedulerpluginsv1alpha1ac.PodGroupSpec().
 		WithMinMember(totalMembers).
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deepspeed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/mpi"
+)
+
+type DeepSpeed struct {
+	client    client.Client
+	secretTTL *metav1.Duration
+}
+
+var _ framework.CustomValidationPlugin = (*DeepSpeed)(nil)
+var _ framework.EnforceMLPolicyPlugin = (*DeepSpeed)(nil)
+var _ framework.WatchExtensionPlugin = (*DeepSpeed)(nil)
+var _ framework.ComponentBuilderPlugin = (*DeepSpeed)(nil)
+
+const Name = "DeepSpeed"
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;get;list;watch;update;patch
+
+func New(_ context.Context, client client.Client, _ client.FieldIndexer, cfg *configapi.Configuration) (framework.Plugin, error) {
+	d := &DeepSpeed{
+		client: client,
+	}
+	if cfg != nil && cfg.GeneratedSecrets != nil {
+		d.secretTTL = cfg.GeneratedSecrets.TTL
+	}
+	return d, nil
+}
+
+func (d *DeepSpeed) Name() string {
+	return Name
+}
+
+func (d *DeepSpeed) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newJobObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil || runtimeInfo.RuntimePolicy.MLPolicySource.DeepSpeed == nil {
+		return nil, allErrs
+	}
+	// Check reserved DeepSpeed envs.
+	if trainJobTrainer := newJobObj.Spec.Trainer; trainJobTrainer != nil {
+		deepSpeedEnvs := sets.New[string]()
+		for _, env := range trainJobTrainer.Env {
+			if constants.DeepSpeedReservedEnvNames.Has(env.Name) {
+				deepSpeedEnvs.Insert(env.Name)
+			}
+		}
+		if deepSpeedEnvs.Len() > 0 {
+			trainerEnvsPath := field.NewPath("spec", "trainer", "env")
+			allErrs = append(allErrs, field.Invalid(trainerEnvsPath, trainJobTrainer.Env, fmt.Sprintf("must not have reserved envs, invalid envs configured: %v", sets.List(deepSpeedEnvs))))
+		}
+	}
+	return nil, allErrs
+}
+
+func (d *DeepSpeed) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil || info.RuntimePolicy.MLPolicySource.DeepSpeed == nil {
+		return nil
+	}
+	deepSpeedPolicy := info.RuntimePolicy.MLPolicySource.DeepSpeed
+
+	// TrainJob contains the actual information for the Trainer.
+	node := info.FindPodSetByName(constants.Node)
+	if trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumNodes != nil && node != nil && node.Count != nil {
+		*node.Count = *trainJob.Spec.Trainer.NumNodes
+	}
+
+	if trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumProcPerNode != nil {
+		deepSpeedPolicy.NumProcPerNode = trainJob.Spec.Trainer.NumProcPerNode
+		// If numProcPerNode is set to 1 in runtime, we make it equal to number of GPUs.
+	} else if ptr.Deref(deepSpeedPolicy.NumProcPerNode, 1) == 1 {
+		resourcesPerNode := ptr.Deref(runtime.ExtractResourcePerNodeFromRuntime(info), corev1.ResourceRequirements{})
+		if jobTrainer := trainJob.Spec.Trainer; jobTrainer != nil && jobTrainer.ResourcesPerNode != nil {
+			resourcesPerNode = ptr.Deref(jobTrainer.ResourcesPerNode, corev1.ResourceRequirements{})
+		}
+		if gpuQ := runtime.GetNumGPUPerNode(&resourcesPerNode); gpuQ > 1 {
+			deepSpeedPolicy.NumProcPerNode = ptr.To(int32(gpuQ))
+		}
+	}
+
+	numNodes := int32(1)
+	if node != nil {
+		numNodes = ptr.Deref(node.Count, 1)
+	}
+
+	// Add Secret and ConfigMap volumes to the Info object
+	for psIdx, ps := range info.TemplateSpec.PodSets {
+		if ps.Name != constants.Node && ps.Name != constants.Launcher {
+			continue
+		}
+		apply.UpsertVolumes(
+			&info.TemplateSpec.PodSets[psIdx].Volumes,
+			*mpi.SSHAuthVolume(constants.DeepSpeedSSHAuthVolumeName, sshAuthSecretName(trainJob.Name)),
+		)
+		if ps.Name == constants.Launcher {
+			apply.UpsertVolumes(
+				&info.TemplateSpec.PodSets[psIdx].Volumes,
+				*corev1ac.Volume().
+					WithName(constants.DeepSpeedHostfileVolumeName).
+					WithConfigMap(corev1ac.ConfigMapVolumeSource().
+						WithName(hostfileConfigMapName(trainJob.Name)).
+						WithItems(
+							corev1ac.KeyToPath().
+								WithKey(constants.DeepSpeedHostfileName).
+								WithPath(constants.DeepSpeedHostfileName).
+								WithMode(0444),
+						),
+					),
+			)
+		}
+		for cIdx, container := range ps.Containers {
+			if container.Name != constants.Node {
+				continue
+			}
+			apply.UpsertVolumeMounts(
+				&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].VolumeMounts,
+				*corev1ac.VolumeMount().
+					WithName(constants.DeepSpeedSSHAuthVolumeName).
+					WithMountPath(ptr.Deref(deepSpeedPolicy.SSHAuthMountPath, "/root/.ssh")),
+			)
+			if ps.Name != constants.Launcher {
+				continue
+			}
+			apply.UpsertVolumeMounts(
+				&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].VolumeMounts,
+				*corev1ac.VolumeMount().
+					WithName(constants.DeepSpeedHostfileVolumeName).
+					WithMountPath(constants.DeepSpeedHostfileDir),
+			)
+			apply.UpsertEnvVars(
+				&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Env,
+				*corev1ac.EnvVar().
+					WithName(constants.DeepSpeedEnvHostfile).
+					WithValue(fmt.Sprintf("%s/%s", constants.DeepSpeedHostfileDir, constants.DeepSpeedHostfileName)),
+				*corev1ac.EnvVar().
+					WithName(constants.DeepSpeedEnvMasterAddr).
+					WithValue(fmt.Sprintf("%s-%s-0-0.%s", trainJob.Name, constants.Node, trainJob.Name)),
+				*corev1ac.EnvVar().
+					WithName(constants.DeepSpeedEnvMasterPort).
+					WithValue(strconv.Itoa(int(info.TrainerPort()))),
+				*corev1ac.EnvVar().
+					WithName(constants.DeepSpeedEnvNumNodes).
+					WithValue(strconv.Itoa(int(numNodes))),
+				*corev1ac.EnvVar().
+					WithName(constants.DeepSpeedEnvNumProcPerNode).
+					WithValue(strconv.Itoa(int(ptr.Deref(deepSpeedPolicy.NumProcPerNode, 1)))),
+			)
+		}
+	}
+	return nil
+}
+
+func (d *DeepSpeed) ReconcilerBuilders() []runtime.ReconcilerBuilder {
+	return []runtime.ReconcilerBuilder{
+		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
+			return b.Watches(
+				&corev1.ConfigMap{},
+				handler.EnqueueRequestForOwner(
+					d.client.Scheme(), d.client.RESTMapper(), &trainer.TrainJob{}, handler.OnlyControllerOwner(),
+				),
+			)
+		},
+		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
+			return b.Watches(
+				&corev1.Secret{},
+				handler.EnqueueRequestForOwner(
+					d.client.Scheme(), d.client.RESTMapper(), &trainer.TrainJob{}, handler.OnlyControllerOwner(),
+				),
+			)
+		},
+	}
+}
+
+func (d *DeepSpeed) SyncParallelCount(_ *runtime.Info) error { return nil }
+
+func (d *DeepSpeed) Build(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil || info.RuntimePolicy.MLPolicySource.DeepSpeed == nil {
+		return nil, nil
+	}
+
+	var objects []apiruntime.ApplyConfiguration
+
+	// DeepSpeed launches the training processes over SSH with the same keys as OpenMPI.
+	secret, err := mpi.BuildSSHAuthSecret(ctx, d.client, sshAuthSecretName(trainJob.Name), trainJob, d.secretTTL)
+	if err != nil {
+		return nil, err
+	}
+	if secret != nil {
+		objects = append(objects, secret)
+	}
+	return append(objects, buildHostfileConfigMap(info, trainJob)), nil
+}
+
+// buildHostfileConfigMap builds the DeepSpeed hostfile with one line per trainer node.
+func buildHostfileConfigMap(info *runtime.Info, trainJob *trainer.TrainJob) *corev1ac.ConfigMapApplyConfiguration {
+	var hostfile bytes.Buffer
+	slots := ptr.Deref(info.RuntimePolicy.MLPolicySource.DeepSpeed.NumProcPerNode, 1)
+	if node := info.FindPodSetByName(constants.Node); node != nil && node.Endpoints != nil {
+		for e := range node.Endpoints {
+			fmt.Fprintf(&hostfile, "%s slots=%d\n", e, slots)
+		}
+	}
+	return corev1ac.ConfigMap(hostfileConfigMapName(trainJob.Name), trainJob.Namespace).
+		WithData(map[string]string{
+			constants.DeepSpeedHostfileName: hostfile.String(),
+		}).
+		WithOwnerReferences(ownerReference(trainJob))
+}
+
+func ownerReference(trainJob *trainer.TrainJob) *metav1ac.OwnerReferenceApplyConfiguration {
+	return metav1ac.OwnerReference().
+		WithAPIVersion(trainer.GroupVersion.String()).
+		WithKind(trainer.TrainJobKind).
+		WithName(trainJob.Name).
+		WithUID(trainJob.UID).
+		WithController(true).
+		WithBlockOwnerDeletion(true)
+}
+
+func sshAuthSecretName(trainJobName string) string {
+	return fmt.Sprintf("%s%s", trainJobName, constants.DeepSpeedSSHAuthSecretSuffix)
+}
+
+func hostfileConfigMapName(trainJobName string) string {
+	return fmt.Sprintf("%s%s", trainJobName, constants.DeepSpeedHostfileConfigMapSuffix)
+}
@@ -0,0 +1,504 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deepspeed
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestDeepSpeed(t *testing.T) {
+	objCmpOpts := []gocmp.Option{
+		cmpopts.SortSlices(func(a, b apiruntime.Object) int {
+			return cmp.Compare(a.GetObjectKind().GroupVersionKind().String(), b.GetObjectKind().GroupVersionKind().String())
+		}),
+		gocmp.Comparer(utiltesting.MPISecretDataComparer),
+	}
+	errorGetSSHAuthSecretFromAPI := errors.New("failed to get SSH Auth Secret from API during Build")
+
+	sshAuthVolume := *corev1ac.Volume().
+		WithName(constants.DeepSpeedSSHAuthVolumeName).
+		WithSecret(corev1ac.SecretVolumeSource().
+			WithSecretName(fmt.Sprintf("trainJob%s", constants.DeepSpeedSSHAuthSecretSuffix)).
+			WithDefaultMode(constants.MPISSHAuthDefaultMode).
+			WithItems(
+				corev1ac.KeyToPath().
+					WithKey(corev1.SSHAuthPrivateKey).
+					WithPath(constants.MPISSHPrivateKeyFile).
+					WithMode(constants.MPISSHPrivateKeyFileMode),
+				corev1ac.KeyToPath().
+					WithKey(constants.MPISSHPublicKey).
+					WithPath(constants.MPISSHPublicKeyFile).
+					WithMode(constants.MPISSHPublicKeyFileMode),
+				corev1ac.KeyToPath().
+					WithKey(constants.MPISSHPublicKey).
+					WithPath(constants.MPISSHAuthorizedKeys).
+					WithMode(constants.MPISSHPublicKeyFileMode),
+			),
+		)
+	hostfileVolume := *corev1ac.Volume().
+		WithName(constants.DeepSpeedHostfileVolumeName).
+		WithConfigMap(corev1ac.ConfigMapVolumeSource().
+			WithName(fmt.Sprintf("trainJob%s", constants.DeepSpeedHostfileConfigMapSuffix)).
+			WithItems(
+				corev1ac.KeyToPath().
+					WithKey(constants.DeepSpeedHostfileName).
+					WithPath(constants.DeepSpeedHostfileName).
+					WithMode(0444),
+			),
+		)
+	podSets := func() []runtime.PodSet {
+		return []runtime.PodSet{
+			{
+				Name:       constants.Launcher,
+				Count:      ptr.To[int32](1),
+				Containers: []runtime.Container{{Name: constants.Node}},
+				Endpoints: func(yield func(string) bool) {
+					yield("trainJob-launcher-0-0.trainJob")
+				},
+			},
+			{
+				Name:       constants.Node,
+				Ancestor:   ptr.To(constants.AncestorTrainer),
+				Count:      ptr.To[int32](1),
+				Containers: []runtime.Container{{Name: constants.Node}},
+				Endpoints: func(yield func(string) bool) {
+					yield("trainJob-node-0-0.trainJob")
+					yield("trainJob-node-0-1.trainJob")
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		info              *runtime.Info
+		trainJob          *trainer.TrainJob
+		objs              []client.Object
+		wantInfo          *runtime.Info
+		wantObjs          []apiruntime.Object
+		wantMLPolicyError error
+		wantBuildError    error
+	}{
+		"no action when info is nil": {},
+		"no action when mlPolicySource is nil": {
+			info: &runtime.Info{
+				Labels: map[string]string{"key": "value"},
+			},
+			wantInfo: &runtime.Info{
+				Labels: map[string]string{"key": "value"},
+			},
+		},
+		"no action when mlPolicySource deepspeed is null": {
+			info: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().Obj(),
+				},
+			},
+			wantInfo: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().Obj(),
+				},
+			},
+		},
+		"trainJob numNodes and numProcPerNode are respected in the hostfile and envs": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: podSets(),
+				},
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](1), ptr.To("/home/deepspeed/.ssh")).
+						Obj(),
+				},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				UID("trainJob").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(2).
+						NumProcPerNode(4).
+						Obj()).
+				Obj(),
+			wantInfo: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:    constants.Launcher,
+							Count:   ptr.To[int32](1),
+							Volumes: []corev1ac.VolumeApplyConfiguration{sshAuthVolume, hostfileVolume},
+							Containers: []runtime.Container{{
+								Name: constants.Node,
+								Env: []corev1ac.EnvVarApplyConfiguration{
+									*corev1ac.EnvVar().
+										WithName(constants.DeepSpeedEnvHostfile).
+										WithValue(fmt.Sprintf("%s/%s", constants.DeepSpeedHostfileDir, constants.DeepSpeedHostfileName)),
+									*corev1ac.EnvVar().
+										WithName(constants.DeepSpeedEnvMasterAddr).
+										WithValue("trainJob-node-0-0.trainJob"),
+									*corev1ac.EnvVar().
+										WithName(constants.DeepSpeedEnvMasterPort).
+										WithValue(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+									*corev1ac.EnvVar().
+										WithName(constants.DeepSpeedEnvNumNodes).
+										WithValue("2"),
+									*corev1ac.EnvVar().
+										WithName(constants.DeepSpeedEnvNumProcPerNode).
+										WithValue("4"),
+								},
+								VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+									*corev1ac.VolumeMount().
+										WithName(constants.DeepSpeedSSHAuthVolumeName).
+										WithMountPath("/home/deepspeed/.ssh"),
+									*corev1ac.VolumeMount().
+										WithName(constants.DeepSpeedHostfileVolumeName).
+										WithMountPath(constants.DeepSpeedHostfileDir),
+								},
+							}},
+							Endpoints: func(yield func(string) bool) {
+								yield("trainJob-launcher-0-0.trainJob")
+							},
+						},
+						{
+							Name:     constants.Node,
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Count:    ptr.To[int32](2),
+							Volumes:  []corev1ac.VolumeApplyConfiguration{sshAuthVolume},
+							Containers: []runtime.Container{{
+								Name: constants.Node,
+								VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+									*corev1ac.VolumeMount().
+										WithName(constants.DeepSpeedSSHAuthVolumeName).
+										WithMountPath("/home/deepspeed/.ssh"),
+								},
+							}},
+							Endpoints: func(yield func(string) bool) {
+								yield("trainJob-node-0-0.trainJob")
+								yield("trainJob-node-0-1.trainJob")
+							},
+						},
+					},
+				},
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](4), ptr.To("/home/deepspeed/.ssh")).
+						Obj(),
+				},
+			},
+			wantObjs: []apiruntime.Object{
+				utiltesting.MakeSecretWrapper(fmt.Sprintf("trainJob%s", constants.DeepSpeedSSHAuthSecretSuffix), metav1.NamespaceDefault).
+					WithImmutable(true).
+					WithType(corev1.SecretTypeSSHAuth).
+					WithData(map[string][]byte{
+						constants.MPISSHPublicKey: []byte("EXIST"),
+						corev1.SSHAuthPrivateKey:  []byte("EXIST"),
+					}).
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "trainJob", "trainJob").
+					Obj(),
+				utiltesting.MakeConfigMapWrapper(fmt.Sprintf("trainJob%s", constants.DeepSpeedHostfileConfigMapSuffix), metav1.NamespaceDefault).
+					WithData(map[string]string{
+						constants.DeepSpeedHostfileName: `trainJob-node-0-0.trainJob slots=4
+trainJob-node-0-1.trainJob slots=4
+`,
+					}).
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "trainJob", "trainJob").
+					Obj(),
+			},
+		},
+		"sshAuth secret already has existed in the cluster": {
+			objs: []client.Object{
+				utiltesting.MakeSecretWrapper(sshAuthSecretName("trainJob"), metav1.NamespaceDefault).
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "trainJob", "trainJob").
+					WithImmutable(true).
+					Obj(),
+			},
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:  constants.Node,
+						Count: ptr.To[int32](1),
+						Endpoints: func(yield func(string) bool) {
+							yield("trainJob-node-0-0.trainJob")
+						},
+					}},
+				},
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](2), ptr.To("/root/.ssh")).
+						Obj(),
+				},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				UID("trainJob").
+				Obj(),
+			wantInfo: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:    constants.Node,
+						Count:   ptr.To[int32](1),
+						Volumes: []corev1ac.VolumeApplyConfiguration{sshAuthVolume},
+						Endpoints: func(yield func(string) bool) {
+							yield("trainJob-node-0-0.trainJob")
+						},
+					}},
+				},
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](2), ptr.To("/root/.ssh")).
+						Obj(),
+				},
+			},
+			wantObjs: []apiruntime.Object{
+				utiltesting.MakeConfigMapWrapper(fmt.Sprintf("trainJob%s", constants.DeepSpeedHostfileConfigMapSuffix), metav1.NamespaceDefault).
+					WithData(map[string]string{
+						constants.DeepSpeedHostfileName: `trainJob-node-0-0.trainJob slots=2
+`,
+					}).
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "trainJob", "trainJob").
+					Obj(),
+			},
+		},
+		"failed to get sshAuth secret due to API error": {
+			info: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](1), ptr.To("/root/.ssh")).
+						Obj(),
+				},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				UID("trainJob").
+				Obj(),
+			wantInfo: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](1), ptr.To("/root/.ssh")).
+						Obj(),
+				},
+			},
+			wantBuildError: errorGetSSHAuthSecretFromAPI,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			b := utiltesting.NewClientBuilder().WithObjects(tc.objs...)
+			b.WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, client client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if _, ok := obj.(*corev1.Secret); ok && errors.Is(tc.wantBuildError, errorGetSSHAuthSecretFromAPI) {
+						return errorGetSSHAuthSecretFromAPI
+					}
+					return client.Get(ctx, key, obj, opts...)
+				},
+			})
+			cli := b.Build()
+
+			p, err := New(ctx, cli, nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize DeepSpeed plugin: %v", err)
+			}
+			err = p.(framework.EnforceMLPolicyPlugin).EnforceMLPolicy(tc.info, tc.trainJob)
+			if diff := gocmp.Diff(tc.wantMLPolicyError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error from EnforceMLPolicy (-want, +got): %s", diff)
+			}
+			if diff := gocmp.Diff(tc.wantInfo, tc.info, utiltesting.PodSetEndpointsCmpOpts); len(diff) != 0 {
+				t.Errorf("Unexpected info from EnforceMLPolicy (-want, +got): %s", diff)
+			}
+			var objs []apiruntime.ApplyConfiguration
+			objs, err = p.(framework.ComponentBuilderPlugin).Build(ctx, tc.info, tc.trainJob)
+			if diff := gocmp.Diff(tc.wantBuildError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error from Build (-want, +got): %s", diff)
+			}
+			var typedObjs []apiruntime.Object
+			typedObjs, err = utiltesting.ToObject(cli.Scheme(), objs...)
+			if err != nil {
+				t.Errorf("Failed to convert object: %v", err)
+			}
+			if diff := gocmp.Diff(tc.wantObjs, typedObjs, objCmpOpts...); len(diff) != 0 {
+				t.Errorf("Unexpected objects from Build (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		info         *runtime.Info
+		oldObj       *trainer.TrainJob
+		newObj       *trainer.TrainJob
+		wantError    field.ErrorList
+		wantWarnings admission.Warnings
+	}{
+		"no action when info is nil": {},
+		"info does not have DeepSpeedPolicySource": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().Obj()),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Env(corev1.EnvVar{Name: constants.DeepSpeedEnvMasterAddr, Value: "custom"}).
+					Obj(),
+				).
+				Obj(),
+		},
+		"trainer has reserved DeepSpeed envs": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().
+					WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](1), nil).
+						Obj(),
+					).
+					Obj(),
+				),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Env(
+						corev1.EnvVar{Name: constants.DeepSpeedEnvMasterAddr, Value: "custom"},
+						corev1.EnvVar{Name: constants.DeepSpeedEnvHostfile, Value: "/custom/hostfile"},
+					).
+					Obj(),
+				).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(
+					field.NewPath("spec", "trainer", "env"),
+					[]corev1.EnvVar{
+						{Name: constants.DeepSpeedEnvMasterAddr, Value: "custom"},
+						{Name: constants.DeepSpeedEnvHostfile, Value: "/custom/hostfile"},
+					},
+					fmt.Sprintf("must not have reserved envs, invalid envs configured: %v", []string{constants.DeepSpeedEnvHostfile, constants.DeepSpeedEnvMasterAddr}),
+				),
+			},
+		},
+		"trainer has non-reserved env is valid": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().
+					WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](1), nil).
+						Obj(),
+					).
+					Obj(),
+				),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Env(corev1.EnvVar{Name: "CUSTOM_ENV", Value: "custom-value"}).
+					Obj(),
+				).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize DeepSpeed plugin: %v", err)
+			}
+			warnings, errs := p.(framework.CustomValidationPlugin).Validate(ctx, tc.info, tc.oldObj, tc.newObj)
+			if diff := gocmp.Diff(tc.wantError, errs); len(diff) != 0 {
+				t.Errorf("Unexpected error from Validate (-want, +got): %s", diff)
+			}
+			if diff := gocmp.Diff(tc.wantWarnings, warnings); len(diff) != 0 {
+				t.Errorf("Unexpected warnings from Validate (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSSHAuthSecretTTL(t *testing.T) {
+	cases := map[string]struct {
+		cfg             *configapi.Configuration
+		wantAnnotations map[string]string
+	}{
+		"no ttl annotation when generatedSecrets is not configured": {
+			cfg: &configapi.Configuration{},
+		},
+		"ttl annotation is set from the configured generatedSecrets ttl": {
+			cfg: &configapi.Configuration{
+				GeneratedSecrets: &configapi.GeneratedSecrets{
+					TTL: &metav1.Duration{Duration: 90 * time.Minute},
+				},
+			},
+			wantAnnotations: map[string]string{
+				constants.AnnotationSecretTTL: "1h30m0s",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil, tc.cfg)
+			if err != nil {
+				t.Fatalf("Failed to initialize DeepSpeed plugin: %v", err)
+			}
+			info := &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						DeepSpeedPolicy(ptr.To[int32](1), ptr.To("/root/.ssh")).
+						Obj(),
+				},
+			}
+			objs, err := p.(framework.ComponentBuilderPlugin).Build(ctx, info, utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj())
+			if err != nil {
+				t.Fatalf("Failed to build DeepSpeed objects: %v", err)
+			}
+			var secret *corev1ac.SecretApplyConfiguration
+			for _, obj := range objs {
+				if s, ok := obj.(*corev1ac.SecretApplyConfiguration); ok {
+					secret = s
+				}
+			}
+			if secret == nil {
+				t.Fatal("SSH Auth secret was not built")
+			}
+			if diff := gocmp.Diff(tc.wantAnnotations, secret.Annotations); len(diff) != 0 {
+				t.Errorf("Unexpected Secret annotations (-want, +got): %s", diff)
+			}
+		})
+	}
+}
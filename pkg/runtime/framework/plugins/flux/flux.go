@@ -22,9 +22,11 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"maps"
+	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
@@ -73,6 +75,7 @@ var (
 		"FLUX_VIEW_IMAGE":     constants.FluxInstallerImage,
 		"FLUX_NETWORK_DEVICE": constants.FluxNewtowkDevice,
 		"FLUX_QUEUE_POLICY":   constants.FluxQueuePolicy,
+		"FLUX_BROKER_PORT":    strconv.Itoa(int(constants.FluxBrokerPort)),
 		// Extra flux or broker options can be added as needed.
 	}
 )
@@ -85,15 +88,20 @@ var _ framework.WatchExtensionPlugin = (*Flux)(nil)
 const Name = "Flux"
 
 type Flux struct {
-	client client.Client
-	scheme *apiruntime.Scheme
+	client    client.Client
+	scheme    *apiruntime.Scheme
+	secretTTL *metav1.Duration
 }
 
-func New(_ context.Context, client client.Client, _ client.FieldIndexer, _ *configapi.Configuration) (framework.Plugin, error) {
-	return &Flux{
+func New(_ context.Context, client client.Client, _ client.FieldIndexer, cfg *configapi.Configuration) (framework.Plugin, error) {
+	f := &Flux{
 		client: client,
 		scheme: client.Scheme(),
-	}, nil
+	}
+	if cfg != nil && cfg.GeneratedSecrets != nil {
+		f.secretTTL = cfg.GeneratedSecrets.TTL
+	}
+	return f, nil
 }
 
 func (f *Flux) Name() string {
@@ -136,7 +144,7 @@ func (f *Flux) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) e
 		return nil
 	}
 
-	settings := f.brokerSettingsFromEnvironment(trainJob, info)
+	settings := f.brokerSettingsFromTrainJob(trainJob, info)
 	configMapName := fmt.Sprintf("%s-flux-entrypoint", trainJob.Name)
 	curveSecretName := fmt.Sprintf("%s-flux-curve", trainJob.Name)
 	sharedVolumes := getViewVolumes(configMapName)
@@ -220,7 +228,7 @@ func (f *Flux) Build(ctx context.Context, info *runtime.Info, trainJob *trainer.
 	// Note that for Flux, we currently support a design that allows for
 	// derivation of options from envars that are associated with the job.
 	// We get these from the designated node container.
-	settings := f.brokerSettingsFromEnvironment(trainJob, info)
+	settings := f.brokerSettingsFromTrainJob(trainJob, info)
 
 	// We need a custom entrypoint to prepare the view and configure flux
 	cm, err := f.buildInitScriptConfigMap(trainJob, info, settings)
@@ -259,13 +267,32 @@ func (f *Flux) ReconcilerBuilders() []runtime.ReconcilerBuilder {
 	}
 }
 
-// brokerSettingsFromTrainJob derives Flux broker config settings from the jobspet node container environment.
-func (f *Flux) brokerSettingsFromEnvironment(trainJob *trainer.TrainJob, info *runtime.Info) map[string]string {
+// brokerSettingsFromTrainJob derives Flux broker config settings from the Flux MLPolicy and
+// the jobspet node container environment. The precedence is the defaults, the MLPolicy fields,
+// the runtime node container envs, and the TrainJob trainer envs, from lowest to highest.
+func (f *Flux) brokerSettingsFromTrainJob(trainJob *trainer.TrainJob, info *runtime.Info) map[string]string {
 
 	// All settings defaults that we support are already defined here
 	settings := maps.Clone(brokerDefaults)
 
-	// Look through the envars in the runtime spec.
+	// The explicit MLPolicy fields take precedence over the defaults
+	if info != nil && info.RuntimePolicy.MLPolicySource != nil && info.RuntimePolicy.MLPolicySource.Flux != nil {
+		fluxPolicy := info.RuntimePolicy.MLPolicySource.Flux
+		if fluxPolicy.ViewImage != nil {
+			settings["FLUX_VIEW_IMAGE"] = *fluxPolicy.ViewImage
+		}
+		if fluxPolicy.NetworkDevice != nil {
+			settings["FLUX_NETWORK_DEVICE"] = *fluxPolicy.NetworkDevice
+		}
+		if fluxPolicy.QueuePolicy != nil {
+			settings["FLUX_QUEUE_POLICY"] = *fluxPolicy.QueuePolicy
+		}
+		if fluxPolicy.BrokerPort != nil {
+			settings["FLUX_BROKER_PORT"] = strconv.Itoa(int(*fluxPolicy.BrokerPort))
+		}
+	}
+
+	// Look through the envars in the runtime spec for backwards compatibility.
 	// We only care about the environment defined for the main workers/nodes
 	if info != nil {
 		trainerContainer := info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node)
@@ -356,6 +383,7 @@ func generateBrokerConfig(
 	// Get the network device for Flux to use (or fall back to default)
 	networkDevice := settings["FLUX_NETWORK_DEVICE"]
 	queuePolicy := settings["FLUX_QUEUE_POLICY"]
+	brokerPort := settings["FLUX_BROKER_PORT"]
 
 	subdomain := trainJob.Name
 	fqdn := fmt.Sprintf("%s.%s.svc.cluster.local", subdomain, trainJob.Namespace)
@@ -367,6 +395,7 @@ func generateBrokerConfig(
 
 	return fmt.Sprintf(
 		brokerTemplate,
+		brokerPort,
 		defaultBind,
 		defaultConnect,
 		hosts,
@@ -528,7 +557,11 @@ func (f *Flux) buildCurveSecret(trainJob *trainer.TrainJob) (*corev1ac.SecretApp
 
 	curveSecretName := fmt.Sprintf("%s-flux-curve", trainJob.Name)
 
-	return corev1ac.Secret(curveSecretName, trainJob.Namespace).
+	secret := corev1ac.Secret(curveSecretName, trainJob.Namespace)
+	if f.secretTTL != nil {
+		secret.WithAnnotations(map[string]string{constants.AnnotationSecretTTL: f.secretTTL.Duration.String()})
+	}
+	return secret.
 		WithData(map[string][]byte{
 			"curve.cert": []byte(curveContent),
 		}).
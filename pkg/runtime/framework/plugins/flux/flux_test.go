@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	gocmp "github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -35,6 +36,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
@@ -563,3 +565,140 @@ func TestGetOriginalCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestBrokerSettingsFromTrainJob(t *testing.T) {
+	fluxInfo := func(policy *trainer.FluxMLPolicySource, env ...corev1ac.EnvVarApplyConfiguration) *runtime.Info {
+		return &runtime.Info{
+			RuntimePolicy: runtime.RuntimePolicy{
+				MLPolicySource: &trainer.MLPolicySource{Flux: policy},
+			},
+			TemplateSpec: runtime.TemplateSpec{
+				PodSets: []runtime.PodSet{{
+					Name:       constants.Node,
+					Ancestor:   ptr.To(constants.AncestorTrainer),
+					Containers: []runtime.Container{{Name: constants.Node, Env: env}},
+				}},
+			},
+		}
+	}
+	fluxPolicy := &trainer.FluxMLPolicySource{
+		ViewImage:     ptr.To("ghcr.io/converged-computing/flux-view-rocky:tag-9"),
+		NetworkDevice: ptr.To("eth1"),
+		QueuePolicy:   ptr.To("easy"),
+		BrokerPort:    ptr.To[int32](9050),
+	}
+	cases := map[string]struct {
+		info     *runtime.Info
+		trainJob *trainer.TrainJob
+		want     map[string]string
+	}{
+		"defaults are used without the policy fields and envs": {
+			info: fluxInfo(&trainer.FluxMLPolicySource{}),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().Obj()).
+				Obj(),
+			want: map[string]string{
+				"FLUX_VIEW_IMAGE":     constants.FluxInstallerImage,
+				"FLUX_NETWORK_DEVICE": constants.FluxNewtowkDevice,
+				"FLUX_QUEUE_POLICY":   constants.FluxQueuePolicy,
+				"FLUX_BROKER_PORT":    "8050",
+			},
+		},
+		"policy fields take precedence over the defaults": {
+			info: fluxInfo(fluxPolicy),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().Obj()).
+				Obj(),
+			want: map[string]string{
+				"FLUX_VIEW_IMAGE":     "ghcr.io/converged-computing/flux-view-rocky:tag-9",
+				"FLUX_NETWORK_DEVICE": "eth1",
+				"FLUX_QUEUE_POLICY":   "easy",
+				"FLUX_BROKER_PORT":    "9050",
+			},
+		},
+		"runtime envs take precedence over the policy fields": {
+			info: fluxInfo(fluxPolicy,
+				*corev1ac.EnvVar().WithName("FLUX_NETWORK_DEVICE").WithValue("ib0"),
+				*corev1ac.EnvVar().WithName("FLUX_BROKER_PORT").WithValue("10050"),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().Obj()).
+				Obj(),
+			want: map[string]string{
+				"FLUX_VIEW_IMAGE":     "ghcr.io/converged-computing/flux-view-rocky:tag-9",
+				"FLUX_NETWORK_DEVICE": "ib0",
+				"FLUX_QUEUE_POLICY":   "easy",
+				"FLUX_BROKER_PORT":    "10050",
+			},
+		},
+		"TrainJob envs take precedence over the runtime envs and policy fields": {
+			info: fluxInfo(fluxPolicy,
+				*corev1ac.EnvVar().WithName("FLUX_NETWORK_DEVICE").WithValue("ib0"),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Env(
+						corev1.EnvVar{Name: "FLUX_NETWORK_DEVICE", Value: "eth2"},
+						corev1.EnvVar{Name: "FLUX_QUEUE_POLICY", Value: "conservative"},
+					).
+					Obj()).
+				Obj(),
+			want: map[string]string{
+				"FLUX_VIEW_IMAGE":     "ghcr.io/converged-computing/flux-view-rocky:tag-9",
+				"FLUX_NETWORK_DEVICE": "eth2",
+				"FLUX_QUEUE_POLICY":   "conservative",
+				"FLUX_BROKER_PORT":    "9050",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := &Flux{}
+			got := f.brokerSettingsFromTrainJob(tc.trainJob, tc.info)
+			if diff := gocmp.Diff(tc.want, got); len(diff) != 0 {
+				t.Errorf("Unexpected broker settings (-want, +got): %s", diff)
+			}
+			brokerConfig := generateBrokerConfig(tc.trainJob, "test-node-0-[0-1]", got)
+			if wantPort := fmt.Sprintf("default_port = %s\n", tc.want["FLUX_BROKER_PORT"]); !strings.Contains(brokerConfig, wantPort) {
+				t.Errorf("Expected the broker config to contain %q, got:\n%s", wantPort, brokerConfig)
+			}
+		})
+	}
+}
+
+func TestBuildCurveSecretTTL(t *testing.T) {
+	cases := map[string]struct {
+		cfg             *configapi.Configuration
+		wantAnnotations map[string]string
+	}{
+		"no ttl annotation when generatedSecrets is not configured": {
+			cfg: &configapi.Configuration{},
+		},
+		"ttl annotation is set from the configured generatedSecrets ttl": {
+			cfg: &configapi.Configuration{
+				GeneratedSecrets: &configapi.GeneratedSecrets{
+					TTL: &metav1.Duration{Duration: 90 * time.Minute},
+				},
+			},
+			wantAnnotations: map[string]string{
+				constants.AnnotationSecretTTL: "1h30m0s",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil, tc.cfg)
+			if err != nil {
+				t.Fatalf("Failed to initialize Flux plugin: %v", err)
+			}
+			secret, err := p.(*Flux).buildCurveSecret(utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj())
+			if err != nil {
+				t.Fatalf("Failed to build curve secret: %v", err)
+			}
+			if diff := gocmp.Diff(tc.wantAnnotations, secret.Annotations); len(diff) != 0 {
+				t.Errorf("Unexpected Secret annotations (-want, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpuenv
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+)
+
+const (
+	Name = "GPUEnv"
+
+	// Environment variable names
+	envNameVisibleDevices     = "NVIDIA_VISIBLE_DEVICES"
+	envNameDriverCapabilities = "NVIDIA_DRIVER_CAPABILITIES"
+)
+
+var _ framework.EnforceMLPolicyPlugin = (*GPUEnv)(nil)
+
+type GPUEnv struct {
+	gpuEnv *configapi.GPUEnv
+}
+
+func New(_ context.Context, _ client.Client, _ client.FieldIndexer, cfg *configapi.Configuration) (framework.Plugin, error) {
+	g := &GPUEnv{}
+	if cfg != nil {
+		g.gpuEnv = cfg.GPUEnv
+	}
+	return g, nil
+}
+
+func (g *GPUEnv) Name() string {
+	return Name
+}
+
+func (g *GPUEnv) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || trainJob == nil || g.gpuEnv == nil {
+		return nil
+	}
+
+	// The TrainJob resources take precedence over the runtime resources.
+	resourcesPerNode := ptr.Deref(runtime.ExtractResourcePerNodeFromRuntime(info), corev1.ResourceRequirements{})
+	if jobTrainer := trainJob.Spec.Trainer; jobTrainer != nil && jobTrainer.ResourcesPerNode != nil {
+		resourcesPerNode = ptr.Deref(jobTrainer.ResourcesPerNode, corev1.ResourceRequirements{})
+	}
+	if runtime.GetNumGPUPerNode(&resourcesPerNode) == 0 {
+		return nil
+	}
+
+	trainerContainer := info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node)
+	if trainerContainer == nil {
+		return nil
+	}
+	var jobEnv []corev1.EnvVar
+	if trainJob.Spec.Trainer != nil {
+		jobEnv = trainJob.Spec.Trainer.Env
+	}
+	defaults := []struct {
+		name  string
+		value *string
+	}{
+		{name: envNameVisibleDevices, value: g.gpuEnv.VisibleDevices},
+		{name: envNameDriverCapabilities, value: g.gpuEnv.DriverCapabilities},
+	}
+	for _, d := range defaults {
+		if d.value == nil || hasEnv(trainerContainer.Env, jobEnv, d.name) {
+			continue
+		}
+		trainerContainer.Env = append(trainerContainer.Env, *corev1ac.EnvVar().
+			WithName(d.name).
+			WithValue(*d.value))
+	}
+	return nil
+}
+
+// hasEnv returns true if the env is already set in the runtime container or the TrainJob.
+func hasEnv(containerEnv []corev1ac.EnvVarApplyConfiguration, jobEnv []corev1.EnvVar, name string) bool {
+	return slices.ContainsFunc(containerEnv, func(e corev1ac.EnvVarApplyConfiguration) bool {
+		return ptr.Deref(e.Name, "") == name
+	}) || slices.ContainsFunc(jobEnv, func(e corev1.EnvVar) bool {
+		return e.Name == name
+	})
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpuenv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestGPUEnv(t *testing.T) {
+	gpuEnvCfg := &configapi.Configuration{
+		GPUEnv: &configapi.GPUEnv{
+			VisibleDevices:     ptr.To("all"),
+			DriverCapabilities: ptr.To("compute,utility"),
+		},
+	}
+	gpuRequests := corev1.ResourceList{
+		"nvidia.com/gpu": resource.MustParse("2"),
+	}
+	cpuRequests := corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("2"),
+	}
+	trainerInfo := func(env ...*corev1ac.EnvVarApplyConfiguration) *runtime.Info {
+		return runtime.NewInfo(
+			runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+				WithContainers(corev1ac.Container().
+					WithName(constants.Node).
+					WithEnv(env...),
+				),
+			),
+		)
+	}
+
+	cases := map[string]struct {
+		cfg       *configapi.Configuration
+		info      *runtime.Info
+		trainJob  *trainer.TrainJob
+		wantEnv   []corev1ac.EnvVarApplyConfiguration
+		wantError error
+	}{
+		"no action when info is nil": {
+			cfg: gpuEnvCfg,
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Obj(),
+		},
+		"no action when gpuEnv is not configured": {
+			cfg:  &configapi.Configuration{},
+			info: trainerInfo(),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, gpuRequests).
+					Obj()).
+				Obj(),
+		},
+		"no action when GPUs are not requested": {
+			cfg:  gpuEnvCfg,
+			info: trainerInfo(),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, cpuRequests).
+					Obj()).
+				Obj(),
+		},
+		"envs are injected when GPUs are requested": {
+			cfg:  gpuEnvCfg,
+			info: trainerInfo(),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, gpuRequests).
+					Obj()).
+				Obj(),
+			wantEnv: []corev1ac.EnvVarApplyConfiguration{
+				*corev1ac.EnvVar().WithName(envNameVisibleDevices).WithValue("all"),
+				*corev1ac.EnvVar().WithName(envNameDriverCapabilities).WithValue("compute,utility"),
+			},
+		},
+		"only configured envs are injected when GPUs are requested": {
+			cfg: &configapi.Configuration{
+				GPUEnv: &configapi.GPUEnv{
+					DriverCapabilities: ptr.To("all"),
+				},
+			},
+			info: trainerInfo(),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, gpuRequests).
+					Obj()).
+				Obj(),
+			wantEnv: []corev1ac.EnvVarApplyConfiguration{
+				*corev1ac.EnvVar().WithName(envNameDriverCapabilities).WithValue("all"),
+			},
+		},
+		"envs set in the runtime and the trainJob are not overridden": {
+			cfg:  gpuEnvCfg,
+			info: trainerInfo(corev1ac.EnvVar().WithName(envNameVisibleDevices).WithValue("0")),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, gpuRequests).
+					Env(corev1.EnvVar{Name: envNameDriverCapabilities, Value: "compute"}).
+					Obj()).
+				Obj(),
+			wantEnv: []corev1ac.EnvVarApplyConfiguration{
+				*corev1ac.EnvVar().WithName(envNameVisibleDevices).WithValue("0"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			cliBuilder := utiltesting.NewClientBuilder()
+			p, err := New(ctx, cliBuilder.Build(), nil, tc.cfg)
+			if err != nil {
+				t.Fatalf("Failed to initialize GPUEnv plugin: %v", err)
+			}
+			err = p.(framework.EnforceMLPolicyPlugin).EnforceMLPolicy(tc.info, tc.trainJob)
+			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error from EnforceMLPolicy (-want,+got):\n%s", diff)
+			}
+			if tc.info == nil {
+				return
+			}
+			var gotEnv []corev1ac.EnvVarApplyConfiguration
+			if c := tc.info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node); c != nil {
+				gotEnv = c.Env
+			}
+			if diff := cmp.Diff(tc.wantEnv, gotEnv, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected trainer envs (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package horovod
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+)
+
+// Horovod is layered on the MPI plugin, which builds the SSH keys Secret and the hostfile ConfigMap
+// and mounts them to the launcher and the nodes. Horovod only wraps the training command with horovodrun
+// and injects the HOROVOD_* envs.
+type Horovod struct{}
+
+var _ framework.CustomValidationPlugin = (*Horovod)(nil)
+var _ framework.EnforceMLPolicyPlugin = (*Horovod)(nil)
+
+const Name = "Horovod"
+
+func New(context.Context, client.Client, client.FieldIndexer, *configapi.Configuration) (framework.Plugin, error) {
+	return &Horovod{}, nil
+}
+
+func (h *Horovod) Name() string {
+	return Name
+}
+
+func (h *Horovod) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil ||
+		runtimeInfo.RuntimePolicy.MLPolicySource.Horovod == nil {
+		return nil, allErrs
+	}
+	// horovodrun reads the number of the training processes from the slots of the OpenMPI hostfile.
+	runtimeRefPath := field.NewPath("spec", "runtimeRef")
+	if mpiPolicy := runtimeInfo.RuntimePolicy.MLPolicySource.MPI; mpiPolicy == nil {
+		allErrs = append(allErrs, field.Invalid(runtimeRefPath, newObj.Spec.RuntimeRef,
+			"must have the mpi policy together with the horovod policy"))
+	} else if impl := ptr.Deref(mpiPolicy.MPIImplementation, trainer.MPIImplementationOpenMPI); impl != trainer.MPIImplementationOpenMPI {
+		allErrs = append(allErrs, field.Invalid(runtimeRefPath, newObj.Spec.RuntimeRef,
+			fmt.Sprintf("must have the mpi policy of the %s implementation together with the horovod policy, but got %s", trainer.MPIImplementationOpenMPI, impl)))
+	}
+	if newObj.Spec.Trainer != nil {
+		specPath := field.NewPath("spec", "trainer", "env")
+		for i, env := range newObj.Spec.Trainer.Env {
+			if constants.HorovodReservedEnvNames.Has(env.Name) {
+				allErrs = append(allErrs, field.Forbidden(
+					specPath.Index(i),
+					fmt.Sprintf("%s is reserved for the Horovod runtime", env.Name),
+				))
+			}
+		}
+	}
+	return nil, allErrs
+}
+
+func (h *Horovod) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil ||
+		info.RuntimePolicy.MLPolicySource.Horovod == nil || info.RuntimePolicy.MLPolicySource.MPI == nil {
+		return nil
+	}
+
+	// Wrap the original command with horovodrun starting the training processes over the MPI hostfile.
+	// Also clear the existing args so only the Horovod launcher command controls execution.
+	originalCmd := getOriginalCommand(trainJob, info)
+	if trainJob.Spec.Trainer == nil {
+		trainJob.Spec.Trainer = &trainer.Trainer{}
+	}
+	trainJob.Spec.Trainer.Command = []string{"/bin/sh", "-c", generateLauncherCommand(originalCmd)}
+	trainJob.Spec.Trainer.Args = nil
+
+	envs := []corev1ac.EnvVarApplyConfiguration{
+		*corev1ac.EnvVar().
+			WithName(constants.HorovodEnvMPIThreadsDisable).
+			WithValue("1"),
+	}
+	if glooIface := info.RuntimePolicy.MLPolicySource.Horovod.GlooIface; glooIface != nil {
+		envs = append(envs, *corev1ac.EnvVar().
+			WithName(constants.HorovodEnvGlooIface).
+			WithValue(*glooIface))
+	}
+	for psIdx, ps := range info.TemplateSpec.PodSets {
+		if ps.Name != constants.Launcher && ps.Name != constants.Node {
+			continue
+		}
+		for cIdx, container := range ps.Containers {
+			if container.Name != constants.Node {
+				continue
+			}
+			apply.UpsertEnvVars(&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Env, envs...)
+		}
+	}
+	return nil
+}
+
+func (h *Horovod) SyncParallelCount(_ *runtime.Info) error { return nil }
+
+// getOriginalCommand derives the original training command run by horovodrun in each slot.
+func getOriginalCommand(trainJob *trainer.TrainJob, info *runtime.Info) string {
+	var command []string
+	var args []string
+	if trainerContainer := info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node); trainerContainer != nil {
+		command = trainerContainer.Command
+	}
+	if trainJob.Spec.Trainer != nil {
+		if trainJob.Spec.Trainer.Command != nil {
+			command = trainJob.Spec.Trainer.Command
+		}
+		if trainJob.Spec.Trainer.Args != nil {
+			args = trainJob.Spec.Trainer.Args
+		}
+	}
+	return strings.TrimSpace(strings.Join(append(command, args...), " "))
+}
+
+// generateLauncherCommand generates the horovodrun command for the launcher.
+// The number of the training processes is summed up from the slots in the OpenMPI hostfile,
+// since the MPI plugin may override the slots with the TrainJob numProcPerNode or the GPU count.
+// The other MPI implementations are rejected by Validate, since their hostfiles have no slots= entries.
+func generateLauncherCommand(originalCmd string) string {
+	hostfile := fmt.Sprintf("%s/%s", constants.MPIHostfileDir, constants.MPIHostfileName)
+	return fmt.Sprintf(`%s -np "$(awk -F'slots=' '{n += $2} END {print n}' %s)" --hostfile %s %s`,
+		constants.HorovodLauncherBinary, hostfile, hostfile, originalCmd)
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package horovod
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestHorovodValidate(t *testing.T) {
+	cases := map[string]struct {
+		runtimeInfo *runtime.Info
+		trainJob    *trainer.TrainJob
+		wantErrs    field.ErrorList
+	}{
+		"no error when runtime is not Horovod": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(false)).
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Env(corev1.EnvVar{Name: constants.HorovodEnvGlooIface, Value: "eth1"}).
+						Obj(),
+				).
+				Obj(),
+		},
+		"error when the horovod policy is set without the mpi policy": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							HorovodPolicy(nil).
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+			wantErrs: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "runtimeRef"), trainer.RuntimeRef{}, ""),
+			},
+		},
+		"error when the horovod policy is set with the mpi policy of the non-OpenMPI implementation": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationMPICH, ptr.To("/root/.ssh"), ptr.To(false)).
+							HorovodPolicy(nil).
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+			wantErrs: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "runtimeRef"), trainer.RuntimeRef{}, ""),
+			},
+		},
+		"error when using reserved env names": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(false)).
+							HorovodPolicy(nil).
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Env(
+							corev1.EnvVar{Name: "TRAIN_EPOCHS", Value: "10"},
+							corev1.EnvVar{Name: constants.HorovodEnvGlooIface, Value: "eth1"},
+							corev1.EnvVar{Name: constants.HorovodEnvMPIThreadsDisable, Value: "0"},
+						).
+						Obj(),
+				).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec", "trainer", "env").Index(1),
+					fmt.Sprintf("%s is reserved for the Horovod runtime", constants.HorovodEnvGlooIface),
+				),
+				field.Forbidden(
+					field.NewPath("spec", "trainer", "env").Index(2),
+					fmt.Sprintf("%s is reserved for the Horovod runtime", constants.HorovodEnvMPIThreadsDisable),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize Horovod plugin: %v", err)
+			}
+
+			_, errs := p.(framework.CustomValidationPlugin).Validate(ctx, tc.runtimeInfo, nil, tc.trainJob)
+			if diff := cmp.Diff(tc.wantErrs, errs, cmpopts.IgnoreFields(field.Error{}, "Detail")); len(diff) != 0 {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHorovodEnforceMLPolicy(t *testing.T) {
+	launcherCommand := func(cmd string) []string {
+		return []string{
+			"/bin/sh", "-c",
+			`horovodrun -np "$(awk -F'slots=' '{n += $2} END {print n}' /etc/mpi/hostfile)" --hostfile /etc/mpi/hostfile ` + cmd,
+		}
+	}
+	cases := map[string]struct {
+		info         *runtime.Info
+		trainJob     *trainer.TrainJob
+		wantInfo     *runtime.Info
+		wantTrainJob *trainer.TrainJob
+	}{
+		"no action when info is nil": {
+			trainJob:     utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+			wantTrainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+		},
+		"no action when mlPolicySource Horovod is null": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(false)).
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+			wantInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(false)).
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			wantTrainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+		},
+		"launcher runs the trainer command with horovodrun and the envs are injected into the launcher and nodes": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							MPIPolicy(ptr.To[int32](2), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(false)).
+							HorovodPolicy(ptr.To("eth0")).
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Launcher, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node).WithCommand("python")),
+				),
+				runtime.WithPodSet(constants.Node, nil, 2, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("horovod:latest", nil, []string{"train.py", "--epochs=10"}, nil).
+						Obj(),
+				).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						MPIPolicy(ptr.To[int32](2), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(false)).
+						HorovodPolicy(ptr.To("eth0")).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:              constants.Launcher,
+							Ancestor:          ptr.To(constants.AncestorTrainer),
+							Count:             ptr.To[int32](1),
+							SinglePodRequests: make(corev1.ResourceList),
+							Containers: []runtime.Container{{
+								Name:    constants.Node,
+								Command: []string{"python"},
+								Env: []corev1ac.EnvVarApplyConfiguration{
+									*corev1ac.EnvVar().
+										WithName(constants.HorovodEnvMPIThreadsDisable).
+										WithValue("1"),
+									*corev1ac.EnvVar().
+										WithName(constants.HorovodEnvGlooIface).
+										WithValue("eth0"),
+								},
+							}},
+						},
+						{
+							Name:              constants.Node,
+							Count:             ptr.To[int32](2),
+							SinglePodRequests: make(corev1.ResourceList),
+							Containers: []runtime.Container{{
+								Name: constants.Node,
+								Env: []corev1ac.EnvVarApplyConfiguration{
+									*corev1ac.EnvVar().
+										WithName(constants.HorovodEnvMPIThreadsDisable).
+										WithValue("1"),
+									*corev1ac.EnvVar().
+										WithName(constants.HorovodEnvGlooIface).
+										WithValue("eth0"),
+								},
+							}},
+						},
+					},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			wantTrainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("horovod:latest", launcherCommand("python train.py --epochs=10"), nil, nil).
+						Obj(),
+				).
+				Obj(),
+		},
+		"HOROVOD_GLOO_IFACE is not injected when glooIface is not set": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(true)).
+							HorovodPolicy(nil).
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Launcher, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("horovod:latest", []string{"python", "train.py"}, nil, nil).
+						Obj(),
+				).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(true)).
+						HorovodPolicy(nil).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Launcher,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								*corev1ac.EnvVar().
+									WithName(constants.HorovodEnvMPIThreadsDisable).
+									WithValue("1"),
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			wantTrainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("horovod:latest", launcherCommand("python train.py"), nil, nil).
+						Obj(),
+				).
+				Obj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize Horovod plugin: %v", err)
+			}
+
+			if err = p.(framework.EnforceMLPolicyPlugin).EnforceMLPolicy(tc.info, tc.trainJob); err != nil {
+				t.Errorf("Unexpected error from EnforceMLPolicy: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantInfo, tc.info, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected RuntimeInfo (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantTrainJob, tc.trainJob, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected TrainJob (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -93,11 +93,11 @@ func (j *Jax) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) er
 						trainJob.Name,
 						constants.Node,
 						trainJob.Name,
-						constants.ContainerTrainerPort)),
+						info.TrainerPort())),
 			)
 
 			// Add container port for the headless service (needed for pod-to-pod communication)
-			apply.UpsertPort(&trainerContainer.Ports, *corev1ac.ContainerPort().WithContainerPort(constants.ContainerTrainerPort))
+			apply.UpsertPort(&trainerContainer.Ports, info.TrainerContainerPort())
 		}
 	}
 
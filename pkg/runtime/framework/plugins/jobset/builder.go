@@ -17,8 +17,20 @@ limitations under the License.
 package jobset
 
 import (
+	"fmt"
+	"maps"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"gopkg.in/inf.v0"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	"k8s.io/utils/ptr"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
@@ -26,10 +38,12 @@ import (
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
+	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
 )
 
 type Builder struct {
 	*jobsetv1alpha2ac.JobSetApplyConfiguration
+	skipInitializerDependency bool
 }
 
 func NewBuilder(jobSet *jobsetv1alpha2ac.JobSetApplyConfiguration) *Builder {
@@ -38,8 +52,15 @@ func NewBuilder(jobSet *jobsetv1alpha2ac.JobSetApplyConfiguration) *Builder {
 	}
 }
 
+// SkipInitializerDependency keeps the trainer Jobs from depending on the completion of the initializer Jobs
+// not declared in the runtime.
+func (b *Builder) SkipInitializerDependency(skip bool) *Builder {
+	b.skipInitializerDependency = skip
+	return b
+}
+
 // Initializer updates JobSet values for the initializer Job.
-func (b *Builder) Initializer(trainJob *trainer.TrainJob) *Builder {
+func (b *Builder) Initializer(trainJob *trainer.TrainJob) (*Builder, error) {
 	for i, rJob := range b.Spec.ReplicatedJobs {
 		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
 		if jobMetadata == nil || jobMetadata.Labels == nil {
@@ -60,7 +81,15 @@ func (b *Builder) Initializer(trainJob *trainer.TrainJob) *Builder {
 							WithName(jobsetplgconsts.InitializerEnvStorageUri).
 							WithValue(*storageUri))
 					}
+					apply.UpsertEnvVars(env, s3CredentialEnvVars(trainJob.Spec.Initializer.Dataset.StorageUri, trainJob.Spec.Initializer.Dataset.SecretRef)...)
+					apply.UpsertEnvVars(env, azureCredentialEnvVars(trainJob.Spec.Initializer.Dataset.StorageUri, trainJob.Spec.Initializer.Dataset.SecretRef)...)
+					apply.UpsertEnvVars(env, httpExtractEnvVars(trainJob.Spec.Initializer.Dataset.StorageUri)...)
+					gcsCredentials(b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec, &b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j],
+						trainJob.Spec.Initializer.Dataset.StorageUri, trainJob.Spec.Initializer.Dataset.SecretRef)
 					apply.UpsertEnvVars(env, apply.EnvVars(trainJob.Spec.Initializer.Dataset.Env...)...)
+					// Update the dataset initializer envFrom sources.
+					envFrom := &b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].EnvFrom
+					*envFrom = append(*envFrom, apply.EnvFromSources(trainJob.Spec.Initializer.Dataset.EnvFrom...)...)
 					// Update the dataset initializer secret reference.
 					if trainJob.Spec.Initializer.Dataset.SecretRef != nil {
 						b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].
@@ -77,6 +106,13 @@ func (b *Builder) Initializer(trainJob *trainer.TrainJob) *Builder {
 			// REF: https://github.com/kubeflow/trainer/issues/2318
 			b.Spec.ReplicatedJobs[i].Replicas = ptr.To[int32](1)
 			for j, container := range rJob.Template.Spec.Template.Spec.Containers {
+				// Update the checkpoint to be downloaded by the model initializer container.
+				if *container.Name == constants.ModelInitializer && trainJob.Spec.Checkpoint != nil && trainJob.Spec.Checkpoint.StorageUri != nil {
+					apply.UpsertEnvVars(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Env, *corev1ac.EnvVar().
+						WithName(jobsetplgconsts.InitializerEnvCheckpointStorageUri).
+						WithValue(*trainJob.Spec.Checkpoint.StorageUri))
+					apply.UpsertVolumeMounts(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].VolumeMounts, checkpointVolumeMount())
+				}
 				// Update values for the model initializer container.
 				if *container.Name == constants.ModelInitializer && trainJob.Spec.Initializer != nil && trainJob.Spec.Initializer.Model != nil {
 					env := &b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Env
@@ -86,7 +122,14 @@ func (b *Builder) Initializer(trainJob *trainer.TrainJob) *Builder {
 							WithName(jobsetplgconsts.InitializerEnvStorageUri).
 							WithValue(*storageUri))
 					}
+					apply.UpsertEnvVars(env, s3CredentialEnvVars(trainJob.Spec.Initializer.Model.StorageUri, trainJob.Spec.Initializer.Model.SecretRef)...)
+					apply.UpsertEnvVars(env, azureCredentialEnvVars(trainJob.Spec.Initializer.Model.StorageUri, trainJob.Spec.Initializer.Model.SecretRef)...)
+					gcsCredentials(b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec, &b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j],
+						trainJob.Spec.Initializer.Model.StorageUri, trainJob.Spec.Initializer.Model.SecretRef)
 					apply.UpsertEnvVars(env, apply.EnvVars(trainJob.Spec.Initializer.Model.Env...)...)
+					// Update the model initializer envFrom sources.
+					envFrom := &b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].EnvFrom
+					*envFrom = append(*envFrom, apply.EnvFromSources(trainJob.Spec.Initializer.Model.EnvFrom...)...)
 					// Update the model initializer secret reference.
 					if trainJob.Spec.Initializer.Model.SecretRef != nil {
 						b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].
@@ -98,7 +141,161 @@ func (b *Builder) Initializer(trainJob *trainer.TrainJob) *Builder {
 			}
 		}
 	}
-	return b
+	if !b.skipInitializerDependency {
+		b.initializerDependency()
+	}
+	if trainJob.Spec.Initializer != nil && trainJob.Spec.Initializer.Dataset != nil && len(trainJob.Spec.Initializer.Dataset.Datasets) != 0 {
+		if err := b.datasets(trainJob.Spec.Initializer.Dataset); err != nil {
+			return nil, err
+		}
+	}
+	if trainJob.Spec.Initializer != nil && trainJob.Spec.Initializer.TrainerDependency != nil {
+		b.trainerDependency(jobsetv1alpha2.DependsOnStatus(*trainJob.Spec.Initializer.TrainerDependency))
+	}
+	return b, nil
+}
+
+// initializerDependency makes the trainer Jobs depend on the completion of the preceding initializer Jobs,
+// so that the trainer Pods do not start before the dataset and model are initialized.
+// The dependencies already declared in the runtime are kept as is.
+func (b *Builder) initializerDependency() {
+	// The DependsOn API is mutually exclusive with the StartupPolicy API.
+	if b.Spec.StartupPolicy != nil {
+		return
+	}
+	var initializers []string
+	for i, rJob := range b.Spec.ReplicatedJobs {
+		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
+		if jobMetadata == nil {
+			continue
+		}
+		switch jobMetadata.Labels[constants.LabelTrainJobAncestor] {
+		case constants.DatasetInitializer, constants.ModelInitializer:
+			initializers = append(initializers, ptr.Deref(rJob.Name, ""))
+		case constants.AncestorTrainer:
+			for _, name := range initializers {
+				if slices.ContainsFunc(rJob.DependsOn, func(d jobsetv1alpha2ac.DependsOnApplyConfiguration) bool {
+					return ptr.Deref(d.Name, "") == name
+				}) {
+					continue
+				}
+				b.Spec.ReplicatedJobs[i].WithDependsOn(jobsetv1alpha2ac.DependsOn().
+					WithName(name).
+					WithStatus(jobsetv1alpha2.DependencyComplete))
+			}
+		}
+	}
+}
+
+// datasets replaces the dataset initializer Job with a Job per dataset, which downloads the dataset
+// into its own sub path of the initializer volume, and mounts the sub paths in the trainer Job.
+func (b *Builder) datasets(dataset *trainer.DatasetInitializer) error {
+	idx := slices.IndexFunc(b.Spec.ReplicatedJobs, func(rJob jobsetv1alpha2ac.ReplicatedJobApplyConfiguration) bool {
+		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
+		return jobMetadata != nil && jobMetadata.Labels[constants.LabelTrainJobAncestor] == constants.DatasetInitializer
+	})
+	if idx == -1 {
+		return nil
+	}
+	rJobName := ptr.Deref(b.Spec.ReplicatedJobs[idx].Name, "")
+	rJobs := make([]jobsetv1alpha2ac.ReplicatedJobApplyConfiguration, 0, len(dataset.Datasets))
+	for i, source := range dataset.Datasets {
+		rJob, err := apply.DeepCopy(&b.Spec.ReplicatedJobs[idx])
+		if err != nil {
+			return err
+		}
+		rJob.Name = ptr.To(fmt.Sprintf("%s-%d", rJobName, i))
+		podSpec := rJob.Template.Spec.Template.Spec
+		for j, container := range podSpec.Containers {
+			if *container.Name != constants.DatasetInitializer {
+				continue
+			}
+			env := &podSpec.Containers[j].Env
+			apply.UpsertEnvVars(env, *corev1ac.EnvVar().
+				WithName(jobsetplgconsts.InitializerEnvStorageUri).
+				WithValue(source.StorageUri))
+			apply.UpsertEnvVars(env, s3CredentialEnvVars(&source.StorageUri, dataset.SecretRef)...)
+			apply.UpsertEnvVars(env, azureCredentialEnvVars(&source.StorageUri, dataset.SecretRef)...)
+			apply.UpsertEnvVars(env, httpExtractEnvVars(&source.StorageUri)...)
+			gcsCredentials(podSpec, &podSpec.Containers[j], &source.StorageUri, dataset.SecretRef)
+			apply.UpsertVolumeMounts(&podSpec.Containers[j].VolumeMounts, *corev1ac.VolumeMount().
+				WithName(jobsetplgconsts.VolumeNameInitializer).
+				WithMountPath(constants.DatasetMountPath).
+				WithSubPath(datasetSubPath(i)))
+		}
+		rJobs = append(rJobs, *rJob)
+	}
+	b.Spec.ReplicatedJobs = slices.Replace(b.Spec.ReplicatedJobs, idx, idx+1, rJobs...)
+
+	for i, rJob := range b.Spec.ReplicatedJobs {
+		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
+		if jobMetadata == nil || jobMetadata.Labels[constants.LabelTrainJobAncestor] != constants.AncestorTrainer {
+			continue
+		}
+		// The trainer Job depends on every dataset initializer Job instead.
+		var dependsOn []jobsetv1alpha2ac.DependsOnApplyConfiguration
+		for _, d := range rJob.DependsOn {
+			if ptr.Deref(d.Name, "") != rJobName {
+				dependsOn = append(dependsOn, d)
+				continue
+			}
+			for _, datasetRJob := range rJobs {
+				dependsOn = append(dependsOn, *jobsetv1alpha2ac.DependsOn().
+					WithName(*datasetRJob.Name).
+					WithStatus(ptr.Deref(d.Status, jobsetv1alpha2.DependencyComplete)))
+			}
+		}
+		b.Spec.ReplicatedJobs[i].DependsOn = dependsOn
+		for j, container := range rJob.Template.Spec.Template.Spec.Containers {
+			if *container.Name != constants.Node {
+				continue
+			}
+			for k, source := range dataset.Datasets {
+				apply.UpsertVolumeMounts(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].VolumeMounts, *corev1ac.VolumeMount().
+					WithName(jobsetplgconsts.VolumeNameInitializer).
+					WithMountPath(source.MountPath).
+					WithSubPath(datasetSubPath(k)))
+			}
+		}
+	}
+	return nil
+}
+
+// datasetSubPath returns the sub path of the initializer volume for the dataset with the index.
+func datasetSubPath(idx int) string {
+	return fmt.Sprintf("dataset-%d", idx)
+}
+
+// checkpointVolumeMount returns the volumeMount of the checkpoint sub path of the initializer volume,
+// which the model initializer downloads the checkpoint into and the trainer resumes the training from.
+func checkpointVolumeMount() corev1ac.VolumeMountApplyConfiguration {
+	return *corev1ac.VolumeMount().
+		WithName(jobsetplgconsts.VolumeNameInitializer).
+		WithMountPath(constants.CheckpointMountPath).
+		WithSubPath(jobsetplgconsts.CheckpointSubPath)
+}
+
+// trainerDependency overrides the status of the initializer Jobs which the trainer Jobs depend on.
+func (b *Builder) trainerDependency(status jobsetv1alpha2.DependsOnStatus) {
+	initializers := sets.New[string]()
+	for _, rJob := range b.Spec.ReplicatedJobs {
+		if jobMetadata := rJob.Template.ObjectMetaApplyConfiguration; jobMetadata != nil {
+			if ancestor := jobMetadata.Labels[constants.LabelTrainJobAncestor]; ancestor == constants.DatasetInitializer || ancestor == constants.ModelInitializer {
+				initializers.Insert(ptr.Deref(rJob.Name, ""))
+			}
+		}
+	}
+	for i, rJob := range b.Spec.ReplicatedJobs {
+		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
+		if jobMetadata == nil || jobMetadata.Labels[constants.LabelTrainJobAncestor] != constants.AncestorTrainer {
+			continue
+		}
+		for j, dependsOn := range rJob.DependsOn {
+			if initializers.Has(ptr.Deref(dependsOn.Name, "")) {
+				b.Spec.ReplicatedJobs[i].DependsOn[j].Status = ptr.To(status)
+			}
+		}
+	}
 }
 
 // isRunLauncherAsNode returns true if runLauncherAsNode is set to true in the MPI policy.
@@ -111,6 +308,7 @@ func (b *Builder) isRunLauncherAsNode(info *runtime.Info) bool {
 
 // Trainer updates JobSet values for the trainer Job.
 func (b *Builder) Trainer(info *runtime.Info, trainJob *trainer.TrainJob) *Builder {
+	metadataEnvs := initializerMetadataEnvVars(b.Spec.ReplicatedJobs, trainJob.Spec.Initializer)
 	for i, rJob := range b.Spec.ReplicatedJobs {
 		ancestor := ""
 		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
@@ -121,6 +319,32 @@ func (b *Builder) Trainer(info *runtime.Info, trainJob *trainer.TrainJob) *Build
 			// TODO: Support multiple replicas ('.template.spec.replicatedJobs[*].replicas') for replicated Jobs.
 			// REF: https://github.com/kubeflow/trainer/issues/2318
 			b.Spec.ReplicatedJobs[i].Replicas = ptr.To[int32](1)
+			if jobTrainer := trainJob.Spec.Trainer; jobTrainer != nil {
+				if jobTrainer.GPUTopology != nil {
+					b.Spec.ReplicatedJobs[i].Template.Spec.Template.WithAnnotations(gpuTopologyAnnotations(jobTrainer.GPUTopology))
+				}
+				// Merge the node selector, tolerations and image pull secrets with the runtime values,
+				// the TrainJob values take precedence for the same keys.
+				podSpec := b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec
+				if len(jobTrainer.NodeSelector) != 0 {
+					podSpec.WithNodeSelector(jobTrainer.NodeSelector)
+				}
+				if len(jobTrainer.Tolerations) != 0 {
+					apply.UpsertTolerations(&podSpec.Tolerations, apply.Tolerations(jobTrainer.Tolerations...)...)
+				}
+				if len(jobTrainer.ImagePullSecrets) != 0 {
+					apply.UpsertImagePullSecrets(&podSpec.ImagePullSecrets, apply.LocalObjectReferences(jobTrainer.ImagePullSecrets...)...)
+				}
+				if jobTrainer.GPUProduct != nil {
+					requireNodeAffinity(podSpec, corev1ac.NodeSelectorRequirement().
+						WithKey(constants.GPUProductLabel).
+						WithOperator(corev1.NodeSelectorOpIn).
+						WithValues(*jobTrainer.GPUProduct))
+				}
+				if jobTrainer.Spot != nil {
+					spotNodeAffinity(podSpec, jobTrainer.Spot, trainjob.IsSpotActive(trainJob))
+				}
+			}
 			// Update values for the Trainer container.
 			for j, container := range rJob.Template.Spec.Template.Spec.Containers {
 				if *container.Name == constants.Node {
@@ -135,6 +359,17 @@ func (b *Builder) Trainer(info *runtime.Info, trainJob *trainer.TrainJob) *Build
 						if args := jobTrainer.Args; args != nil {
 							b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Args = args
 						}
+						if len(jobTrainer.AddCapabilities) != 0 {
+							addCapabilities(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j], jobTrainer.AddCapabilities...)
+						}
+						if jobTrainer.Heartbeat != nil {
+							heartbeatLivenessProbe(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j], jobTrainer.Heartbeat)
+						}
+					}
+					// Inject the additional named ports declared in the runtime.
+					for _, port := range info.RuntimePolicy.AdditionalPorts {
+						apply.UpsertPort(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Ports,
+							*corev1ac.ContainerPort().WithName(port.Name).WithContainerPort(port.ContainerPort))
 					}
 				}
 			}
@@ -144,11 +379,22 @@ func (b *Builder) Trainer(info *runtime.Info, trainJob *trainer.TrainJob) *Build
 			// Eventually, we should find better way to propagate resources from TrainJob to JobSet.
 			for j, container := range rJob.Template.Spec.Template.Spec.Containers {
 				if *container.Name == constants.Node {
+					if trainJob.Spec.Checkpoint != nil && trainJob.Spec.Checkpoint.StorageUri != nil {
+						apply.UpsertEnvVars(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Env, *corev1ac.EnvVar().
+							WithName(jobsetplgconsts.EnvCheckpointPath).
+							WithValue(constants.CheckpointMountPath))
+						apply.UpsertVolumeMounts(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].VolumeMounts, checkpointVolumeMount())
+					}
+					if len(metadataEnvs) != 0 {
+						apply.UpsertEnvVars(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Env, metadataEnvs...)
+					}
 					if jobTrainer := trainJob.Spec.Trainer; jobTrainer != nil {
 						apply.UpsertEnvVars(
 							&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Env,
 							apply.EnvVars(jobTrainer.Env...)...,
 						)
+						envFrom := &b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].EnvFrom
+						*envFrom = append(*envFrom, apply.EnvFromSources(jobTrainer.EnvFrom...)...)
 					}
 				}
 			}
@@ -157,6 +403,210 @@ func (b *Builder) Trainer(info *runtime.Info, trainJob *trainer.TrainJob) *Build
 	return b
 }
 
+// initializerMetadataEnvVars returns the env vars pointing the trainer at the metadata reported
+// by the initializers present in the runtime on the shared initializer volume.
+func initializerMetadataEnvVars(rJobs []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration, initializer *trainer.Initializer) []corev1ac.EnvVarApplyConfiguration {
+	if initializer == nil {
+		return nil
+	}
+	var (
+		envs            []corev1ac.EnvVarApplyConfiguration
+		datasetMetadata bool
+	)
+	for _, rJob := range rJobs {
+		if rJob.Template == nil || rJob.Template.ObjectMetaApplyConfiguration == nil {
+			continue
+		}
+		switch rJob.Template.Labels[constants.LabelTrainJobAncestor] {
+		case constants.DatasetInitializer:
+			// Every dataset initializer Job reports the metadata of its own dataset.
+			if initializer.Dataset == nil || datasetMetadata {
+				continue
+			}
+			datasetMetadata = true
+			envs = append(envs, *corev1ac.EnvVar().
+				WithName(jobsetplgconsts.EnvDatasetMetadataPath).
+				WithValue(datasetMetadataPaths(initializer.Dataset)))
+		case constants.ModelInitializer:
+			if initializer.Model == nil {
+				continue
+			}
+			envs = append(envs, *corev1ac.EnvVar().
+				WithName(jobsetplgconsts.EnvModelMetadataPath).
+				WithValue(path.Join(constants.ModelMountPath, jobsetplgconsts.InitializerMetadataFileName)))
+		}
+	}
+	return envs
+}
+
+// datasetMetadataPaths returns the comma-separated paths of the dataset metadata in the trainer.
+// The metadata of the datasets is reported into their sub paths mounted at the dataset mount paths.
+func datasetMetadataPaths(dataset *trainer.DatasetInitializer) string {
+	if len(dataset.Datasets) == 0 {
+		return path.Join(constants.DatasetMountPath, jobsetplgconsts.InitializerMetadataFileName)
+	}
+	paths := make([]string, 0, len(dataset.Datasets))
+	for _, source := range dataset.Datasets {
+		paths = append(paths, path.Join(source.MountPath, jobsetplgconsts.InitializerMetadataFileName))
+	}
+	return strings.Join(paths, ",")
+}
+
+// gpuTopologyAnnotations returns the Pod annotations requesting the topology-aware placement
+// of the training nodes within the domain of the GPU topology level.
+func gpuTopologyAnnotations(gpuTopology *trainer.GPUTopology) map[string]string {
+	level := ptr.Deref(gpuTopology.Level, constants.DefaultGPUTopologyLevel)
+	if ptr.Deref(gpuTopology.Mode, trainer.GPUTopologyModeRequired) == trainer.GPUTopologyModePreferred {
+		return map[string]string{constants.AnnotationPodSetPreferredTopology: level}
+	}
+	return map[string]string{constants.AnnotationPodSetRequiredTopology: level}
+}
+
+// requireNodeAffinity adds the node selector requirement to every required node selector term
+// of the Pod node affinity, so that it narrows the runtime node affinity rather than being ORed with it.
+func requireNodeAffinity(podSpec *corev1ac.PodSpecApplyConfiguration, requirement *corev1ac.NodeSelectorRequirementApplyConfiguration) {
+	if podSpec.Affinity == nil {
+		podSpec.WithAffinity(corev1ac.Affinity())
+	}
+	if podSpec.Affinity.NodeAffinity == nil {
+		podSpec.Affinity.WithNodeAffinity(corev1ac.NodeAffinity())
+	}
+	nodeAffinity := podSpec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.WithRequiredDuringSchedulingIgnoredDuringExecution(corev1ac.NodeSelector())
+	}
+	required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(required.NodeSelectorTerms) == 0 {
+		required.WithNodeSelectorTerms(corev1ac.NodeSelectorTerm())
+	}
+	for i := range required.NodeSelectorTerms {
+		required.NodeSelectorTerms[i].WithMatchExpressions(requirement)
+	}
+}
+
+// spotNodeAffinity requires the spot node labels, together with the tolerations for the spot node taints,
+// while the spot placement is active. Otherwise, the spot nodes are excluded to fall back to the on-demand nodes.
+func spotNodeAffinity(podSpec *corev1ac.PodSpecApplyConfiguration, spot *trainer.Spot, active bool) {
+	operator := corev1.NodeSelectorOpNotIn
+	if active {
+		operator = corev1.NodeSelectorOpIn
+		if len(spot.Tolerations) != 0 {
+			apply.UpsertTolerations(&podSpec.Tolerations, apply.Tolerations(spot.Tolerations...)...)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(spot.NodeLabels)) {
+		requireNodeAffinity(podSpec, corev1ac.NodeSelectorRequirement().
+			WithKey(key).
+			WithOperator(operator).
+			WithValues(spot.NodeLabels[key]))
+	}
+}
+
+// heartbeatLivenessProbe configures the container liveness probe failing when the heartbeat file
+// is not updated within the timeout, so that the hung trainer container is restarted.
+// The heartbeat file path is passed as the positional parameter to avoid the shell quoting.
+func heartbeatLivenessProbe(container *corev1ac.ContainerApplyConfiguration, heartbeat *trainer.Heartbeat) {
+	timeoutSeconds := ptr.Deref(heartbeat.TimeoutSeconds, constants.DefaultHeartbeatTimeoutSeconds)
+	apply.UpsertEnvVars(&container.Env, *corev1ac.EnvVar().
+		WithName(jobsetplgconsts.EnvHeartbeatFile).
+		WithValue(heartbeat.Path))
+	container.WithLivenessProbe(corev1ac.Probe().
+		WithExec(corev1ac.ExecAction().
+			WithCommand("/bin/sh", "-c", fmt.Sprintf(`test $(( $(date +%%s) - $(stat -c %%Y "$1") )) -lt %d`, timeoutSeconds), "heartbeat", heartbeat.Path)).
+		WithInitialDelaySeconds(timeoutSeconds).
+		WithPeriodSeconds(min(timeoutSeconds, 30)).
+		WithFailureThreshold(1))
+}
+
+// addCapabilities adds the Linux capabilities to the container security context
+// while preserving the capabilities already configured in the runtime.
+func addCapabilities(container *corev1ac.ContainerApplyConfiguration, capabilities ...corev1.Capability) {
+	if container.SecurityContext == nil {
+		container.WithSecurityContext(corev1ac.SecurityContext())
+	}
+	if container.SecurityContext.Capabilities == nil {
+		container.SecurityContext.WithCapabilities(corev1ac.Capabilities())
+	}
+	for _, c := range capabilities {
+		if !slices.Contains(container.SecurityContext.Capabilities.Add, c) {
+			container.SecurityContext.Capabilities.WithAdd(c)
+		}
+	}
+}
+
+// DefaultResourceLimits sets the resource limits for the trainer and initializer containers
+// from the resource requests multiplied by the given ratio when the limits are not set.
+func (b *Builder) DefaultResourceLimits(limitRequestRatio corev1.ResourceList) *Builder {
+	if len(limitRequestRatio) == 0 {
+		return b
+	}
+	for i, rJob := range b.Spec.ReplicatedJobs {
+		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
+		if jobMetadata == nil || jobMetadata.Labels == nil {
+			continue
+		}
+		var containerName string
+		switch jobMetadata.Labels[constants.LabelTrainJobAncestor] {
+		case constants.AncestorTrainer:
+			containerName = constants.Node
+		case constants.DatasetInitializer:
+			containerName = constants.DatasetInitializer
+		case constants.ModelInitializer:
+			containerName = constants.ModelInitializer
+		default:
+			continue
+		}
+		for j, container := range rJob.Template.Spec.Template.Spec.Containers {
+			if *container.Name != containerName || container.Resources == nil || container.Resources.Requests == nil {
+				continue
+			}
+			resources := b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Resources
+			limits := corev1.ResourceList{}
+			if resources.Limits != nil {
+				limits = maps.Clone(*resources.Limits)
+			}
+			for name, ratio := range limitRequestRatio {
+				request, ok := (*resources.Requests)[name]
+				if _, found := limits[name]; !ok || found {
+					continue
+				}
+				limits[name] = scaleQuantity(name, request, ratio)
+			}
+			if len(limits) != 0 {
+				resources.WithLimits(limits)
+			}
+		}
+	}
+	return b
+}
+
+// scaleQuantity returns the quantity multiplied by the ratio, rounded up to the nearest milli unit for CPU
+// and to the nearest whole unit, e.g. bytes for memory, for the other resources.
+func scaleQuantity(name corev1.ResourceName, q, ratio resource.Quantity) resource.Quantity {
+	scale := inf.Scale(0)
+	if name == corev1.ResourceCPU {
+		scale = 3
+	}
+	scaled := new(inf.Dec).Mul(q.AsDec(), ratio.AsDec())
+	return *resource.NewDecimalQuantity(*scaled.Round(scaled, scale, inf.RoundCeil), q.Format)
+}
+
+// JobAnnotations propagates the annotations to the Job template metadata for all replicated Jobs.
+// The annotations defined in the Job template take precedence over the propagated ones.
+func (b *Builder) JobAnnotations(annotations map[string]string) *Builder {
+	if len(annotations) == 0 {
+		return b
+	}
+	for i := range b.Spec.ReplicatedJobs {
+		jobAnnotations := maps.Clone(annotations)
+		if metadata := b.Spec.ReplicatedJobs[i].Template.ObjectMetaApplyConfiguration; metadata != nil {
+			maps.Copy(jobAnnotations, metadata.Annotations)
+		}
+		b.Spec.ReplicatedJobs[i].Template.WithAnnotations(jobAnnotations)
+	}
+	return b
+}
+
 // TODO: Supporting merge labels would be great.
 
 func (b *Builder) PodLabels(labels map[string]string) *Builder {
@@ -166,6 +616,70 @@ func (b *Builder) PodLabels(labels map[string]string) *Builder {
 	return b
 }
 
+// DefaultEnv sets the env vars in all the containers and init containers of the JobSet,
+// unless the env vars are already set by the runtime or the TrainJob.
+func (b *Builder) DefaultEnv(envs []corev1.EnvVar) *Builder {
+	if len(envs) == 0 {
+		return b
+	}
+	for i := range b.Spec.ReplicatedJobs {
+		podSpec := b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec
+		for _, containers := range [][]corev1ac.ContainerApplyConfiguration{podSpec.InitContainers, podSpec.Containers} {
+			for j := range containers {
+				for _, env := range envs {
+					if slices.ContainsFunc(containers[j].Env, func(e corev1ac.EnvVarApplyConfiguration) bool {
+						return ptr.Deref(e.Name, "") == env.Name
+					}) {
+						continue
+					}
+					containers[j].WithEnv(corev1ac.EnvVar().
+						WithName(env.Name).
+						WithValue(env.Value))
+				}
+			}
+		}
+	}
+	return b
+}
+
+// TrainJobIdentityEnv sets the name, namespace and UID of the TrainJob as env vars in the trainer containers,
+// so that the training code can correlate its logs and metrics with the TrainJob.
+func (b *Builder) TrainJobIdentityEnv(trainJob *trainer.TrainJob) *Builder {
+	for i, rJob := range b.Spec.ReplicatedJobs {
+		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
+		if jobMetadata == nil || jobMetadata.Labels[constants.LabelTrainJobAncestor] != constants.AncestorTrainer {
+			continue
+		}
+		for j, container := range rJob.Template.Spec.Template.Spec.Containers {
+			if ptr.Deref(container.Name, "") != constants.Node {
+				continue
+			}
+			apply.UpsertEnvVars(&b.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[j].Env,
+				*corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobName).WithValue(trainJob.Name),
+				*corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobNamespace).WithValue(trainJob.Namespace),
+				*corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobUID).WithValue(string(trainJob.UID)),
+			)
+		}
+	}
+	return b
+}
+
+// TrainerPodLabels applies the labels to the Pod template of the trainer Jobs.
+// The given labels take precedence over the labels with the same keys in the Pod template.
+func (b *Builder) TrainerPodLabels(labels map[string]string) *Builder {
+	if len(labels) == 0 {
+		return b
+	}
+	for i, rJob := range b.Spec.ReplicatedJobs {
+		jobMetadata := rJob.Template.ObjectMetaApplyConfiguration
+		if jobMetadata == nil || jobMetadata.Labels[constants.LabelTrainJobAncestor] != constants.AncestorTrainer {
+			continue
+		}
+		b.Spec.ReplicatedJobs[i].Template.Spec.Template.WithLabels(labels)
+	}
+	return b
+}
+
 func (b *Builder) PodAnnotations(annotations map[string]string) *Builder {
 	for i := range b.Spec.ReplicatedJobs {
 		b.Spec.ReplicatedJobs[i].Template.Spec.Template.WithAnnotations(annotations)
@@ -181,3 +695,88 @@ func (b *Builder) Suspend(suspend *bool) *Builder {
 func (b *Builder) Build() *jobsetv1alpha2ac.JobSetApplyConfiguration {
 	return b.JobSetApplyConfiguration
 }
+
+// s3CredentialEnvVars returns the AWS credential envs sourced from the initializer secret
+// when the storageUri refers to S3, so that the S3 clients pick up the credentials
+// without them appearing in plaintext. The keys are optional, so that the initializer starts
+// without them and the S3 clients fall back to the other credential sources.
+func s3CredentialEnvVars(storageUri *string, secretRef *corev1.LocalObjectReference) []corev1ac.EnvVarApplyConfiguration {
+	if storageUri == nil || secretRef == nil || !strings.HasPrefix(*storageUri, "s3://") {
+		return nil
+	}
+	secretKeyEnv := func(name, key string) corev1ac.EnvVarApplyConfiguration {
+		return *corev1ac.EnvVar().
+			WithName(name).
+			WithValueFrom(corev1ac.EnvVarSource().
+				WithSecretKeyRef(corev1ac.SecretKeySelector().
+					WithName(secretRef.Name).
+					WithKey(key).
+					WithOptional(true)))
+	}
+	return []corev1ac.EnvVarApplyConfiguration{
+		secretKeyEnv(jobsetplgconsts.InitializerEnvAWSAccessKeyID, jobsetplgconsts.InitializerEnvAccessKeyID),
+		secretKeyEnv(jobsetplgconsts.InitializerEnvAWSSecretAccessKey, jobsetplgconsts.InitializerEnvSecretAccessKey),
+	}
+}
+
+// azureCredentialEnvVars returns the Azure storage account envs sourced from the initializer secret
+// when the storageUri refers to Azure Blob Storage.
+// The keys are optional, so that the initializer reports the missing account name instead of the Pod failing to start.
+func azureCredentialEnvVars(storageUri *string, secretRef *corev1.LocalObjectReference) []corev1ac.EnvVarApplyConfiguration {
+	if storageUri == nil || secretRef == nil || !strings.HasPrefix(*storageUri, "az://") {
+		return nil
+	}
+	var envs []corev1ac.EnvVarApplyConfiguration
+	for _, name := range []string{jobsetplgconsts.InitializerEnvAzureStorageAccountName, jobsetplgconsts.InitializerEnvAzureStorageAccountKey} {
+		envs = append(envs, *corev1ac.EnvVar().
+			WithName(name).
+			WithValueFrom(corev1ac.EnvVarSource().
+				WithSecretKeyRef(corev1ac.SecretKeySelector().
+					WithName(secretRef.Name).
+					WithKey(name).
+					WithOptional(true))))
+	}
+	return envs
+}
+
+// httpExtractEnvVars returns the env to extract the downloaded archive
+// when the storageUri refers to the tarball or zip archive over HTTP(S).
+func httpExtractEnvVars(storageUri *string) []corev1ac.EnvVarApplyConfiguration {
+	if storageUri == nil {
+		return nil
+	}
+	uri, err := url.Parse(*storageUri)
+	if err != nil || (uri.Scheme != "http" && uri.Scheme != "https") {
+		return nil
+	}
+	if !strings.HasSuffix(uri.Path, ".tar.gz") && !strings.HasSuffix(uri.Path, ".zip") {
+		return nil
+	}
+	return []corev1ac.EnvVarApplyConfiguration{
+		*corev1ac.EnvVar().
+			WithName(jobsetplgconsts.InitializerEnvExtract).
+			WithValue("true"),
+	}
+}
+
+// gcsCredentials projects the GCP service account key from the initializer secret into the initializer
+// container when the storageUri refers to GCS, and points GOOGLE_APPLICATION_CREDENTIALS at the key file.
+func gcsCredentials(podSpec *corev1ac.PodSpecApplyConfiguration, container *corev1ac.ContainerApplyConfiguration, storageUri *string, secretRef *corev1.LocalObjectReference) {
+	if storageUri == nil || secretRef == nil || !strings.HasPrefix(*storageUri, "gs://") {
+		return
+	}
+	apply.UpsertVolumes(&podSpec.Volumes, *corev1ac.Volume().
+		WithName(jobsetplgconsts.VolumeNameGCSCredentials).
+		WithSecret(corev1ac.SecretVolumeSource().
+			WithSecretName(secretRef.Name).
+			WithItems(corev1ac.KeyToPath().
+				WithKey(jobsetplgconsts.InitializerEnvServiceAccountKey).
+				WithPath(jobsetplgconsts.GCSCredentialsFileName))))
+	apply.UpsertVolumeMounts(&container.VolumeMounts, *corev1ac.VolumeMount().
+		WithName(jobsetplgconsts.VolumeNameGCSCredentials).
+		WithMountPath(jobsetplgconsts.GCSCredentialsMountPath).
+		WithReadOnly(true))
+	apply.UpsertEnvVars(&container.Env, *corev1ac.EnvVar().
+		WithName(jobsetplgconsts.InitializerEnvGoogleApplicationCredentials).
+		WithValue(path.Join(jobsetplgconsts.GCSCredentialsMountPath, jobsetplgconsts.GCSCredentialsFileName)))
+}
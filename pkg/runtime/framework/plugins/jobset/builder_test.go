@@ -17,15 +17,18 @@ limitations under the License.
 package jobset
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	batchv1ac "k8s.io/client-go/applyconfigurations/batch/v1"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
 	"k8s.io/utils/ptr"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
@@ -185,6 +188,306 @@ func TestBuilderInitializer(t *testing.T) {
 				},
 			},
 		},
+		"model initializer with checkpoint storageUri mounts the checkpoint sub path": {
+			jobSet: makeJobSet(constants.ModelInitializer, constants.ModelInitializer, 2, "initializer-job"),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Checkpoint: &trainer.CheckpointConfig{
+						StorageUri: ptr.To("s3://checkpoints/step-1000"),
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.ModelInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvCheckpointStorageUri),
+															Value: ptr.To("s3://checkpoints/step-1000"),
+														},
+													},
+													VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To(constants.CheckpointMountPath),
+															SubPath:   ptr.To(jobsetplgconsts.CheckpointSubPath),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.ModelInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("initializer-job"),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"model initializer with s3 storageUri and secretRef sources the AWS credentials from the secret": {
+			jobSet: makeJobSet(constants.ModelInitializer, constants.ModelInitializer, 1, "initializer-job"),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Model: &trainer.ModelInitializer{
+							StorageUri: ptr.To("s3://bucket/model"),
+							SecretRef: &corev1.LocalObjectReference{
+								Name: "s3-secret",
+							},
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.ModelInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
+															Value: ptr.To("s3://bucket/model"),
+														},
+														{
+															Name: ptr.To(jobsetplgconsts.InitializerEnvAWSAccessKeyID),
+															ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+																SecretKeyRef: &corev1ac.SecretKeySelectorApplyConfiguration{
+																	LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																		Name: ptr.To("s3-secret"),
+																	},
+																	Key:      ptr.To(jobsetplgconsts.InitializerEnvAccessKeyID),
+																	Optional: ptr.To(true),
+																},
+															},
+														},
+														{
+															Name: ptr.To(jobsetplgconsts.InitializerEnvAWSSecretAccessKey),
+															ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+																SecretKeyRef: &corev1ac.SecretKeySelectorApplyConfiguration{
+																	LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																		Name: ptr.To("s3-secret"),
+																	},
+																	Key:      ptr.To(jobsetplgconsts.InitializerEnvSecretAccessKey),
+																	Optional: ptr.To(true),
+																},
+															},
+														},
+													},
+													EnvFrom: []corev1ac.EnvFromSourceApplyConfiguration{
+														{
+															SecretRef: &corev1ac.SecretEnvSourceApplyConfiguration{
+																LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																	Name: ptr.To("s3-secret"),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.ModelInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("initializer-job"),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"dataset initializer with az storageUri and secretRef sources the Azure storage account from the secret": {
+			jobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, "initializer-job"),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							StorageUri: ptr.To("az://container/dataset"),
+							SecretRef: &corev1.LocalObjectReference{
+								Name: "azure-secret",
+							},
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
+															Value: ptr.To("az://container/dataset"),
+														},
+														{
+															Name: ptr.To(jobsetplgconsts.InitializerEnvAzureStorageAccountName),
+															ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+																SecretKeyRef: &corev1ac.SecretKeySelectorApplyConfiguration{
+																	LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																		Name: ptr.To("azure-secret"),
+																	},
+																	Key:      ptr.To(jobsetplgconsts.InitializerEnvAzureStorageAccountName),
+																	Optional: ptr.To(true),
+																},
+															},
+														},
+														{
+															Name: ptr.To(jobsetplgconsts.InitializerEnvAzureStorageAccountKey),
+															ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+																SecretKeyRef: &corev1ac.SecretKeySelectorApplyConfiguration{
+																	LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																		Name: ptr.To("azure-secret"),
+																	},
+																	Key:      ptr.To(jobsetplgconsts.InitializerEnvAzureStorageAccountKey),
+																	Optional: ptr.To(true),
+																},
+															},
+														},
+													},
+													EnvFrom: []corev1ac.EnvFromSourceApplyConfiguration{
+														{
+															SecretRef: &corev1ac.SecretEnvSourceApplyConfiguration{
+																LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																	Name: ptr.To("azure-secret"),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("initializer-job"),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"dataset initializer with gs storageUri and secretRef projects the GCP service account key from the secret": {
+			jobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, "initializer-job"),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							StorageUri: ptr.To("gs://bucket/dataset"),
+							SecretRef: &corev1.LocalObjectReference{
+								Name: "gcs-secret",
+							},
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
+															Value: ptr.To("gs://bucket/dataset"),
+														},
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvGoogleApplicationCredentials),
+															Value: ptr.To("/var/secrets/google/service-account.json"),
+														},
+													},
+													EnvFrom: []corev1ac.EnvFromSourceApplyConfiguration{
+														{
+															SecretRef: &corev1ac.SecretEnvSourceApplyConfiguration{
+																LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																	Name: ptr.To("gcs-secret"),
+																},
+															},
+														},
+													},
+													VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameGCSCredentials),
+															MountPath: ptr.To("/var/secrets/google"),
+															ReadOnly:  ptr.To(true),
+														},
+													},
+												},
+											},
+											Volumes: []corev1ac.VolumeApplyConfiguration{
+												{
+													Name: ptr.To(jobsetplgconsts.VolumeNameGCSCredentials),
+													VolumeSourceApplyConfiguration: corev1ac.VolumeSourceApplyConfiguration{
+														Secret: &corev1ac.SecretVolumeSourceApplyConfiguration{
+															SecretName: ptr.To("gcs-secret"),
+															Items: []corev1ac.KeyToPathApplyConfiguration{
+																{
+																	Key:  ptr.To(jobsetplgconsts.InitializerEnvServiceAccountKey),
+																	Path: ptr.To("service-account.json"),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("initializer-job"),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
 		"dataset initializer merges Dataset.Env with storageUri and upserts pre-existing container env": {
 			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
@@ -220,17 +523,1366 @@ func TestBuilderInitializer(t *testing.T) {
 			},
 			trainJob: &trainer.TrainJob{
 				Spec: trainer.TrainJobSpec{
-					Initializer: &trainer.Initializer{
-						Dataset: &trainer.DatasetInitializer{
-							StorageUri: ptr.To("hf://my-org/my-dataset"),
-							Env: []corev1.EnvVar{
-								{Name: "CUSTOM_FROM_USER", Value: "user-value"},
-								{Name: "PRE_EXISTING", Value: "overridden-by-trainjob"},
-							},
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							StorageUri: ptr.To("hf://my-org/my-dataset"),
+							Env: []corev1.EnvVar{
+								{Name: "CUSTOM_FROM_USER", Value: "user-value"},
+								{Name: "PRE_EXISTING", Value: "overridden-by-trainjob"},
+							},
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To("PRE_EXISTING"),
+															Value: ptr.To("overridden-by-trainjob"),
+														},
+														{
+															Name:  ptr.To("OTHER"),
+															Value: ptr.To("keep-me"),
+														},
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
+															Value: ptr.To("hf://my-org/my-dataset"),
+														},
+														{
+															Name:  ptr.To("CUSTOM_FROM_USER"),
+															Value: ptr.To("user-value"),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("initializer-job"),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"dataset initializer without secretRef only injects storageUri": {
+			jobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 2, "initializer-job"),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							StorageUri: ptr.To("s3://bucket/dataset"),
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
+															Value: ptr.To("s3://bucket/dataset"),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("initializer-job"),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"dataset initializer with https tarball storageUri sets the extract env": {
+			jobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 2, "initializer-job"),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							StorageUri: ptr.To("https://example.com/datasets/alpaca.tar.gz"),
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
+															Value: ptr.To("https://example.com/datasets/alpaca.tar.gz"),
+														},
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvExtract),
+															Value: ptr.To("true"),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("initializer-job"),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"dataset initializer ancestor with nil Initializer spec sets replicas to 1": {
+			jobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 3, "initializer-job"),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: nil,
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("initializer-job"),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"no ancestor label skips modification": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												*corev1ac.Container().WithName("some-container"),
+											},
+										},
+									},
+								},
+							},
+							Name:     ptr.To("worker"),
+							Replicas: ptr.To[int32](4),
+						},
+					},
+				},
+			},
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							StorageUri: ptr.To("hf://dataset"),
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To("some-container"),
+												},
+											},
+										},
+									},
+								},
+							},
+							Name:     ptr.To("worker"),
+							Replicas: ptr.To[int32](4),
+						},
+					},
+				},
+			},
+		},
+		"trainer depends on the initializers Ready status": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name: ptr.To(constants.Node),
+							DependsOn: []jobsetv1alpha2ac.DependsOnApplyConfiguration{
+								{
+									Name:   ptr.To(constants.DatasetInitializer),
+									Status: ptr.To(jobsetv1alpha2.DependencyComplete),
+								},
+							},
+						},
+					},
+				},
+			},
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						TrainerDependency: ptr.To(trainer.InitializerDependencyReady),
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name: ptr.To(constants.Node),
+							DependsOn: []jobsetv1alpha2ac.DependsOnApplyConfiguration{
+								{
+									Name:   ptr.To(constants.DatasetInitializer),
+									Status: ptr.To(jobsetv1alpha2.DependencyReady),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"trainer depends on the completion of the preceding initializers": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name: ptr.To(constants.DatasetInitializer),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.ModelInitializer,
+									},
+								},
+							},
+							Name: ptr.To(constants.ModelInitializer),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name: ptr.To(constants.Node),
+						},
+					},
+				},
+			},
+			trainJob: &trainer.TrainJob{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.ModelInitializer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.ModelInitializer),
+							Replicas: ptr.To[int32](1),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name: ptr.To(constants.Node),
+							DependsOn: []jobsetv1alpha2ac.DependsOnApplyConfiguration{
+								{
+									Name:   ptr.To(constants.DatasetInitializer),
+									Status: ptr.To(jobsetv1alpha2.DependencyComplete),
+								},
+								{
+									Name:   ptr.To(constants.ModelInitializer),
+									Status: ptr.To(jobsetv1alpha2.DependencyComplete),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"multiple datasets are initialized by the separate Jobs and mounted in the trainer": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+													VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To(constants.DatasetMountPath),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To(constants.DatasetMountPath),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name: ptr.To(constants.Node),
+							DependsOn: []jobsetv1alpha2ac.DependsOnApplyConfiguration{
+								{
+									Name:   ptr.To(constants.DatasetInitializer),
+									Status: ptr.To(jobsetv1alpha2.DependencyComplete),
+								},
+							},
+						},
+					},
+				},
+			},
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							Datasets: []trainer.DatasetSource{
+								{StorageUri: "hf://my-org/train", MountPath: "/data/train"},
+								{StorageUri: "hf://my-org/eval", MountPath: "/data/eval"},
+							},
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
+															Value: ptr.To("hf://my-org/train"),
+														},
+													},
+													VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To(constants.DatasetMountPath),
+															SubPath:   ptr.To("dataset-0"),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("dataset-initializer-0"),
+							Replicas: ptr.To[int32](1),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.DatasetInitializer),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
+															Value: ptr.To("hf://my-org/eval"),
+														},
+													},
+													VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To(constants.DatasetMountPath),
+															SubPath:   ptr.To("dataset-1"),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+									},
+								},
+							},
+							Name:     ptr.To("dataset-initializer-1"),
+							Replicas: ptr.To[int32](1),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To(constants.DatasetMountPath),
+														},
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To("/data/train"),
+															SubPath:   ptr.To("dataset-0"),
+														},
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To("/data/eval"),
+															SubPath:   ptr.To("dataset-1"),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name: ptr.To(constants.Node),
+							DependsOn: []jobsetv1alpha2ac.DependsOnApplyConfiguration{
+								{
+									Name:   ptr.To("dataset-initializer-0"),
+									Status: ptr.To(jobsetv1alpha2.DependencyComplete),
+								},
+								{
+									Name:   ptr.To("dataset-initializer-1"),
+									Status: ptr.To(jobsetv1alpha2.DependencyComplete),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			builder, err := NewBuilder(tc.jobSet).Initializer(tc.trainJob)
+			if err != nil {
+				t.Fatalf("Failed to build the initializer: %v", err)
+			}
+			got := builder.Build()
+			if diff := cmp.Diff(tc.wantJobSet, got); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet from Initializer (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilderTrainer(t *testing.T) {
+	cases := map[string]struct {
+		jobSet     *jobsetv1alpha2ac.JobSetApplyConfiguration
+		trainJob   *trainer.TrainJob
+		info       *runtime.Info
+		wantJobSet *jobsetv1alpha2ac.JobSetApplyConfiguration
+	}{
+		"trainer ancestor with image command and args": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 4, constants.Node),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						Image:   ptr.To("docker.io/my-org/train:latest"),
+						Command: []string{"torchrun", "--nproc_per_node=4"},
+						Args:    []string{"train.py", "--epochs=10"},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name:    ptr.To(constants.Node),
+													Image:   ptr.To("docker.io/my-org/train:latest"),
+													Command: []string{"torchrun", "--nproc_per_node=4"},
+													Args:    []string{"train.py", "--epochs=10"},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor merges Trainer.Env and upserts duplicate container env keys": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														*corev1ac.EnvVar().WithName("FROM_TEMPLATE").WithValue("template-value"),
+														*corev1ac.EnvVar().WithName("OVERRIDE_ME").WithValue("old-value"),
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](4),
+						},
+					},
+				},
+			},
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						Env: []corev1.EnvVar{
+							{Name: "OVERRIDE_ME", Value: "new-value"},
+							{Name: "FROM_TRAINJOB", Value: "extra"},
+						},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To("FROM_TEMPLATE"),
+															Value: ptr.To("template-value"),
+														},
+														{
+															Name:  ptr.To("OVERRIDE_ME"),
+															Value: ptr.To("new-value"),
+														},
+														{
+															Name:  ptr.To("FROM_TRAINJOB"),
+															Value: ptr.To("extra"),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor passes through Trainer.Env valueFrom and appends Trainer.EnvFrom": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													EnvFrom: []corev1ac.EnvFromSourceApplyConfiguration{
+														*corev1ac.EnvFromSource().WithConfigMapRef(corev1ac.ConfigMapEnvSource().WithName("runtime-config")),
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						Env: []corev1.EnvVar{
+							{
+								Name: "HF_TOKEN",
+								ValueFrom: &corev1.EnvVarSource{
+									SecretKeyRef: &corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "hf-secret"},
+										Key:                  "token",
+									},
+								},
+							},
+							{
+								Name: "LEARNING_RATE",
+								ValueFrom: &corev1.EnvVarSource{
+									ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "hyperparameters"},
+										Key:                  "lr",
+										Optional:             ptr.To(true),
+									},
+								},
+							},
+						},
+						EnvFrom: []corev1.EnvFromSource{
+							{
+								SecretRef: &corev1.SecretEnvSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "wandb-secret"},
+								},
+							},
+						},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name: ptr.To("HF_TOKEN"),
+															ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+																SecretKeyRef: &corev1ac.SecretKeySelectorApplyConfiguration{
+																	LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																		Name: ptr.To("hf-secret"),
+																	},
+																	Key: ptr.To("token"),
+																},
+															},
+														},
+														{
+															Name: ptr.To("LEARNING_RATE"),
+															ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+																ConfigMapKeyRef: &corev1ac.ConfigMapKeySelectorApplyConfiguration{
+																	LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																		Name: ptr.To("hyperparameters"),
+																	},
+																	Key:      ptr.To("lr"),
+																	Optional: ptr.To(true),
+																},
+															},
+														},
+													},
+													EnvFrom: []corev1ac.EnvFromSourceApplyConfiguration{
+														{
+															ConfigMapRef: &corev1ac.ConfigMapEnvSourceApplyConfiguration{
+																LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																	Name: ptr.To("runtime-config"),
+																},
+															},
+														},
+														{
+															SecretRef: &corev1ac.SecretEnvSourceApplyConfiguration{
+																LocalObjectReferenceApplyConfiguration: corev1ac.LocalObjectReferenceApplyConfiguration{
+																	Name: ptr.To("wandb-secret"),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor with resourcesPerNode leaves merge to trainingruntime": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 2, constants.Node),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						ResourcesPerNode: &corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								"nvidia.com/gpu":      resource.MustParse("2"),
+								corev1.ResourceMemory: resource.MustParse("16Gi"),
+							},
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("4"),
+								corev1.ResourceMemory: resource.MustParse("8Gi"),
+							},
+						},
+					},
+				},
+			},
+			info:       &runtime.Info{},
+			wantJobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+		},
+		"trainer ancestor with nil Trainer spec sets replicas to 1": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 5, constants.Node),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: nil,
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor with MPI runLauncherAsNode composes trainer fields without duplication": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														*corev1ac.EnvVar().WithName("FROM_MPI_TEMPLATE").WithValue("mpi-template"),
+														*corev1ac.EnvVar().WithName("CLASH_KEY").WithValue("from-template"),
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](3),
+						},
+					},
+				},
+			},
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						Image:   ptr.To("registry.example/train:combined"),
+						Command: []string{"mpirun"},
+						Env: []corev1.EnvVar{
+							{Name: "CLASH_KEY", Value: "from-trainer"},
+							{Name: "EXTRA_MPI_ENV", Value: "present-once"},
+						},
+						ResourcesPerNode: &corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: resource.MustParse("48Gi"),
+							},
+						},
+					},
+				},
+			},
+			info: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: &trainer.MLPolicySource{
+						MPI: &trainer.MPIMLPolicySource{
+							RunLauncherAsNode: ptr.To(true),
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name:    ptr.To(constants.Node),
+													Image:   ptr.To("registry.example/train:combined"),
+													Command: []string{"mpirun"},
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To("FROM_MPI_TEMPLATE"),
+															Value: ptr.To("mpi-template"),
+														},
+														{
+															Name:  ptr.To("CLASH_KEY"),
+															Value: ptr.To("from-trainer"),
+														},
+														{
+															Name:  ptr.To("EXTRA_MPI_ENV"),
+															Value: ptr.To("present-once"),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor with addCapabilities preserves runtime capabilities": {
+			jobSet: func() *jobsetv1alpha2ac.JobSetApplyConfiguration {
+				js := makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node)
+				js.Spec.ReplicatedJobs[0].Template.Spec.Template.Spec.Containers[0].
+					WithSecurityContext(corev1ac.SecurityContext().
+						WithCapabilities(corev1ac.Capabilities().WithAdd("IPC_LOCK")))
+				return js
+			}(),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						AddCapabilities: []corev1.Capability{"IPC_LOCK", "SYS_RESOURCE"},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													SecurityContext: &corev1ac.SecurityContextApplyConfiguration{
+														Capabilities: &corev1ac.CapabilitiesApplyConfiguration{
+															Add: []corev1.Capability{"IPC_LOCK", "SYS_RESOURCE"},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor with addCapabilities adds capabilities to the trainer container": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						AddCapabilities: []corev1.Capability{"IPC_LOCK"},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													SecurityContext: &corev1ac.SecurityContextApplyConfiguration{
+														Capabilities: &corev1ac.CapabilitiesApplyConfiguration{
+															Add: []corev1.Capability{"IPC_LOCK"},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor with heartbeat configures the liveness probe on the trainer container": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						Heartbeat: &trainer.Heartbeat{
+							Path:           "/tmp/heartbeat",
+							TimeoutSeconds: ptr.To[int32](120),
+						},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.EnvHeartbeatFile),
+															Value: ptr.To("/tmp/heartbeat"),
+														},
+													},
+													LivenessProbe: &corev1ac.ProbeApplyConfiguration{
+														ProbeHandlerApplyConfiguration: corev1ac.ProbeHandlerApplyConfiguration{
+															Exec: &corev1ac.ExecActionApplyConfiguration{
+																Command: []string{
+																	"/bin/sh",
+																	"-c",
+																	`test $(( $(date +%s) - $(stat -c %Y "$1") )) -lt 120`,
+																	"heartbeat",
+																	"/tmp/heartbeat",
+																},
+															},
+														},
+														InitialDelaySeconds: ptr.To[int32](120),
+														PeriodSeconds:       ptr.To[int32](30),
+														FailureThreshold:    ptr.To[int32](1),
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor with additional ports injects the named ports next to the trainer port": {
+			jobSet: func() *jobsetv1alpha2ac.JobSetApplyConfiguration {
+				jobSet := makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node)
+				jobSet.Spec.ReplicatedJobs[0].Template.Spec.Template.Spec.Containers[0].Ports = []corev1ac.ContainerPortApplyConfiguration{
+					*corev1ac.ContainerPort().WithName("master").WithContainerPort(29500),
+				}
+				return jobSet
+			}(),
+			trainJob: &trainer.TrainJob{},
+			info: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					TrainerPortName: ptr.To("master"),
+					AdditionalPorts: []trainer.NamedPort{
+						{Name: "metrics", ContainerPort: 9090},
+						{Name: "debug", ContainerPort: 5678},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+													Ports: []corev1ac.ContainerPortApplyConfiguration{
+														*corev1ac.ContainerPort().WithName("master").WithContainerPort(29500),
+														*corev1ac.ContainerPort().WithName("metrics").WithContainerPort(9090),
+														*corev1ac.ContainerPort().WithName("debug").WithContainerPort(5678),
+													},
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor with gpuTopology sets the required topology annotation on the trainer pods": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 2, constants.Node),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						GPUTopology: &trainer.GPUTopology{},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+											Annotations: map[string]string{
+												constants.AnnotationPodSetRequiredTopology: constants.DefaultGPUTopologyLevel,
+											},
+										},
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												{
+													Name: ptr.To(constants.Node),
+												},
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+		},
+		"trainer ancestor with preferred gpuTopology sets the preferred topology annotation on the trainer pods": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 2, constants.Node),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						GPUTopology: &trainer.GPUTopology{
+							Level: ptr.To("cloud.provider.com/topology-block"),
+							Mode:  ptr.To(trainer.GPUTopologyModePreferred),
 						},
 					},
 				},
 			},
+			info: &runtime.Info{},
 			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
 					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
@@ -238,28 +1890,15 @@ func TestBuilderInitializer(t *testing.T) {
 							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
 									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+											Annotations: map[string]string{
+												constants.AnnotationPodSetPreferredTopology: "cloud.provider.com/topology-block",
+											},
+										},
 										Spec: &corev1ac.PodSpecApplyConfiguration{
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
-													Name: ptr.To(constants.DatasetInitializer),
-													Env: []corev1ac.EnvVarApplyConfiguration{
-														{
-															Name:  ptr.To("PRE_EXISTING"),
-															Value: ptr.To("overridden-by-trainjob"),
-														},
-														{
-															Name:  ptr.To("OTHER"),
-															Value: ptr.To("keep-me"),
-														},
-														{
-															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
-															Value: ptr.To("hf://my-org/my-dataset"),
-														},
-														{
-															Name:  ptr.To("CUSTOM_FROM_USER"),
-															Value: ptr.To("user-value"),
-														},
-													},
+													Name: ptr.To(constants.Node),
 												},
 											},
 										},
@@ -267,29 +1906,19 @@ func TestBuilderInitializer(t *testing.T) {
 								},
 								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
 									Labels: map[string]string{
-										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
 									},
 								},
 							},
-							Name:     ptr.To("initializer-job"),
+							Name:     ptr.To(constants.Node),
 							Replicas: ptr.To[int32](1),
 						},
 					},
 				},
 			},
 		},
-		"dataset initializer without secretRef only injects storageUri": {
-			jobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 2, "initializer-job"),
-			trainJob: &trainer.TrainJob{
-				Spec: trainer.TrainJobSpec{
-					Initializer: &trainer.Initializer{
-						Dataset: &trainer.DatasetInitializer{
-							StorageUri: ptr.To("s3://bucket/dataset"),
-						},
-					},
-				},
-			},
-			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+		"trainer ancestor with nodeSelector and tolerations merged with the runtime values": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
 					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
 						{
@@ -297,40 +1926,43 @@ func TestBuilderInitializer(t *testing.T) {
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
 									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
 										Spec: &corev1ac.PodSpecApplyConfiguration{
+											NodeSelector: map[string]string{
+												"node-pool":   "runtime",
+												"accelerator": "nvidia-a100",
+											},
+											Tolerations: []corev1ac.TolerationApplyConfiguration{
+												*corev1ac.Toleration().WithKey("nvidia.com/gpu").WithOperator(corev1.TolerationOpExists),
+												*corev1ac.Toleration().WithKey("node-pool").WithValue("runtime"),
+											},
 											Containers: []corev1ac.ContainerApplyConfiguration{
-												{
-													Name: ptr.To(constants.DatasetInitializer),
-													Env: []corev1ac.EnvVarApplyConfiguration{
-														{
-															Name:  ptr.To(jobsetplgconsts.InitializerEnvStorageUri),
-															Value: ptr.To("s3://bucket/dataset"),
-														},
-													},
-												},
+												*corev1ac.Container().WithName(constants.Node),
 											},
 										},
 									},
 								},
 								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
 									Labels: map[string]string{
-										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
 									},
 								},
 							},
-							Name:     ptr.To("initializer-job"),
+							Name:     ptr.To(constants.Node),
 							Replicas: ptr.To[int32](1),
 						},
 					},
 				},
 			},
-		},
-		"dataset initializer ancestor with nil Initializer spec sets replicas to 1": {
-			jobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 3, "initializer-job"),
 			trainJob: &trainer.TrainJob{
 				Spec: trainer.TrainJobSpec{
-					Initializer: nil,
+					Trainer: &trainer.Trainer{
+						NodeSelector: map[string]string{"node-pool": "h100"},
+						Tolerations: []corev1.Toleration{
+							{Key: "node-pool", Value: "h100", Effect: corev1.TaintEffectNoSchedule},
+						},
+					},
 				},
 			},
+			info: &runtime.Info{},
 			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
 					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
@@ -339,9 +1971,17 @@ func TestBuilderInitializer(t *testing.T) {
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
 									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
 										Spec: &corev1ac.PodSpecApplyConfiguration{
+											NodeSelector: map[string]string{
+												"node-pool":   "h100",
+												"accelerator": "nvidia-a100",
+											},
+											Tolerations: []corev1ac.TolerationApplyConfiguration{
+												*corev1ac.Toleration().WithKey("nvidia.com/gpu").WithOperator(corev1.TolerationOpExists),
+												*corev1ac.Toleration().WithKey("node-pool").WithValue("h100").WithEffect(corev1.TaintEffectNoSchedule),
+											},
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
-													Name: ptr.To(constants.DatasetInitializer),
+													Name: ptr.To(constants.Node),
 												},
 											},
 										},
@@ -349,18 +1989,18 @@ func TestBuilderInitializer(t *testing.T) {
 								},
 								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
 									Labels: map[string]string{
-										constants.LabelTrainJobAncestor: constants.DatasetInitializer,
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
 									},
 								},
 							},
-							Name:     ptr.To("initializer-job"),
+							Name:     ptr.To(constants.Node),
 							Replicas: ptr.To[int32](1),
 						},
 					},
 				},
 			},
 		},
-		"no ancestor label skips modification": {
+		"trainer ancestor with imagePullSecrets merged with the runtime values without duplicates": {
 			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
 					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
@@ -369,28 +2009,38 @@ func TestBuilderInitializer(t *testing.T) {
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
 									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
 										Spec: &corev1ac.PodSpecApplyConfiguration{
+											ImagePullSecrets: []corev1ac.LocalObjectReferenceApplyConfiguration{
+												*corev1ac.LocalObjectReference().WithName("runtime-registry"),
+											},
 											Containers: []corev1ac.ContainerApplyConfiguration{
-												*corev1ac.Container().WithName("some-container"),
+												*corev1ac.Container().WithName(constants.Node),
 											},
 										},
 									},
 								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
 							},
-							Name:     ptr.To("worker"),
-							Replicas: ptr.To[int32](4),
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
 						},
 					},
 				},
 			},
 			trainJob: &trainer.TrainJob{
 				Spec: trainer.TrainJobSpec{
-					Initializer: &trainer.Initializer{
-						Dataset: &trainer.DatasetInitializer{
-							StorageUri: ptr.To("hf://dataset"),
+					Trainer: &trainer.Trainer{
+						ImagePullSecrets: []corev1.LocalObjectReference{
+							{Name: "runtime-registry"},
+							{Name: "private-registry"},
 						},
 					},
 				},
 			},
+			info: &runtime.Info{},
 			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
 					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
@@ -399,49 +2049,71 @@ func TestBuilderInitializer(t *testing.T) {
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
 									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
 										Spec: &corev1ac.PodSpecApplyConfiguration{
+											ImagePullSecrets: []corev1ac.LocalObjectReferenceApplyConfiguration{
+												*corev1ac.LocalObjectReference().WithName("runtime-registry"),
+												*corev1ac.LocalObjectReference().WithName("private-registry"),
+											},
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
-													Name: ptr.To("some-container"),
+													Name: ptr.To(constants.Node),
 												},
 											},
 										},
 									},
 								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
 							},
-							Name:     ptr.To("worker"),
-							Replicas: ptr.To[int32](4),
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
 						},
 					},
 				},
 			},
 		},
-	}
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			builder := NewBuilder(tc.jobSet)
-			got := builder.Initializer(tc.trainJob).Build()
-			if diff := cmp.Diff(tc.wantJobSet, got); len(diff) != 0 {
-				t.Errorf("Unexpected JobSet from Initializer (-want,+got):\n%s", diff)
-			}
-		})
-	}
-}
-
-func TestBuilderTrainer(t *testing.T) {
-	cases := map[string]struct {
-		jobSet     *jobsetv1alpha2ac.JobSetApplyConfiguration
-		trainJob   *trainer.TrainJob
-		info       *runtime.Info
-		wantJobSet *jobsetv1alpha2ac.JobSetApplyConfiguration
-	}{
-		"trainer ancestor with image command and args": {
-			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 4, constants.Node),
+		"trainer ancestor with gpuProduct adds the node affinity to every runtime node selector term": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Affinity: corev1ac.Affinity().
+												WithNodeAffinity(corev1ac.NodeAffinity().
+													WithRequiredDuringSchedulingIgnoredDuringExecution(corev1ac.NodeSelector().
+														WithNodeSelectorTerms(
+															corev1ac.NodeSelectorTerm().WithMatchExpressions(corev1ac.NodeSelectorRequirement().
+																WithKey("node-pool").WithOperator(corev1.NodeSelectorOpIn).WithValues("a")),
+															corev1ac.NodeSelectorTerm().WithMatchExpressions(corev1ac.NodeSelectorRequirement().
+																WithKey("node-pool").WithOperator(corev1.NodeSelectorOpIn).WithValues("b")),
+														))),
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												*corev1ac.Container().WithName(constants.Node),
+											},
+										},
+									},
+								},
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+								},
+							},
+							Name:     ptr.To(constants.Node),
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
 			trainJob: &trainer.TrainJob{
 				Spec: trainer.TrainJobSpec{
 					Trainer: &trainer.Trainer{
-						Image:   ptr.To("docker.io/my-org/train:latest"),
-						Command: []string{"torchrun", "--nproc_per_node=4"},
-						Args:    []string{"train.py", "--epochs=10"},
+						GPUProduct: ptr.To("NVIDIA-H100-80GB-HBM3"),
 					},
 				},
 			},
@@ -454,12 +2126,26 @@ func TestBuilderTrainer(t *testing.T) {
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
 									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
 										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Affinity: corev1ac.Affinity().
+												WithNodeAffinity(corev1ac.NodeAffinity().
+													WithRequiredDuringSchedulingIgnoredDuringExecution(corev1ac.NodeSelector().
+														WithNodeSelectorTerms(
+															corev1ac.NodeSelectorTerm().WithMatchExpressions(
+																corev1ac.NodeSelectorRequirement().
+																	WithKey("node-pool").WithOperator(corev1.NodeSelectorOpIn).WithValues("a"),
+																corev1ac.NodeSelectorRequirement().
+																	WithKey(constants.GPUProductLabel).WithOperator(corev1.NodeSelectorOpIn).WithValues("NVIDIA-H100-80GB-HBM3"),
+															),
+															corev1ac.NodeSelectorTerm().WithMatchExpressions(
+																corev1ac.NodeSelectorRequirement().
+																	WithKey("node-pool").WithOperator(corev1.NodeSelectorOpIn).WithValues("b"),
+																corev1ac.NodeSelectorRequirement().
+																	WithKey(constants.GPUProductLabel).WithOperator(corev1.NodeSelectorOpIn).WithValues("NVIDIA-H100-80GB-HBM3"),
+															),
+														))),
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
-													Name:    ptr.To(constants.Node),
-													Image:   ptr.To("docker.io/my-org/train:latest"),
-													Command: []string{"torchrun", "--nproc_per_node=4"},
-													Args:    []string{"train.py", "--epochs=10"},
+													Name: ptr.To(constants.Node),
 												},
 											},
 										},
@@ -478,8 +2164,26 @@ func TestBuilderTrainer(t *testing.T) {
 				},
 			},
 		},
-		"trainer ancestor merges Trainer.Env and upserts duplicate container env keys": {
-			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+		"trainer ancestor with spot requires the spot node labels and tolerates the spot taints": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{
+						Spot: &trainer.Spot{
+							NodeLabels: map[string]string{
+								"cloud.google.com/gke-spot": "true",
+								"node-lifecycle":            "spot",
+							},
+							Tolerations: []corev1.Toleration{
+								{Key: "cloud.google.com/gke-spot", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+							},
+							FallbackAfterSchedulingFailures: ptr.To[int32](2),
+						},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
 					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
 						{
@@ -487,13 +2191,23 @@ func TestBuilderTrainer(t *testing.T) {
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
 									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
 										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Tolerations: []corev1ac.TolerationApplyConfiguration{
+												*corev1ac.Toleration().WithKey("cloud.google.com/gke-spot").WithOperator(corev1.TolerationOpExists).WithEffect(corev1.TaintEffectNoSchedule),
+											},
+											Affinity: corev1ac.Affinity().
+												WithNodeAffinity(corev1ac.NodeAffinity().
+													WithRequiredDuringSchedulingIgnoredDuringExecution(corev1ac.NodeSelector().
+														WithNodeSelectorTerms(
+															corev1ac.NodeSelectorTerm().WithMatchExpressions(
+																corev1ac.NodeSelectorRequirement().
+																	WithKey("cloud.google.com/gke-spot").WithOperator(corev1.NodeSelectorOpIn).WithValues("true"),
+																corev1ac.NodeSelectorRequirement().
+																	WithKey("node-lifecycle").WithOperator(corev1.NodeSelectorOpIn).WithValues("spot"),
+															),
+														))),
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
 													Name: ptr.To(constants.Node),
-													Env: []corev1ac.EnvVarApplyConfiguration{
-														*corev1ac.EnvVar().WithName("FROM_TEMPLATE").WithValue("template-value"),
-														*corev1ac.EnvVar().WithName("OVERRIDE_ME").WithValue("old-value"),
-													},
 												},
 											},
 										},
@@ -506,20 +2220,36 @@ func TestBuilderTrainer(t *testing.T) {
 								},
 							},
 							Name:     ptr.To(constants.Node),
-							Replicas: ptr.To[int32](4),
+							Replicas: ptr.To[int32](1),
 						},
 					},
 				},
 			},
+		},
+		"trainer ancestor with spot fallback excludes the spot nodes": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
 			trainJob: &trainer.TrainJob{
 				Spec: trainer.TrainJobSpec{
 					Trainer: &trainer.Trainer{
-						Env: []corev1.EnvVar{
-							{Name: "OVERRIDE_ME", Value: "new-value"},
-							{Name: "FROM_TRAINJOB", Value: "extra"},
+						Spot: &trainer.Spot{
+							NodeLabels: map[string]string{
+								"cloud.google.com/gke-spot": "true",
+								"node-lifecycle":            "spot",
+							},
+							Tolerations: []corev1.Toleration{
+								{Key: "cloud.google.com/gke-spot", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+							},
+							FallbackAfterSchedulingFailures: ptr.To[int32](2),
 						},
 					},
 				},
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainJobSpotFallback,
+						Status: metav1.ConditionTrue,
+						Reason: trainer.TrainJobSpotSchedulingFailedReason,
+					}},
+				},
 			},
 			info: &runtime.Info{},
 			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
@@ -530,23 +2260,20 @@ func TestBuilderTrainer(t *testing.T) {
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
 									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
 										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Affinity: corev1ac.Affinity().
+												WithNodeAffinity(corev1ac.NodeAffinity().
+													WithRequiredDuringSchedulingIgnoredDuringExecution(corev1ac.NodeSelector().
+														WithNodeSelectorTerms(
+															corev1ac.NodeSelectorTerm().WithMatchExpressions(
+																corev1ac.NodeSelectorRequirement().
+																	WithKey("cloud.google.com/gke-spot").WithOperator(corev1.NodeSelectorOpNotIn).WithValues("true"),
+																corev1ac.NodeSelectorRequirement().
+																	WithKey("node-lifecycle").WithOperator(corev1.NodeSelectorOpNotIn).WithValues("spot"),
+															),
+														))),
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
 													Name: ptr.To(constants.Node),
-													Env: []corev1ac.EnvVarApplyConfiguration{
-														{
-															Name:  ptr.To("FROM_TEMPLATE"),
-															Value: ptr.To("template-value"),
-														},
-														{
-															Name:  ptr.To("OVERRIDE_ME"),
-															Value: ptr.To("new-value"),
-														},
-														{
-															Name:  ptr.To("FROM_TRAINJOB"),
-															Value: ptr.To("extra"),
-														},
-													},
 												},
 											},
 										},
@@ -565,34 +2292,15 @@ func TestBuilderTrainer(t *testing.T) {
 				},
 			},
 		},
-		"trainer ancestor with resourcesPerNode leaves merge to trainingruntime": {
+		"trainer ancestor with checkpoint sets the checkpoint path env and mounts the checkpoint": {
 			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 2, constants.Node),
 			trainJob: &trainer.TrainJob{
 				Spec: trainer.TrainJobSpec{
-					Trainer: &trainer.Trainer{
-						ResourcesPerNode: &corev1.ResourceRequirements{
-							Limits: corev1.ResourceList{
-								"nvidia.com/gpu":      resource.MustParse("2"),
-								corev1.ResourceMemory: resource.MustParse("16Gi"),
-							},
-							Requests: corev1.ResourceList{
-								corev1.ResourceCPU:    resource.MustParse("4"),
-								corev1.ResourceMemory: resource.MustParse("8Gi"),
-							},
-						},
+					Checkpoint: &trainer.CheckpointConfig{
+						StorageUri: ptr.To("s3://checkpoints/step-1000"),
 					},
 				},
 			},
-			info:       &runtime.Info{},
-			wantJobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
-		},
-		"trainer ancestor with nil Trainer spec sets replicas to 1": {
-			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 5, constants.Node),
-			trainJob: &trainer.TrainJob{
-				Spec: trainer.TrainJobSpec{
-					Trainer: nil,
-				},
-			},
 			info: &runtime.Info{},
 			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
@@ -605,6 +2313,19 @@ func TestBuilderTrainer(t *testing.T) {
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
 													Name: ptr.To(constants.Node),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.EnvCheckpointPath),
+															Value: ptr.To(constants.CheckpointMountPath),
+														},
+													},
+													VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+														{
+															Name:      ptr.To(jobsetplgconsts.VolumeNameInitializer),
+															MountPath: ptr.To(constants.CheckpointMountPath),
+															SubPath:   ptr.To(jobsetplgconsts.CheckpointSubPath),
+														},
+													},
 												},
 											},
 										},
@@ -623,10 +2344,32 @@ func TestBuilderTrainer(t *testing.T) {
 				},
 			},
 		},
-		"trainer ancestor with MPI runLauncherAsNode composes trainer fields without duplication": {
+		"trainer ancestor with the dataset initializer sets the dataset metadata path env": {
 			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: append(
+						makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer).Spec.ReplicatedJobs,
+						makeJobSet(constants.AncestorTrainer, constants.Node, 2, constants.Node).Spec.ReplicatedJobs...,
+					),
+				},
+			},
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							StorageUri: ptr.To("hf://dataset"),
+						},
+						Model: &trainer.ModelInitializer{
+							StorageUri: ptr.To("hf://model"),
+						},
+					},
+				},
+			},
+			info: &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
 					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer).Spec.ReplicatedJobs[0],
 						{
 							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
@@ -635,9 +2378,11 @@ func TestBuilderTrainer(t *testing.T) {
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
 													Name: ptr.To(constants.Node),
-													Env: []corev1ac.EnvVarApplyConfiguration{
-														*corev1ac.EnvVar().WithName("FROM_MPI_TEMPLATE").WithValue("mpi-template"),
-														*corev1ac.EnvVar().WithName("CLASH_KEY").WithValue("from-template"),
+													Env: []corev1ac.EnvVarApplyConfiguration{
+														{
+															Name:  ptr.To(jobsetplgconsts.EnvDatasetMetadataPath),
+															Value: ptr.To("/workspace/dataset/.metadata.json"),
+														},
 													},
 												},
 											},
@@ -651,40 +2396,40 @@ func TestBuilderTrainer(t *testing.T) {
 								},
 							},
 							Name:     ptr.To(constants.Node),
-							Replicas: ptr.To[int32](3),
+							Replicas: ptr.To[int32](1),
 						},
 					},
 				},
 			},
+		},
+		"trainer ancestor with the multiple datasets sets the metadata paths env of every dataset": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: slices.Concat(
+						makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, "dataset-initializer-0").Spec.ReplicatedJobs,
+						makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, "dataset-initializer-1").Spec.ReplicatedJobs,
+						makeJobSet(constants.AncestorTrainer, constants.Node, 2, constants.Node).Spec.ReplicatedJobs,
+					),
+				},
+			},
 			trainJob: &trainer.TrainJob{
 				Spec: trainer.TrainJobSpec{
-					Trainer: &trainer.Trainer{
-						Image:   ptr.To("registry.example/train:combined"),
-						Command: []string{"mpirun"},
-						Env: []corev1.EnvVar{
-							{Name: "CLASH_KEY", Value: "from-trainer"},
-							{Name: "EXTRA_MPI_ENV", Value: "present-once"},
-						},
-						ResourcesPerNode: &corev1.ResourceRequirements{
-							Limits: corev1.ResourceList{
-								corev1.ResourceMemory: resource.MustParse("48Gi"),
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							Datasets: []trainer.DatasetSource{
+								{StorageUri: "hf://train", MountPath: "/workspace/train"},
+								{StorageUri: "s3://bucket/eval", MountPath: "/workspace/eval"},
 							},
 						},
 					},
 				},
 			},
-			info: &runtime.Info{
-				RuntimePolicy: runtime.RuntimePolicy{
-					MLPolicySource: &trainer.MLPolicySource{
-						MPI: &trainer.MPIMLPolicySource{
-							RunLauncherAsNode: ptr.To(true),
-						},
-					},
-				},
-			},
+			info: &runtime.Info{},
 			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
 				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
 					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, "dataset-initializer-0").Spec.ReplicatedJobs[0],
+						makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, "dataset-initializer-1").Spec.ReplicatedJobs[0],
 						{
 							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
 								Spec: &batchv1ac.JobSpecApplyConfiguration{
@@ -692,21 +2437,11 @@ func TestBuilderTrainer(t *testing.T) {
 										Spec: &corev1ac.PodSpecApplyConfiguration{
 											Containers: []corev1ac.ContainerApplyConfiguration{
 												{
-													Name:    ptr.To(constants.Node),
-													Image:   ptr.To("registry.example/train:combined"),
-													Command: []string{"mpirun"},
+													Name: ptr.To(constants.Node),
 													Env: []corev1ac.EnvVarApplyConfiguration{
 														{
-															Name:  ptr.To("FROM_MPI_TEMPLATE"),
-															Value: ptr.To("mpi-template"),
-														},
-														{
-															Name:  ptr.To("CLASH_KEY"),
-															Value: ptr.To("from-trainer"),
-														},
-														{
-															Name:  ptr.To("EXTRA_MPI_ENV"),
-															Value: ptr.To("present-once"),
+															Name:  ptr.To(jobsetplgconsts.EnvDatasetMetadataPath),
+															Value: ptr.To("/workspace/train/.metadata.json,/workspace/eval/.metadata.json"),
 														},
 													},
 												},
@@ -727,6 +2462,26 @@ func TestBuilderTrainer(t *testing.T) {
 				},
 			},
 		},
+		"trainer ancestor without the TrainJob initializer does not set the metadata path env": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: append(
+						makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer).Spec.ReplicatedJobs,
+						makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node).Spec.ReplicatedJobs...,
+					),
+				},
+			},
+			trainJob: &trainer.TrainJob{},
+			info:     &runtime.Info{},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: append(
+						makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer).Spec.ReplicatedJobs,
+						makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node).Spec.ReplicatedJobs...,
+					),
+				},
+			},
+		},
 		"non-trainer ancestor is not modified": {
 			jobSet: makeJobSet(constants.DatasetInitializer, constants.Node, 2, constants.Node),
 			trainJob: &trainer.TrainJob{
@@ -907,6 +2662,136 @@ func TestBuilderTrainer(t *testing.T) {
 	}
 }
 
+func TestBuilderJobAnnotations(t *testing.T) {
+	cases := map[string]struct {
+		jobSet      *jobsetv1alpha2ac.JobSetApplyConfiguration
+		annotations map[string]string
+		wantJobSet  *jobsetv1alpha2ac.JobSetApplyConfiguration
+	}{
+		"annotations applied to every replicated job template": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{},
+							Name:     ptr.To("worker"),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{},
+							Name:     ptr.To("launcher"),
+						},
+					},
+				},
+			},
+			annotations: map[string]string{
+				"example.com/owner": "ml-platform",
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Annotations: map[string]string{
+										"example.com/owner": "ml-platform",
+									},
+								},
+							},
+							Name: ptr.To("worker"),
+						},
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Annotations: map[string]string{
+										"example.com/owner": "ml-platform",
+									},
+								},
+							},
+							Name: ptr.To("launcher"),
+						},
+					},
+				},
+			},
+		},
+		"job template annotations take precedence over propagated annotations": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+									Annotations: map[string]string{
+										"example.com/owner": "node-team",
+									},
+								},
+							},
+							Name: ptr.To(constants.Node),
+						},
+					},
+				},
+			},
+			annotations: map[string]string{
+				"example.com/owner": "ml-platform",
+				"example.com/cost":  "research",
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								ObjectMetaApplyConfiguration: &metav1ac.ObjectMetaApplyConfiguration{
+									Labels: map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									},
+									Annotations: map[string]string{
+										"example.com/owner": "node-team",
+										"example.com/cost":  "research",
+									},
+								},
+							},
+							Name: ptr.To(constants.Node),
+						},
+					},
+				},
+			},
+		},
+		"empty annotations leave job templates unchanged": {
+			jobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{},
+							Name:     ptr.To("worker"),
+						},
+					},
+				},
+			},
+			wantJobSet: &jobsetv1alpha2ac.JobSetApplyConfiguration{
+				Spec: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{},
+							Name:     ptr.To("worker"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			builder := NewBuilder(tc.jobSet)
+			got := builder.JobAnnotations(tc.annotations).Build()
+			if diff := cmp.Diff(tc.wantJobSet, got); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet from JobAnnotations (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestBuilderPodLabels(t *testing.T) {
 	cases := map[string]struct {
 		jobSet     *jobsetv1alpha2ac.JobSetApplyConfiguration
@@ -1030,6 +2915,178 @@ func TestBuilderPodLabels(t *testing.T) {
 	}
 }
 
+func TestBuilderTrainerPodLabels(t *testing.T) {
+	withPodLabels := func(jobSet *jobsetv1alpha2ac.JobSetApplyConfiguration, labels map[string]string) *jobsetv1alpha2ac.JobSetApplyConfiguration {
+		jobSet.Spec.ReplicatedJobs[0].Template.Spec.Template.WithLabels(labels)
+		return jobSet
+	}
+	cases := map[string]struct {
+		jobSet     *jobsetv1alpha2ac.JobSetApplyConfiguration
+		labels     map[string]string
+		wantJobSet *jobsetv1alpha2ac.JobSetApplyConfiguration
+	}{
+		"labels applied to the trainer job": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+			labels: map[string]string{"network.example.com/policy": "trainer"},
+			wantJobSet: withPodLabels(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				map[string]string{"network.example.com/policy": "trainer"}),
+		},
+		"labels take precedence over the existing pod template labels": {
+			jobSet: withPodLabels(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				map[string]string{"network.example.com/policy": "runtime", "team": "ml-platform"}),
+			labels: map[string]string{"network.example.com/policy": "trainer"},
+			wantJobSet: withPodLabels(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				map[string]string{"network.example.com/policy": "trainer", "team": "ml-platform"}),
+		},
+		"labels are not applied to the initializer job": {
+			jobSet:     makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer),
+			labels:     map[string]string{"network.example.com/policy": "trainer"},
+			wantJobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer),
+		},
+		"empty labels": {
+			jobSet:     makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+			wantJobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			builder := NewBuilder(tc.jobSet)
+			got := builder.TrainerPodLabels(tc.labels).Build()
+			if diff := cmp.Diff(tc.wantJobSet, got); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet from TrainerPodLabels (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilderTrainJobIdentityEnv(t *testing.T) {
+	withEnv := func(jobSet *jobsetv1alpha2ac.JobSetApplyConfiguration, envs ...*corev1ac.EnvVarApplyConfiguration) *jobsetv1alpha2ac.JobSetApplyConfiguration {
+		jobSet.Spec.ReplicatedJobs[0].Template.Spec.Template.Spec.Containers[0].WithEnv(envs...)
+		return jobSet
+	}
+	trainJob := &trainer.TrainJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-job",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "uid",
+		},
+	}
+	cases := map[string]struct {
+		jobSet     *jobsetv1alpha2ac.JobSetApplyConfiguration
+		wantJobSet *jobsetv1alpha2ac.JobSetApplyConfiguration
+	}{
+		"identity env injected into the trainer container": {
+			jobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+			wantJobSet: withEnv(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobName).WithValue("test-job"),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobNamespace).WithValue(metav1.NamespaceDefault),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobUID).WithValue("uid")),
+		},
+		"identity env overrides the env from the runtime": {
+			jobSet: withEnv(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobName).WithValue("runtime")),
+			wantJobSet: withEnv(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobName).WithValue("test-job"),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobNamespace).WithValue(metav1.NamespaceDefault),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvTrainJobUID).WithValue("uid")),
+		},
+		"identity env is not injected into the initializer job": {
+			jobSet:     makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer),
+			wantJobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer),
+		},
+		"identity env is not injected into the sidecar container": {
+			jobSet:     makeJobSet(constants.AncestorTrainer, "sidecar", 1, constants.Node),
+			wantJobSet: makeJobSet(constants.AncestorTrainer, "sidecar", 1, constants.Node),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			builder := NewBuilder(tc.jobSet)
+			got := builder.TrainJobIdentityEnv(trainJob).Build()
+			if diff := cmp.Diff(tc.wantJobSet, got); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet from TrainJobIdentityEnv (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilderDefaultEnv(t *testing.T) {
+	proxyEnv := []corev1.EnvVar{
+		{Name: jobsetplgconsts.EnvHTTPProxy, Value: "http://proxy.example.com:3128"},
+		{Name: jobsetplgconsts.EnvNoProxy, Value: ".svc,.cluster.local"},
+	}
+	withEnv := func(jobSet *jobsetv1alpha2ac.JobSetApplyConfiguration, envs ...*corev1ac.EnvVarApplyConfiguration) *jobsetv1alpha2ac.JobSetApplyConfiguration {
+		jobSet.Spec.ReplicatedJobs[0].Template.Spec.Template.Spec.Containers[0].WithEnv(envs...)
+		return jobSet
+	}
+	withInitContainer := func(jobSet *jobsetv1alpha2ac.JobSetApplyConfiguration, container *corev1ac.ContainerApplyConfiguration) *jobsetv1alpha2ac.JobSetApplyConfiguration {
+		jobSet.Spec.ReplicatedJobs[0].Template.Spec.Template.Spec.WithInitContainers(container)
+		return jobSet
+	}
+	cases := map[string]struct {
+		jobSet     *jobsetv1alpha2ac.JobSetApplyConfiguration
+		trainJob   *trainer.TrainJob
+		envs       []corev1.EnvVar
+		wantJobSet *jobsetv1alpha2ac.JobSetApplyConfiguration
+	}{
+		"env injected into the containers and init containers": {
+			jobSet: withInitContainer(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.Container().WithName("pip-install")),
+			trainJob: &trainer.TrainJob{},
+			envs:     proxyEnv,
+			wantJobSet: withInitContainer(
+				withEnv(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+					corev1ac.EnvVar().WithName(jobsetplgconsts.EnvHTTPProxy).WithValue("http://proxy.example.com:3128"),
+					corev1ac.EnvVar().WithName(jobsetplgconsts.EnvNoProxy).WithValue(".svc,.cluster.local")),
+				corev1ac.Container().WithName("pip-install").WithEnv(
+					corev1ac.EnvVar().WithName(jobsetplgconsts.EnvHTTPProxy).WithValue("http://proxy.example.com:3128"),
+					corev1ac.EnvVar().WithName(jobsetplgconsts.EnvNoProxy).WithValue(".svc,.cluster.local"))),
+		},
+		"env from the runtime is not overridden": {
+			jobSet: withEnv(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvHTTPProxy).WithValue("http://runtime.example.com:3128")),
+			trainJob: &trainer.TrainJob{},
+			envs:     proxyEnv,
+			wantJobSet: withEnv(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvHTTPProxy).WithValue("http://runtime.example.com:3128"),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvNoProxy).WithValue(".svc,.cluster.local")),
+		},
+		"env from the TrainJob is not overridden": {
+			jobSet: makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer),
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{
+							Env: []corev1.EnvVar{{Name: jobsetplgconsts.EnvNoProxy, Value: "*"}},
+						},
+					},
+				},
+			},
+			envs: proxyEnv,
+			wantJobSet: withEnv(makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvNoProxy).WithValue("*"),
+				corev1ac.EnvVar().WithName(jobsetplgconsts.EnvHTTPProxy).WithValue("http://proxy.example.com:3128")),
+		},
+		"empty env": {
+			jobSet:     makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+			trainJob:   &trainer.TrainJob{},
+			wantJobSet: makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			builder, err := NewBuilder(tc.jobSet).Initializer(tc.trainJob)
+			if err != nil {
+				t.Fatalf("Failed to build the initializer: %v", err)
+			}
+			got := builder.DefaultEnv(tc.envs).Build()
+			if diff := cmp.Diff(tc.wantJobSet, got); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet from DefaultEnv (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestBuilderPodAnnotations(t *testing.T) {
 	cases := map[string]struct {
 		jobSet      *jobsetv1alpha2ac.JobSetApplyConfiguration
@@ -1200,3 +3257,132 @@ func TestBuilderSuspend(t *testing.T) {
 		})
 	}
 }
+
+func TestBuilderDefaultResourceLimits(t *testing.T) {
+	withResources := func(jobSet *jobsetv1alpha2ac.JobSetApplyConfiguration, resources *corev1ac.ResourceRequirementsApplyConfiguration) *jobsetv1alpha2ac.JobSetApplyConfiguration {
+		jobSet.Spec.ReplicatedJobs[0].Template.Spec.Template.Spec.Containers[0].WithResources(resources)
+		return jobSet
+	}
+	cases := map[string]struct {
+		jobSet            *jobsetv1alpha2ac.JobSetApplyConfiguration
+		limitRequestRatio corev1.ResourceList
+		wantJobSet        *jobsetv1alpha2ac.JobSetApplyConfiguration
+	}{
+		"trainer limits are derived from requests when limits are omitted": {
+			jobSet: withResources(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.ResourceRequirements().WithRequests(corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				})),
+			limitRequestRatio: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("1.5"),
+			},
+			wantJobSet: withResources(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.ResourceRequirements().
+					WithRequests(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("500m"),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					}).
+					WithLimits(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("6Gi"),
+					})),
+		},
+		"limits are rounded up to milli CPU and whole memory bytes with the non-integer ratio": {
+			jobSet: withResources(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.ResourceRequirements().WithRequests(corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("333m"),
+					corev1.ResourceMemory: resource.MustParse("3Gi"),
+				})),
+			limitRequestRatio: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1.1"),
+				corev1.ResourceMemory: resource.MustParse("1.1"),
+			},
+			wantJobSet: withResources(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.ResourceRequirements().
+					WithRequests(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("333m"),
+						corev1.ResourceMemory: resource.MustParse("3Gi"),
+					}).
+					WithLimits(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("367m"),
+						corev1.ResourceMemory: resource.MustParse("3543348020"),
+					})),
+		},
+		"initializer limits are derived from requests when limits are omitted": {
+			jobSet: withResources(makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer),
+				corev1ac.ResourceRequirements().WithRequests(corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("2Gi"),
+				})),
+			limitRequestRatio: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("2"),
+			},
+			wantJobSet: withResources(makeJobSet(constants.DatasetInitializer, constants.DatasetInitializer, 1, constants.DatasetInitializer),
+				corev1ac.ResourceRequirements().
+					WithRequests(corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("2Gi"),
+					}).
+					WithLimits(corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					})),
+		},
+		"existing limits are preserved": {
+			jobSet: withResources(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.ResourceRequirements().
+					WithRequests(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					}).
+					WithLimits(corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("4"),
+					})),
+			limitRequestRatio: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("2"),
+			},
+			wantJobSet: withResources(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.ResourceRequirements().
+					WithRequests(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					}).
+					WithLimits(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("8Gi"),
+					})),
+		},
+		"empty ratio leaves limits unset": {
+			jobSet: withResources(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.ResourceRequirements().WithRequests(corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+				})),
+			wantJobSet: withResources(makeJobSet(constants.AncestorTrainer, constants.Node, 1, constants.Node),
+				corev1ac.ResourceRequirements().WithRequests(corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+				})),
+		},
+		"non-trainer containers are not changed": {
+			jobSet: withResources(makeJobSet(constants.AncestorTrainer, "sidecar", 1, constants.Node),
+				corev1ac.ResourceRequirements().WithRequests(corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+				})),
+			limitRequestRatio: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("2"),
+			},
+			wantJobSet: withResources(makeJobSet(constants.AncestorTrainer, "sidecar", 1, constants.Node),
+				corev1ac.ResourceRequirements().WithRequests(corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+				})),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			builder := NewBuilder(tc.jobSet)
+			got := builder.DefaultResourceLimits(tc.limitRequestRatio).Build()
+			if diff := cmp.Diff(tc.wantJobSet, got, cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet from DefaultResourceLimits (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
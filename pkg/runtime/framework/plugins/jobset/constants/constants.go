@@ -23,4 +23,84 @@ const (
 
 	// InitializerEnvStorageUri is the env name for the initializer storage uri.
 	InitializerEnvStorageUri string = "STORAGE_URI"
+
+	// InitializerEnvExtract is the env name for the flag to extract the archive downloaded by the initializer.
+	InitializerEnvExtract string = "INITIALIZER_EXTRACT"
+
+	// InitializerEnvCheckpointStorageUri is the env name for the checkpoint storage uri in the model initializer.
+	InitializerEnvCheckpointStorageUri string = "CHECKPOINT_STORAGE_URI"
+
+	// EnvCheckpointPath is the env name for the path of the downloaded checkpoint in the trainer.
+	EnvCheckpointPath string = "CHECKPOINT_PATH"
+
+	// CheckpointSubPath is the sub path of the initializer volume for the downloaded checkpoint.
+	CheckpointSubPath string = "checkpoint"
+
+	// InitializerMetadataFileName is the file name of the metadata reported by the initializers
+	// on the shared initializer volume, e.g. the dataset size or the model config.
+	InitializerMetadataFileName string = ".metadata.json"
+
+	// EnvDatasetMetadataPath is the env name for the path of the metadata reported by the dataset initializer in the trainer.
+	// The paths are comma-separated in the order of the TrainJob datasets when multiple datasets are initialized.
+	EnvDatasetMetadataPath string = "DATASET_METADATA_PATH"
+
+	// EnvModelMetadataPath is the env name for the path of the metadata reported by the model initializer in the trainer.
+	EnvModelMetadataPath string = "MODEL_METADATA_PATH"
+
+	// EnvHeartbeatFile is the env name for the path of the heartbeat file touched by the trainer.
+	EnvHeartbeatFile string = "TRAINER_HEARTBEAT_FILE"
+
+	// EnvTrainJobName is the env name for the name of the TrainJob in the trainer.
+	EnvTrainJobName string = "TRAINJOB_NAME"
+
+	// EnvTrainJobNamespace is the env name for the namespace of the TrainJob in the trainer.
+	EnvTrainJobNamespace string = "TRAINJOB_NAMESPACE"
+
+	// EnvTrainJobUID is the env name for the UID of the TrainJob in the trainer.
+	EnvTrainJobUID string = "TRAINJOB_UID"
+
+	// InitializerEnvAccessToken is the env name for the HuggingFace access token.
+	InitializerEnvAccessToken string = "ACCESS_TOKEN"
+
+	// InitializerEnvAccessKeyID is the env name for the S3 access key id.
+	InitializerEnvAccessKeyID string = "ACCESS_KEY_ID"
+
+	// InitializerEnvSecretAccessKey is the env name for the S3 secret access key.
+	InitializerEnvSecretAccessKey string = "SECRET_ACCESS_KEY"
+
+	// InitializerEnvAWSAccessKeyID is the env name for the AWS access key id used by the S3 clients.
+	InitializerEnvAWSAccessKeyID string = "AWS_ACCESS_KEY_ID"
+
+	// InitializerEnvAWSSecretAccessKey is the env name for the AWS secret access key used by the S3 clients.
+	InitializerEnvAWSSecretAccessKey string = "AWS_SECRET_ACCESS_KEY"
+
+	// InitializerEnvAzureStorageAccountName is the env name and the secret key for the Azure storage account name.
+	InitializerEnvAzureStorageAccountName string = "AZURE_STORAGE_ACCOUNT_NAME"
+
+	// InitializerEnvAzureStorageAccountKey is the env name and the secret key for the Azure storage account key.
+	InitializerEnvAzureStorageAccountKey string = "AZURE_STORAGE_ACCOUNT_KEY"
+
+	// InitializerEnvServiceAccountKey is the secret key for the GCP service account JSON key.
+	InitializerEnvServiceAccountKey string = "SERVICE_ACCOUNT_KEY"
+
+	// InitializerEnvGoogleApplicationCredentials is the env name for the GCP service account key path used by the GCS clients.
+	InitializerEnvGoogleApplicationCredentials string = "GOOGLE_APPLICATION_CREDENTIALS"
+
+	// VolumeNameGCSCredentials is the name for the initializer Pod's Volume and VolumeMount of the GCP service account key.
+	VolumeNameGCSCredentials string = "gcs-credentials"
+
+	// GCSCredentialsMountPath is the mount path for the GCP service account key in the initializer container.
+	GCSCredentialsMountPath string = "/var/secrets/google"
+
+	// GCSCredentialsFileName is the file name for the GCP service account key in the initializer container.
+	GCSCredentialsFileName string = "service-account.json"
+
+	// EnvHTTPProxy is the env name for the HTTP proxy.
+	EnvHTTPProxy string = "HTTP_PROXY"
+
+	// EnvHTTPSProxy is the env name for the HTTPS proxy.
+	EnvHTTPSProxy string = "HTTPS_PROXY"
+
+	// EnvNoProxy is the env name for the hosts excluded from the proxy.
+	EnvNoProxy string = "NO_PROXY"
 )
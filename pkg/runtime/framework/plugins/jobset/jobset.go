@@ -17,28 +17,41 @@ limitations under the License.
 package jobset
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"maps"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	resourcehelpers "k8s.io/component-helpers/resource"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
@@ -47,6 +60,7 @@ import (
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/apply"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/features"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
@@ -54,8 +68,31 @@ import (
 )
 
 var (
-	runtimeRefPath     = field.NewPath("spec").Child("runtimeRef")
-	runtimePatchesPath = field.NewPath("spec").Child("runtimePatches")
+	runtimeRefPath            = field.NewPath("spec").Child("runtimeRef")
+	runtimePatchesPath        = field.NewPath("spec").Child("runtimePatches")
+	initializerPath           = field.NewPath("spec").Child("initializer")
+	numNodesPath              = field.NewPath("spec").Child("trainer", "numNodes")
+	numProcPerNodePath        = field.NewPath("spec").Child("trainer", "numProcPerNode")
+	resourcesPerContainerPath = field.NewPath("spec").Child("trainer", "resourcesPerContainer")
+	resourcesPerNodePath      = field.NewPath("spec").Child("trainer", "resourcesPerNode")
+
+	// initializerSecretKeys are the keys that the initializer credentials secret
+	// must contain for the given StorageUri scheme.
+	initializerSecretKeys = map[string][]string{
+		"hf": {jobsetplgconsts.InitializerEnvAccessToken},
+		"s3": {jobsetplgconsts.InitializerEnvAccessKeyID, jobsetplgconsts.InitializerEnvSecretAccessKey},
+		"gs": {jobsetplgconsts.InitializerEnvServiceAccountKey},
+		"az": {jobsetplgconsts.InitializerEnvAzureStorageAccountName, jobsetplgconsts.InitializerEnvAzureStorageAccountKey},
+	}
+
+	// storageUriHostSegments are the names of the host segment required for the given StorageUri scheme.
+	storageUriHostSegments = map[string]string{
+		"gs": "bucket",
+		"az": "container",
+	}
+
+	// datasetStorageUriSchemes are the StorageUri schemes which the dataset initializer can download.
+	datasetStorageUriSchemes = sets.New("hf", "s3", "az", "cache", "http", "https")
 )
 
 type JobSet struct {
@@ -63,6 +100,11 @@ type JobSet struct {
 	restMapper meta.RESTMapper
 	scheme     *apiruntime.Scheme
 	logger     logr.Logger
+
+	limitRequestRatio corev1.ResourceList
+	trainerPodLabels  map[string]string
+	proxyEnv          []corev1.EnvVar
+	gpuSharing        bool
 }
 
 var _ framework.WatchExtensionPlugin = (*JobSet)(nil)
@@ -73,15 +115,30 @@ var _ framework.CustomValidationPlugin = (*JobSet)(nil)
 
 const Name = constants.JobSetKind
 
+// oomKilledReason is the container termination reason set by the kubelet
+// when the container is killed for running out of memory.
+const oomKilledReason = "OOMKilled"
+
 // +kubebuilder:rbac:groups=jobset.x-k8s.io,resources=jobsets,verbs=create;delete;get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 
-func New(ctx context.Context, client client.Client, _ client.FieldIndexer, _ *configapi.Configuration) (framework.Plugin, error) {
-	return &JobSet{
+func New(ctx context.Context, client client.Client, _ client.FieldIndexer, cfg *configapi.Configuration) (framework.Plugin, error) {
+	j := &JobSet{
 		client:     client,
 		restMapper: client.RESTMapper(),
 		scheme:     client.Scheme(),
 		logger:     ctrl.LoggerFrom(ctx).WithValues("pluginName", constants.JobSetKind),
-	}, nil
+	}
+	if cfg != nil {
+		if cfg.Resources != nil {
+			j.limitRequestRatio = cfg.Resources.LimitRequestRatio
+			j.gpuSharing = ptr.Deref(cfg.Resources.GPUSharing, false)
+		}
+		j.trainerPodLabels = cfg.TrainerPodLabels
+		j.proxyEnv = proxyEnv(cfg.Proxy)
+	}
+	return j, nil
 }
 
 func (j *JobSet) Name() string {
@@ -90,6 +147,7 @@ func (j *JobSet) Name() string {
 
 func (j *JobSet) Validate(ctx context.Context, info *runtime.Info, oldObj, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
 	var allErrs field.ErrorList
+	var warnings admission.Warnings
 	jobSetSpec, ok := runtime.TemplateSpecApply[jobsetv1alpha2ac.JobSetSpecApplyConfiguration](info)
 	if !ok {
 		return nil, nil
@@ -109,6 +167,34 @@ func (j *JobSet) Validate(ctx context.Context, info *runtime.Info, oldObj, newOb
 		}
 	}
 
+	// numNodes=0 is only allowed in the launcher-only mode, where the MPI launcher runs the training process
+	// with runLauncherAsNode.
+	if jobTrainer := newObj.Spec.Trainer; jobTrainer != nil && jobTrainer.NumNodes != nil && *jobTrainer.NumNodes < 1 {
+		if *jobTrainer.NumNodes < 0 || !isLauncherOnlyMode(info) {
+			allErrs = append(allErrs, field.Invalid(numNodesPath, *jobTrainer.NumNodes, "must be greater than or equal to 1 unless the MPI launcher runs as a node"))
+		}
+	}
+	if jobTrainer := newObj.Spec.Trainer; jobTrainer != nil && jobTrainer.NumNodes != nil &&
+		info.RuntimePolicy.NumNodes != nil && *jobTrainer.NumNodes != *info.RuntimePolicy.NumNodes {
+		warnings = append(warnings, fmt.Sprintf(
+			"%s (%d) differs from the runtime mlPolicy.numNodes (%d); the TrainJob value overrides the runtime",
+			numNodesPath, *jobTrainer.NumNodes, *info.RuntimePolicy.NumNodes,
+		))
+	}
+	if jobTrainer := newObj.Spec.Trainer; jobTrainer != nil && jobTrainer.NumProcPerNode != nil &&
+		info.RuntimePolicy.SingleProcessPerNode && *jobTrainer.NumProcPerNode != 1 {
+		allErrs = append(allErrs, field.Invalid(numProcPerNodePath, *jobTrainer.NumProcPerNode, "must be 1 for the runtime with singleProcessPerNode"))
+	}
+
+	// The resources can only be overridden for the containers of the trainer node.
+	if jobTrainer := newObj.Spec.Trainer; jobTrainer != nil {
+		for _, name := range slices.Sorted(maps.Keys(jobTrainer.ResourcesPerContainer)) {
+			if !rJobContainerNames[constants.Node].Has(name) {
+				allErrs = append(allErrs, field.NotFound(resourcesPerContainerPath.Key(name), name))
+			}
+		}
+	}
+
 	if newObj.Spec.Initializer != nil && newObj.Spec.Initializer.Dataset != nil {
 		containers, ok := rJobContainerNames[constants.DatasetInitializer]
 		if !ok {
@@ -139,6 +225,12 @@ func (j *JobSet) Validate(ctx context.Context, info *runtime.Info, oldObj, newOb
 		}
 	}
 
+	if newObj.Spec.Checkpoint != nil {
+		if containers, ok := rJobContainerNames[constants.ModelInitializer]; !ok || !containers.Has(constants.ModelInitializer) {
+			allErrs = append(allErrs, field.Invalid(runtimeRefPath, newObj.Spec.RuntimeRef, fmt.Sprintf("must have container with name - %s in the %s job when trainJob is configured with checkpoint", constants.ModelInitializer, constants.ModelInitializer)))
+		}
+	}
+
 	if newObj.Spec.Initializer != nil && newObj.Spec.Initializer.Model != nil {
 		containers, ok := rJobContainerNames[constants.ModelInitializer]
 		if !ok {
@@ -169,6 +261,11 @@ func (j *JobSet) Validate(ctx context.Context, info *runtime.Info, oldObj, newOb
 		}
 	}
 
+	if !j.gpuSharing {
+		allErrs = append(allErrs, validateWholeGPUs(newObj)...)
+	}
+	allErrs = append(allErrs, validateInitializerStorageUris(newObj)...)
+	allErrs = append(allErrs, j.validateInitializerSecretRefs(ctx, oldObj, newObj)...)
 	allErrs = append(allErrs, j.checkRuntimePatchesImmutability(ctx, oldObj, newObj)...)
 
 	// TODO (andreyvelich): Validate Volumes, VolumeMounts, and Tolerations.
@@ -204,11 +301,152 @@ func (j *JobSet) Validate(ctx context.Context, info *runtime.Info, oldObj, newOb
 					allErrs = append(allErrs, field.Invalid(runtimePatchesPath, newObj.Spec.RuntimePatches,
 						fmt.Sprintf("must not have envs for the %s, %s, %s containers", constants.DatasetInitializer, constants.ModelInitializer, constants.Node)))
 				}
+				if c.RestartPolicy != nil {
+					allErrs = append(allErrs, field.Invalid(runtimePatchesPath, newObj.Spec.RuntimePatches,
+						fmt.Sprintf("must not have restartPolicy for container %s, only init containers can be sidecars", c.Name)))
+				}
+			}
+		}
+	}
+
+	return warnings, allErrs
+}
+
+// validateInitializerSecretRefs verifies that the credentials secrets referenced by the
+// dataset and model initializers exist and have the keys expected for the StorageUri scheme.
+func (j *JobSet) validateInitializerSecretRefs(ctx context.Context, oldObj, newObj *trainer.TrainJob) field.ErrorList {
+	var allErrs field.ErrorList
+	if newObj.Spec.Initializer == nil {
+		return allErrs
+	}
+	// The initializer is immutable, so the secrets are only checked on creation.
+	if oldObj != nil {
+		return allErrs
+	}
+	if dataset := newObj.Spec.Initializer.Dataset; dataset != nil && dataset.SecretRef != nil {
+		storageUris := []*string{dataset.StorageUri}
+		for _, source := range dataset.Datasets {
+			storageUris = append(storageUris, &source.StorageUri)
+		}
+		allErrs = append(allErrs, j.validateInitializerSecretRef(ctx, initializerPath.Child("dataset", "secretRef"),
+			newObj.Namespace, dataset.SecretRef.Name, storageUris...)...)
+	}
+	if model := newObj.Spec.Initializer.Model; model != nil && model.SecretRef != nil {
+		allErrs = append(allErrs, j.validateInitializerSecretRef(ctx, initializerPath.Child("model", "secretRef"),
+			newObj.Namespace, model.SecretRef.Name, model.StorageUri)...)
+	}
+	return allErrs
+}
+
+// validateWholeGPUs validates that the GPU requests and limits of the trainer are whole GPUs,
+// since the fractional GPUs can only be allocated when the GPUs are shared.
+func validateWholeGPUs(newObj *trainer.TrainJob) field.ErrorList {
+	var allErrs field.ErrorList
+	jobTrainer := newObj.Spec.Trainer
+	if jobTrainer == nil {
+		return nil
+	}
+	validate := func(path *field.Path, resources *corev1.ResourceRequirements) {
+		for _, list := range []struct {
+			name      string
+			resources corev1.ResourceList
+		}{{"requests", resources.Requests}, {"limits", resources.Limits}} {
+			for _, name := range slices.Sorted(maps.Keys(list.resources)) {
+				quantity := list.resources[name]
+				if strings.Contains(strings.ToLower(name.String()), "gpu") && quantity.MilliValue()%1000 != 0 {
+					allErrs = append(allErrs, field.Invalid(path.Child(list.name).Key(name.String()), quantity.String(),
+						"must be a whole number of GPUs unless the GPU sharing is enabled"))
+				}
 			}
 		}
 	}
+	if jobTrainer.ResourcesPerNode != nil {
+		validate(resourcesPerNodePath, jobTrainer.ResourcesPerNode)
+	}
+	for _, name := range slices.Sorted(maps.Keys(jobTrainer.ResourcesPerContainer)) {
+		resources := jobTrainer.ResourcesPerContainer[name]
+		validate(resourcesPerContainerPath.Key(name), &resources)
+	}
+	return allErrs
+}
+
+// validateInitializerStorageUris verifies that the GCS and Azure storageUris of the dataset and model initializers
+// have the bucket and the container respectively.
+func validateInitializerStorageUris(newObj *trainer.TrainJob) field.ErrorList {
+	var allErrs field.ErrorList
+	if newObj.Spec.Initializer == nil {
+		return allErrs
+	}
+	if dataset := newObj.Spec.Initializer.Dataset; dataset != nil {
+		allErrs = append(allErrs, validateDatasetStorageUriScheme(initializerPath.Child("dataset", "storageUri"), dataset.StorageUri)...)
+		allErrs = append(allErrs, validateStorageUriHost(initializerPath.Child("dataset", "storageUri"), dataset.StorageUri)...)
+		for i, source := range dataset.Datasets {
+			path := initializerPath.Child("dataset", "datasets").Index(i).Child("storageUri")
+			allErrs = append(allErrs, validateDatasetStorageUriScheme(path, &source.StorageUri)...)
+			allErrs = append(allErrs, validateStorageUriHost(path, &source.StorageUri)...)
+		}
+	}
+	if model := newObj.Spec.Initializer.Model; model != nil {
+		allErrs = append(allErrs, validateStorageUriHost(initializerPath.Child("model", "storageUri"), model.StorageUri)...)
+	}
+	return allErrs
+}
 
-	return nil, allErrs
+func validateDatasetStorageUriScheme(path *field.Path, storageUri *string) field.ErrorList {
+	var allErrs field.ErrorList
+	if storageUri == nil || len(*storageUri) == 0 {
+		return allErrs
+	}
+	if uri, err := url.Parse(*storageUri); err == nil && !datasetStorageUriSchemes.Has(uri.Scheme) {
+		allErrs = append(allErrs, field.NotSupported(path, *storageUri, sets.List(datasetStorageUriSchemes)))
+	}
+	return allErrs
+}
+
+func validateStorageUriHost(path *field.Path, storageUri *string) field.ErrorList {
+	var allErrs field.ErrorList
+	if storageUri == nil {
+		return allErrs
+	}
+	uri, err := url.Parse(*storageUri)
+	if err != nil {
+		return allErrs
+	}
+	if segment, ok := storageUriHostSegments[uri.Scheme]; ok && uri.Host == "" {
+		allErrs = append(allErrs, field.Invalid(path, *storageUri, fmt.Sprintf("must have the %s for the %s storageUri", segment, uri.Scheme)))
+	}
+	return allErrs
+}
+
+func (j *JobSet) validateInitializerSecretRef(ctx context.Context, path *field.Path, namespace, name string, storageUris ...*string) field.ErrorList {
+	var allErrs field.ErrorList
+	secret := &corev1.Secret{}
+	if err := j.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(path.Child("name"), name))
+		} else {
+			allErrs = append(allErrs, field.InternalError(path, err))
+		}
+		return allErrs
+	}
+	schemes := sets.New[string]()
+	for _, storageUri := range storageUris {
+		if storageUri == nil {
+			continue
+		}
+		if uri, err := url.Parse(*storageUri); err == nil {
+			schemes.Insert(uri.Scheme)
+		}
+	}
+	for _, scheme := range sets.List(schemes) {
+		for _, key := range initializerSecretKeys[scheme] {
+			if _, ok := secret.Data[key]; !ok {
+				allErrs = append(allErrs, field.Invalid(path.Child("name"), name,
+					fmt.Sprintf("secret must have the %s key for the %s storageUri", key, scheme)))
+			}
+		}
+	}
+	return allErrs
 }
 
 func (j *JobSet) checkRuntimePatchesImmutability(ctx context.Context, oldObj, newObj *trainer.TrainJob) field.ErrorList {
@@ -267,7 +505,128 @@ func (j *JobSet) ReconcilerBuilders() []runtime.ReconcilerBuilder {
 				),
 			)
 		},
+		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
+			// The JobSet status does not reflect the image pull and scheduling failures, so the Pods are watched
+			// to surface them in the ImagePullFailed and SpotFallback conditions. The JobSet is named after the TrainJob.
+			// The scheduled Pods are also watched to copy the Node label with the number of processes per node.
+			// The Pod informer only caches the Pods with the JobSet name label, see config.CacheOptions.
+			return b.Watches(
+				&corev1.Pod{},
+				handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+					name, ok := obj.GetLabels()[jobsetv1alpha2.JobSetNameKey]
+					if !ok {
+						return nil
+					}
+					return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}}}
+				}),
+				builder.WithPredicates(predicate.Funcs{
+					CreateFunc: func(e event.CreateEvent) bool {
+						return isImagePullFailed(e.Object) || isUnschedulable(e.Object) || isNumProcPerNodeLabelMissing(e.Object)
+					},
+					UpdateFunc: func(e event.UpdateEvent) bool {
+						return isImagePullFailed(e.ObjectOld) != isImagePullFailed(e.ObjectNew) ||
+							isUnschedulable(e.ObjectOld) != isUnschedulable(e.ObjectNew) ||
+							isNumProcPerNodeLabelMissing(e.ObjectOld) != isNumProcPerNodeLabelMissing(e.ObjectNew)
+					},
+					DeleteFunc: func(e event.DeleteEvent) bool {
+						return isImagePullFailed(e.Object) || isUnschedulable(e.Object)
+					},
+					GenericFunc: func(event.GenericEvent) bool {
+						return false
+					},
+				}),
+			)
+		},
+		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
+			// The scheduling preflight only lists the Nodes while building the JobSet, so the TrainJobs kept suspended
+			// by the preflight are requeued once the cluster capacity changes.
+			return b.Watches(
+				&corev1.Node{},
+				handler.EnqueueRequestsFromMapFunc(j.schedulingPreflightFailedRequests),
+				builder.WithPredicates(predicate.Funcs{
+					CreateFunc: func(event.CreateEvent) bool {
+						return true
+					},
+					UpdateFunc: func(e event.UpdateEvent) bool {
+						return isNodeCapacityChanged(e.ObjectOld, e.ObjectNew)
+					},
+					DeleteFunc: func(event.DeleteEvent) bool {
+						return false
+					},
+					GenericFunc: func(event.GenericEvent) bool {
+						return false
+					},
+				}),
+			)
+		},
+	}
+}
+
+// schedulingPreflightFailedRequests returns the requests for the TrainJobs whose JobSets are kept suspended
+// by the scheduling preflight. The JobSet is named after the TrainJob.
+func (j *JobSet) schedulingPreflightFailedRequests(ctx context.Context, _ client.Object) []reconcile.Request {
+	var jobSets jobsetv1alpha2.JobSetList
+	if err := j.client.List(ctx, &jobSets); err != nil {
+		j.logger.Error(err, "Failed to list JobSets kept suspended by the scheduling preflight")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, jobSet := range jobSets.Items {
+		if _, ok := jobSet.Annotations[constants.AnnotationSchedulingPreflightFailed]; ok {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&jobSet)})
+		}
 	}
+	return requests
+}
+
+// isNodeCapacityChanged returns true if the Node allocatable resources or schedulability are changed.
+func isNodeCapacityChanged(oldObj, newObj client.Object) bool {
+	oldNode, ok := oldObj.(*corev1.Node)
+	if !ok {
+		return false
+	}
+	newNode, ok := newObj.(*corev1.Node)
+	if !ok {
+		return false
+	}
+	return oldNode.Spec.Unschedulable != newNode.Spec.Unschedulable ||
+		!equality.Semantic.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable)
+}
+
+// isImagePullFailed returns true if the object is the Pod with a container which can not pull its image.
+func isImagePullFailed(obj client.Object) bool {
+	pod, ok := obj.(*corev1.Pod)
+	return ok && imagePullFailedContainer(pod) != nil
+}
+
+// isNumProcPerNodeLabelMissing returns true if the object is the scheduled Pod
+// which does not have the Node label with the number of processes per node copied yet.
+func isNumProcPerNodeLabelMissing(obj client.Object) bool {
+	pod, ok := obj.(*corev1.Pod)
+	return ok && len(numProcPerNodeNodeLabel(pod)) != 0
+}
+
+// numProcPerNodeNodeLabel returns the Node label to copy to the Pod label with the number of processes per node,
+// or empty if the Pod does not read it, is not scheduled yet, or already has the label.
+func numProcPerNodeNodeLabel(pod *corev1.Pod) string {
+	if _, ok := pod.Labels[constants.LabelNumProcPerNode]; ok || len(pod.Spec.NodeName) == 0 {
+		return ""
+	}
+	return pod.Annotations[constants.AnnotationNumProcPerNodeNodeLabel]
+}
+
+// isUnschedulable returns true if the object is the Pod which can not be scheduled.
+func isUnschedulable(obj client.Object) bool {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodScheduled {
+			return c.Status == corev1.ConditionFalse && c.Reason == corev1.PodReasonUnschedulable
+		}
+	}
+	return false
 }
 
 func (j *JobSet) IdentifyPodNetwork(info *runtime.Info, trainJob *trainer.TrainJob) error {
@@ -335,6 +694,12 @@ func (j *JobSet) Build(ctx context.Context, info *runtime.Info, trainJob *traine
 		}
 		oldJobSet = nil
 	}
+	// Wait for the deletion of the JobSet, e.g. the canary JobSet replaced by the JobSet with numNodes.
+	if oldJobSet != nil && oldJobSet.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	// The force-rerender annotation does not bypass this, since the JobSet webhook rejects
+	// the replicatedJobs changes of the running JobSet, so the re-render is deferred until the JobSet is suspended.
 	if oldJobSet != nil &&
 		!ptr.Deref(trainJob.Spec.Suspend, false) &&
 		!ptr.Deref(oldJobSet.Spec.Suspend, false) {
@@ -414,15 +779,57 @@ func (j *JobSet) Build(ctx context.Context, info *runtime.Info, trainJob *traine
 		WithLabels(maps.Clone(info.Labels)).
 		WithAnnotations(maps.Clone(info.Annotations)).
 		WithSpec(jobSetSpec))
+	if forceRerender, ok := trainJob.Annotations[constants.AnnotationForceRerender]; ok {
+		jobSetBuilder.WithAnnotations(map[string]string{constants.AnnotationForceRerender: forceRerender})
+	}
+	if clientVersion, ok := trainJob.Annotations[constants.AnnotationClientVersion]; ok {
+		jobSetBuilder.WithAnnotations(map[string]string{constants.AnnotationClientVersion: clientVersion})
+	}
+	if trainjob.IsCanaryPending(trainJob) {
+		jobSetBuilder.WithAnnotations(map[string]string{constants.AnnotationCanary: "true"})
+	}
+	if trainjob.IsSpotActive(trainJob) {
+		jobSetBuilder.WithAnnotations(map[string]string{constants.AnnotationSpot: "true"})
+	}
+
+	// The JobSet is kept suspended while the TrainJob can never fit the cluster, rather than being started or resumed.
+	suspend := trainJob.Spec.Suspend
+	if features.Enabled(features.SchedulingPreflight) && !ptr.Deref(trainJob.Spec.Suspend, false) &&
+		(oldJobSet == nil || ptr.Deref(oldJobSet.Spec.Suspend, false)) {
+		message, err := j.schedulingPreflight(ctx, info)
+		if err != nil {
+			return nil, err
+		}
+		if len(message) != 0 {
+			suspend = ptr.To(true)
+			jobSetBuilder.WithAnnotations(map[string]string{constants.AnnotationSchedulingPreflightFailed: message})
+		}
+	}
+
+	// The replicatedJobs dependsOn is immutable, so the JobSet created before the trainer Jobs depended
+	// on the initializer Jobs is resumed without the dependencies.
+	skipInitializerDependency := oldJobSet != nil && !slices.ContainsFunc(oldJobSet.Spec.ReplicatedJobs, func(rJob jobsetv1alpha2.ReplicatedJob) bool {
+		return len(rJob.DependsOn) != 0
+	})
 
 	// TODO (andreyvelich): Refactor the builder with wrappers for PodSpec.
 	// TODO: Once we remove deprecated runtime.Info.Trainer, we should remove JobSet Builder with DeprecatedTrainer().
+	jobSetBuilder, err := jobSetBuilder.
+		SkipInitializerDependency(skipInitializerDependency).
+		Initializer(trainJob)
+	if err != nil {
+		return nil, err
+	}
 	jobSet := jobSetBuilder.
-		Initializer(trainJob).
 		Trainer(info, trainJob).
+		DefaultResourceLimits(j.limitRequestRatio).
+		JobAnnotations(info.Annotations).
 		PodLabels(info.Scheduler.PodLabels).
+		TrainerPodLabels(j.trainerPodLabels).
+		TrainJobIdentityEnv(trainJob).
+		DefaultEnv(j.proxyEnv).
 		PodAnnotations(info.Scheduler.PodAnnotations).
-		Suspend(trainJob.Spec.Suspend).
+		Suspend(suspend).
 		Build().
 		WithOwnerReferences(metav1ac.OwnerReference().
 			WithAPIVersion(trainer.GroupVersion.String()).
@@ -445,14 +852,56 @@ func (j *JobSet) Status(ctx context.Context, trainJob *trainer.TrainJob) (*train
 		}
 		return nil, err
 	}
+	// The JobSet of another owner, e.g. the previous TrainJob with the same name, does not reflect the TrainJob.
+	if owner := metav1.GetControllerOf(jobSet); owner != nil && owner.UID != trainJob.UID {
+		return nil, nil
+	}
 	status := trainJob.Status.DeepCopy()
+	status.JobSetStatus = &trainer.JobSetStatus{
+		CreationTimestamp: ptr.To(jobSet.CreationTimestamp),
+		Phase:             jobSetPhase(jobSet),
+	}
+	// The start time is reset while the JobSet is suspended, so that it reflects the latest resume.
+	if status.JobSetStatus.Phase == trainer.JobSetPhaseSuspended {
+		status.StartTime = nil
+	} else if status.StartTime == nil {
+		status.StartTime = ptr.To(jobSetStartTime(jobSet))
+	}
 
-	if completed := meta.FindStatusCondition(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetCompleted)); completed != nil && completed.Status == metav1.ConditionTrue {
+	// The completion of the canary JobSet does not complete the TrainJob, since the trainer is then scaled to numNodes.
+	_, canary := jobSet.Annotations[constants.AnnotationCanary]
+	if canary {
+		meta.SetStatusCondition(&status.Conditions, canaryCondition(jobSet))
+	}
+	if completed := meta.FindStatusCondition(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetCompleted)); completed != nil && completed.Status == metav1.ConditionTrue && !canary {
 		completed.Type = trainer.TrainJobComplete
+		if status.CompletionTime == nil {
+			status.CompletionTime = ptr.To(completed.LastTransitionTime)
+		}
 		meta.SetStatusCondition(&status.Conditions, *completed)
 	}
 	if failed := meta.FindStatusCondition(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetFailed)); failed != nil && failed.Status == metav1.ConditionTrue {
 		failed.Type = trainer.TrainJobFailed
+		if prevFailed := meta.FindStatusCondition(status.Conditions, trainer.TrainJobFailed); prevFailed != nil && prevFailed.Status == metav1.ConditionTrue {
+			// Keep the failed Pod details, since the Pods might be deleted after the JobSet failed.
+			failed.Message = prevFailed.Message
+			if prevFailed.Reason == trainer.TrainJobOOMKilledReason {
+				failed.Reason = prevFailed.Reason
+			}
+		} else {
+			podMessage, oomKilled, err := j.failedPodMessage(ctx, trainJob)
+			if err != nil {
+				return nil, err
+			}
+			if len(podMessage) != 0 {
+				failed.Message = fmt.Sprintf("%s: %s", failed.Message, podMessage)
+			}
+			// The JobSet failure reason is kept in the message, since the reason is replaced.
+			if oomKilled {
+				failed.Message = fmt.Sprintf("%s: %s; %s", failed.Reason, failed.Message, constants.TrainJobOOMKilledHintMessage)
+				failed.Reason = trainer.TrainJobOOMKilledReason
+			}
+		}
 		meta.SetStatusCondition(&status.Conditions, *failed)
 	}
 
@@ -468,6 +917,413 @@ func (j *JobSet) Status(ctx context.Context, trainJob *trainer.TrainJob) (*train
 		})
 	}
 	status.JobsStatus = statuses
+	// The Pods are not running while the JobSet is suspended or finished, so the image pull failures are cleared.
+	if status.JobSetStatus.Phase == trainer.JobSetPhaseRunning {
+		imagePullFailed, err := j.imagePullFailedCondition(ctx, trainJob)
+		if err != nil {
+			return nil, err
+		}
+		if imagePullFailed != nil {
+			meta.SetStatusCondition(&status.Conditions, *imagePullFailed)
+		} else {
+			meta.RemoveStatusCondition(&status.Conditions, trainer.TrainJobImagePullFailed)
+		}
+	} else {
+		meta.RemoveStatusCondition(&status.Conditions, trainer.TrainJobImagePullFailed)
+	}
+
+	// The downward API can not reference the Node labels, so the Node label with the number of processes per node
+	// is copied to the label of the scheduled trainer Pods, which sets PET_NPROC_PER_NODE once the containers start.
+	if status.JobSetStatus.Phase == trainer.JobSetPhaseRunning {
+		if err := j.copyNumProcPerNodeLabels(ctx, trainJob); err != nil {
+			return nil, err
+		}
+	}
+
+	// The spot JobSet is replaced by the JobSet placing the trainer on the on-demand nodes once the fallback is reported.
+	if _, spot := jobSet.Annotations[constants.AnnotationSpot]; spot && status.JobSetStatus.Phase == trainer.JobSetPhaseRunning {
+		spotFallback, err := j.spotFallbackCondition(ctx, trainJob, jobSet)
+		if err != nil {
+			return nil, err
+		}
+		if spotFallback != nil {
+			meta.SetStatusCondition(&status.Conditions, *spotFallback)
+		}
+	}
+
+	// The reason why the scheduling preflight keeps the JobSet suspended is surfaced until the TrainJob fits the cluster.
+	if message, ok := jobSet.Annotations[constants.AnnotationSchedulingPreflightFailed]; ok &&
+		ptr.Deref(jobSet.Spec.Suspend, false) && !ptr.Deref(trainJob.Spec.Suspend, false) {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    trainer.TrainJobUnschedulable,
+			Status:  metav1.ConditionTrue,
+			Reason:  trainer.TrainJobInsufficientClusterCapacityReason,
+			Message: message,
+		})
+	} else {
+		meta.RemoveStatusCondition(&status.Conditions, trainer.TrainJobUnschedulable)
+	}
+
+	// The JobSet restarts are surfaced to detect the flapping TrainJobs.
+	// The Restarting condition is removed once all the recreated Jobs are ready again.
+	restarted := false
+	if restarts := jobSet.Status.Restarts; restarts != 0 {
+		if restarts > ptr.Deref(status.RestartCount, 0) && status.JobSetStatus.Phase == trainer.JobSetPhaseRunning {
+			meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+				Type:    trainer.TrainJobRestarting,
+				Status:  metav1.ConditionTrue,
+				Reason:  trainer.TrainJobRestartedReason,
+				Message: constants.TrainJobRestartingMessage,
+			})
+			restarted = true
+		}
+		status.RestartCount = ptr.To(restarts)
+	}
+	if status.JobSetStatus.Phase != trainer.JobSetPhaseRunning || (!restarted && replicatedJobsReady(jobSet)) {
+		meta.RemoveStatusCondition(&status.Conditions, trainer.TrainJobRestarting)
+	}
+
+	status.EffectiveCommand = effectiveCommand(jobSet)
+	status.TotalResources = totalResources(jobSet)
 
 	return status, nil
 }
+
+// replicatedJobsReady returns whether all the Jobs of every replicated Job are ready or succeeded.
+func replicatedJobsReady(jobSet *jobsetv1alpha2.JobSet) bool {
+	statuses := make(map[string]jobsetv1alpha2.ReplicatedJobStatus, len(jobSet.Status.ReplicatedJobsStatus))
+	for _, status := range jobSet.Status.ReplicatedJobsStatus {
+		statuses[status.Name] = status
+	}
+	for _, rJob := range jobSet.Spec.ReplicatedJobs {
+		status, ok := statuses[rJob.Name]
+		if !ok || status.Ready+status.Succeeded < rJob.Replicas {
+			return false
+		}
+	}
+	return true
+}
+
+// failedPodMessage returns the termination details of the first failed container in the TrainJob Pods,
+// and whether that container was OOMKilled.
+// It returns an empty message if none of the Pods has a failed container.
+func (j *JobSet) failedPodMessage(ctx context.Context, trainJob *trainer.TrainJob) (string, bool, error) {
+	pods, err := j.listTrainJobPods(ctx, trainJob)
+	if err != nil {
+		return "", false, err
+	}
+	var (
+		failedPod       *corev1.Pod
+		failedContainer *corev1.ContainerStatus
+	)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		for _, cs := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+			terminated := cs.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			if failedContainer == nil || isTerminatedBefore(pod, terminated, failedPod, failedContainer.State.Terminated) {
+				failedPod, failedContainer = pod, &cs
+			}
+		}
+	}
+	if failedContainer == nil {
+		return "", false, nil
+	}
+	terminated := failedContainer.State.Terminated
+	return fmt.Sprintf("container %s in pod %s terminated with reason %s and exit code %d",
+		failedContainer.Name, failedPod.Name, terminated.Reason, terminated.ExitCode), terminated.Reason == oomKilledReason, nil
+}
+
+// imagePullFailedCondition returns the ImagePullFailed condition for the first container,
+// ordered by the Pod names, which can not pull its image.
+// It returns nil if none of the TrainJob Pods fails to pull the container images.
+func (j *JobSet) imagePullFailedCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error) {
+	pods, err := j.listTrainJobPods(ctx, trainJob)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(pods.Items, func(a, b corev1.Pod) int { return cmp.Compare(a.Name, b.Name) })
+	for _, pod := range pods.Items {
+		cs := imagePullFailedContainer(&pod)
+		if cs == nil {
+			continue
+		}
+		message := fmt.Sprintf("container %s in pod %s failed to pull image %s", cs.Name, pod.Name, cs.Image)
+		if len(cs.State.Waiting.Message) != 0 {
+			message = fmt.Sprintf("%s: %s", message, cs.State.Waiting.Message)
+		}
+		return &metav1.Condition{
+			Type:    trainer.TrainJobImagePullFailed,
+			Status:  metav1.ConditionTrue,
+			Reason:  cs.State.Waiting.Reason,
+			Message: message,
+		}, nil
+	}
+	return nil, nil
+}
+
+// listTrainJobPods lists the Pods of the TrainJob by the JobSet name label, narrowed down by the additional requirements.
+// The Pods must be selected by the JobSet name label, since the Pod cache is limited to the JobSet Pods.
+func (j *JobSet) listTrainJobPods(ctx context.Context, trainJob *trainer.TrainJob, reqs ...labels.Requirement) (*corev1.PodList, error) {
+	var pods corev1.PodList
+	selector := labels.SelectorFromValidatedSet(labels.Set{jobsetv1alpha2.JobSetNameKey: trainJob.Name}).Add(reqs...)
+	if err := j.client.List(ctx, &pods, client.InNamespace(trainJob.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	return &pods, nil
+}
+
+// copyNumProcPerNodeLabels copies the Node label with the number of processes per node
+// to the label of the TrainJob Pods scheduled to the Node.
+func (j *JobSet) copyNumProcPerNodeLabels(ctx context.Context, trainJob *trainer.TrainJob) error {
+	pods, err := j.listTrainJobPods(ctx, trainJob)
+	if err != nil {
+		return err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		nodeLabel := numProcPerNodeNodeLabel(pod)
+		if len(nodeLabel) == 0 {
+			continue
+		}
+		node := &corev1.Node{}
+		if err := j.client.Get(ctx, client.ObjectKey{Name: pod.Spec.NodeName}, node); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		value, ok := node.Labels[nodeLabel]
+		if !ok {
+			ctrl.LoggerFrom(ctx).Info("Node does not have the label with the number of processes per node",
+				"node", node.Name, "label", nodeLabel, "pod", klog.KObj(pod))
+			continue
+		}
+		origin := pod.DeepCopy()
+		metav1.SetMetaDataLabel(&pod.ObjectMeta, constants.LabelNumProcPerNode, value)
+		if err := j.client.Patch(ctx, pod, client.MergeFrom(origin)); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// imagePullFailedContainer returns the status of the first container in the Pod which can not pull its image,
+// or nil if all the container images of the Pod are pulled.
+func imagePullFailedContainer(pod *corev1.Pod) *corev1.ContainerStatus {
+	for _, cs := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+		if waiting := cs.State.Waiting; waiting != nil &&
+			(waiting.Reason == trainer.TrainJobErrImagePullReason || waiting.Reason == trainer.TrainJobImagePullBackOffReason) {
+			return &cs
+		}
+	}
+	return nil
+}
+
+// spotFallbackCondition returns the SpotFallback condition once the number of the trainer Pods
+// which can not be scheduled on the spot nodes reaches the spot fallbackAfterSchedulingFailures.
+// It returns nil if the TrainJob does not fall back to the on-demand nodes.
+func (j *JobSet) spotFallbackCondition(ctx context.Context, trainJob *trainer.TrainJob, jobSet *jobsetv1alpha2.JobSet) (*metav1.Condition, error) {
+	if trainJob.Spec.Trainer == nil || trainJob.Spec.Trainer.Spot == nil || trainJob.Spec.Trainer.Spot.FallbackAfterSchedulingFailures == nil {
+		return nil, nil
+	}
+	trainerJobs := sets.New[string]()
+	for _, rJob := range jobSet.Spec.ReplicatedJobs {
+		if rJob.Template.Labels[constants.LabelTrainJobAncestor] == constants.AncestorTrainer {
+			trainerJobs.Insert(rJob.Name)
+		}
+	}
+	if trainerJobs.Len() == 0 {
+		return nil, nil
+	}
+	trainerPods, err := labels.NewRequirement(jobsetv1alpha2.ReplicatedJobNameKey, selection.In, sets.List(trainerJobs))
+	if err != nil {
+		return nil, err
+	}
+	pods, err := j.listTrainJobPods(ctx, trainJob, *trainerPods)
+	if err != nil {
+		return nil, err
+	}
+	var failures int32
+	for _, pod := range pods.Items {
+		if isUnschedulable(&pod) {
+			failures++
+		}
+	}
+	if failures < *trainJob.Spec.Trainer.Spot.FallbackAfterSchedulingFailures {
+		return nil, nil
+	}
+	return &metav1.Condition{
+		Type:    trainer.TrainJobSpotFallback,
+		Status:  metav1.ConditionTrue,
+		Reason:  trainer.TrainJobSpotSchedulingFailedReason,
+		Message: constants.TrainJobSpotFallbackMessage,
+	}, nil
+}
+
+// isTerminatedBefore returns true if the container a terminated before the container b.
+// The Pod names break the ties to keep the failed Pod selection deterministic.
+func isTerminatedBefore(aPod *corev1.Pod, a *corev1.ContainerStateTerminated, bPod *corev1.Pod, b *corev1.ContainerStateTerminated) bool {
+	if !a.FinishedAt.Equal(&b.FinishedAt) {
+		return a.FinishedAt.Before(&b.FinishedAt)
+	}
+	return aPod.Name < bPod.Name
+}
+
+// canaryCondition returns the Canary condition of the TrainJob from the canary JobSet conditions.
+func canaryCondition(jobSet *jobsetv1alpha2.JobSet) metav1.Condition {
+	switch {
+	case meta.IsStatusConditionTrue(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetCompleted)):
+		return metav1.Condition{
+			Type:    trainer.TrainJobCanary,
+			Status:  metav1.ConditionTrue,
+			Reason:  trainer.TrainJobCanarySucceededReason,
+			Message: constants.TrainJobCanarySucceededMessage,
+		}
+	case meta.IsStatusConditionTrue(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetFailed)):
+		return metav1.Condition{
+			Type:    trainer.TrainJobCanary,
+			Status:  metav1.ConditionFalse,
+			Reason:  trainer.TrainJobCanaryFailedReason,
+			Message: constants.TrainJobCanaryFailedMessage,
+		}
+	default:
+		return metav1.Condition{
+			Type:    trainer.TrainJobCanary,
+			Status:  metav1.ConditionFalse,
+			Reason:  trainer.TrainJobCanaryRunningReason,
+			Message: constants.TrainJobCanaryRunningMessage,
+		}
+	}
+}
+
+// proxyEnv returns the proxy env vars from the configured proxy.
+func proxyEnv(proxy *configapi.Proxy) []corev1.EnvVar {
+	if proxy == nil {
+		return nil
+	}
+	var envs []corev1.EnvVar
+	for _, env := range []struct {
+		name  string
+		value *string
+	}{
+		{name: jobsetplgconsts.EnvHTTPProxy, value: proxy.HTTPProxy},
+		{name: jobsetplgconsts.EnvHTTPSProxy, value: proxy.HTTPSProxy},
+		{name: jobsetplgconsts.EnvNoProxy, value: proxy.NoProxy},
+	} {
+		if env.value != nil {
+			envs = append(envs, corev1.EnvVar{Name: env.name, Value: *env.value})
+		}
+	}
+	return envs
+}
+
+// jobSetPhase returns the high-level phase of the JobSet from its conditions and suspend state.
+func jobSetPhase(jobSet *jobsetv1alpha2.JobSet) trainer.JobSetPhase {
+	switch {
+	case meta.IsStatusConditionTrue(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetFailed)):
+		return trainer.JobSetPhaseFailed
+	case meta.IsStatusConditionTrue(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetCompleted)):
+		return trainer.JobSetPhaseCompleted
+	case ptr.Deref(jobSet.Spec.Suspend, false):
+		return trainer.JobSetPhaseSuspended
+	default:
+		return trainer.JobSetPhaseRunning
+	}
+}
+
+// jobSetStartTime returns the time when the JobSet was last resumed,
+// or the JobSet creation time if the JobSet has never been suspended.
+func jobSetStartTime(jobSet *jobsetv1alpha2.JobSet) metav1.Time {
+	if suspended := meta.FindStatusCondition(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetSuspended)); suspended != nil && suspended.Status == metav1.ConditionFalse {
+		return suspended.LastTransitionTime
+	}
+	return jobSet.CreationTimestamp
+}
+
+// effectiveCommand returns the command and args of the trainer node container rendered into the JobSet.
+// It returns nil if the JobSet does not contain the trainer node container.
+func effectiveCommand(jobSet *jobsetv1alpha2.JobSet) []string {
+	for _, rJob := range jobSet.Spec.ReplicatedJobs {
+		if rJob.Template.Labels[constants.LabelTrainJobAncestor] != constants.AncestorTrainer {
+			continue
+		}
+		for _, container := range rJob.Template.Spec.Template.Spec.Containers {
+			if container.Name == constants.Node {
+				return slices.Concat(container.Command, container.Args)
+			}
+		}
+	}
+	return nil
+}
+
+// totalResources returns the sum of the resource requests across all the Pods of the JobSet.
+// It returns nil if no Pod requests any resources.
+func totalResources(jobSet *jobsetv1alpha2.JobSet) corev1.ResourceList {
+	var total corev1.ResourceList
+	for _, rJob := range jobSet.Spec.ReplicatedJobs {
+		count := int64(rJob.Replicas) * int64(ptr.Deref(rJob.Template.Spec.Parallelism, 1))
+		requests := resourcehelpers.PodRequests(&corev1.Pod{Spec: rJob.Template.Spec.Template.Spec}, resourcehelpers.PodResourcesOptions{})
+		for name, quantity := range requests {
+			if total == nil {
+				total = make(corev1.ResourceList)
+			}
+			quantity.Mul(count)
+			current := total[name]
+			current.Add(quantity)
+			total[name] = current
+		}
+	}
+	return total
+}
+
+// schedulingPreflight returns the message explaining why the TrainJob can never fit the cluster,
+// when its aggregate requests exceed the allocatable resources of the schedulable cluster nodes.
+func (j *JobSet) schedulingPreflight(ctx context.Context, info *runtime.Info) (string, error) {
+	requests := make(corev1.ResourceList)
+	for _, ps := range info.TemplateSpec.PodSets {
+		for name, quantity := range ps.SinglePodRequests {
+			quantity.Mul(int64(ptr.Deref(ps.Count, 1)))
+			current := requests[name]
+			current.Add(quantity)
+			requests[name] = current
+		}
+	}
+	var nodes corev1.NodeList
+	if err := j.client.List(ctx, &nodes); err != nil {
+		return "", err
+	}
+	allocatable := make(corev1.ResourceList)
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		for name, quantity := range node.Status.Allocatable {
+			current := allocatable[name]
+			current.Add(quantity)
+			allocatable[name] = current
+		}
+	}
+	var insufficient []string
+	for _, name := range slices.Sorted(maps.Keys(requests)) {
+		request, available := requests[name], allocatable[name]
+		if request.Cmp(available) > 0 {
+			insufficient = append(insufficient, fmt.Sprintf("%s (requested %s, allocatable %s)", name, request.String(), available.String()))
+		}
+	}
+	if len(insufficient) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%s: %s", constants.TrainJobUnschedulableMessage, strings.Join(insufficient, ", ")), nil
+}
+
+// isLauncherOnlyMode returns true if the MPI runtime runs the training process on the launcher with runLauncherAsNode.
+func isLauncherOnlyMode(info *runtime.Info) bool {
+	mlPolicySource := info.RuntimePolicy.MLPolicySource
+	return mlPolicySource != nil && mlPolicySource.MPI != nil && ptr.Deref(mlPolicySource.MPI.RunLauncherAsNode, false)
+}
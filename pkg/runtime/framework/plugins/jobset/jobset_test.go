@@ -26,8 +26,10 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	batchv1ac "k8s.io/client-go/applyconfigurations/batch/v1"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
@@ -35,10 +37,12 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
@@ -338,11 +342,50 @@ func TestJobSet(t *testing.T) {
 }
 
 func TestValidate(t *testing.T) {
+	initializerInfo := func(name string) *runtime.Info {
+		return &runtime.Info{
+			TemplateSpec: runtime.TemplateSpec{
+				ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+					ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+						{
+							Name: ptr.To(name),
+							Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+								Spec: &batchv1ac.JobSpecApplyConfiguration{
+									Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+										Spec: &corev1ac.PodSpecApplyConfiguration{
+											Containers: []corev1ac.ContainerApplyConfiguration{
+												*corev1ac.Container().
+													WithName(name).
+													WithVolumeMounts(corev1ac.VolumeMount().
+														WithName(jobsetplgconsts.VolumeNameInitializer)),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	nodeInfo := &runtime.Info{TemplateSpec: runtime.TemplateSpec{
+		ObjApply: jobsetv1alpha2ac.JobSetSpec().
+			WithReplicatedJobs(jobsetv1alpha2ac.ReplicatedJob().
+				WithName(constants.Node).
+				WithTemplate(batchv1ac.JobTemplateSpec().
+					WithSpec(batchv1ac.JobSpec().
+						WithTemplate(corev1ac.PodTemplateSpec().
+							WithSpec(corev1ac.PodSpec().
+								WithContainers(corev1ac.Container().WithName(constants.Node))))))),
+	}}
 	cases := map[string]struct {
+		cfg          *configapi.Configuration
 		info         *runtime.Info
 		oldObj       *trainer.TrainJob
 		newObj       *trainer.TrainJob
 		jobSet       *jobsetv1alpha2.JobSet
+		secret       *corev1.Secret
 		clientErr    error
 		wantError    field.ErrorList
 		wantWarnings admission.Warnings
@@ -492,6 +535,41 @@ func TestValidate(t *testing.T) {
 					fmt.Sprintf("must have %s job when trainJob is configured with input modelConfig", constants.ModelInitializer)),
 			},
 		},
+		"must have model initializer container when trainJob is configured with checkpoint": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+						ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+							{
+								Name: ptr.To(constants.Node),
+								Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+									Spec: &batchv1ac.JobSpecApplyConfiguration{
+										Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+											Spec: &corev1ac.PodSpecApplyConfiguration{
+												Containers: []corev1ac.ContainerApplyConfiguration{
+													{
+														Name: ptr.To(constants.Node),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			newObj: utiltesting.MakeTrainJobWrapper("default", "test").
+				Checkpoint(&trainer.CheckpointConfig{
+					StorageUri: ptr.To("s3://checkpoints/step-1000"),
+				}).Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(runtimeRefPath,
+					utiltesting.MakeTrainJobWrapper("default", "test").Obj().Spec.RuntimeRef,
+					fmt.Sprintf("must have container with name - %s in the %s job when trainJob is configured with checkpoint", constants.ModelInitializer, constants.ModelInitializer)),
+			},
+		},
 		"must have container with name - model initializer in the model initializer job": {
 			info: &runtime.Info{
 				TemplateSpec: runtime.TemplateSpec{
@@ -737,6 +815,361 @@ func TestValidate(t *testing.T) {
 					fmt.Sprintf("must have volumeMount with name - %s in container %s of the %s job", jobsetplgconsts.VolumeNameInitializer, constants.ModelInitializer, constants.ModelInitializer)),
 			},
 		},
+		"numNodes must be greater than or equal to 1": {
+			info: &runtime.Info{TemplateSpec: runtime.TemplateSpec{
+				ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{},
+			}},
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(0).Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(numNodesPath, int32(0), "must be greater than or equal to 1 unless the MPI launcher runs as a node"),
+			},
+		},
+		"numNodes=0 passes in the MPI launcher-only mode": {
+			info: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(true)).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{},
+				},
+			},
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(0).Obj()).
+				Obj(),
+		},
+		"negative numNodes fails in the MPI launcher-only mode": {
+			info: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(true)).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{},
+				},
+			},
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(-1).Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(numNodesPath, int32(-1), "must be greater than or equal to 1 unless the MPI launcher runs as a node"),
+			},
+		},
+		"valid numNodes passes": {
+			info: &runtime.Info{TemplateSpec: runtime.TemplateSpec{
+				ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{},
+			}},
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(1).Obj()).
+				Obj(),
+		},
+		"numNodes differing from the runtime mlPolicy returns the override warning": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().WithNumNodes(2).Obj()),
+				runtime.WithTemplateSpecObjApply(&jobsetv1alpha2ac.JobSetSpecApplyConfiguration{}),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(4).Obj()).
+				Obj(),
+			wantWarnings: admission.Warnings{
+				"spec.trainer.numNodes (4) differs from the runtime mlPolicy.numNodes (2); the TrainJob value overrides the runtime",
+			},
+		},
+		"numNodes equal to the runtime mlPolicy passes without warnings": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().WithNumNodes(2).Obj()),
+				runtime.WithTemplateSpecObjApply(&jobsetv1alpha2ac.JobSetSpecApplyConfiguration{}),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(2).Obj()).
+				Obj(),
+		},
+		"numProcPerNode must be 1 for the runtime with singleProcessPerNode": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().WithSingleProcessPerNode(true).Obj()),
+				runtime.WithTemplateSpecObjApply(&jobsetv1alpha2ac.JobSetSpecApplyConfiguration{}),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumProcPerNode(4).Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(numProcPerNodePath, int32(4), "must be 1 for the runtime with singleProcessPerNode"),
+			},
+		},
+		"numProcPerNode of 1 passes for the runtime with singleProcessPerNode": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().WithSingleProcessPerNode(true).Obj()),
+				runtime.WithTemplateSpecObjApply(&jobsetv1alpha2ac.JobSetSpecApplyConfiguration{}),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumProcPerNode(1).Obj()).
+				Obj(),
+		},
+		"numProcPerNode greater than 1 passes for the runtime without singleProcessPerNode": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().Obj()),
+				runtime.WithTemplateSpecObjApply(&jobsetv1alpha2ac.JobSetSpecApplyConfiguration{}),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumProcPerNode(4).Obj()).
+				Obj(),
+		},
+		"resourcesPerContainer must reference the trainer node containers": {
+			info: &runtime.Info{TemplateSpec: runtime.TemplateSpec{
+				ObjApply: jobsetv1alpha2ac.JobSetSpec().
+					WithReplicatedJobs(jobsetv1alpha2ac.ReplicatedJob().
+						WithName(constants.Node).
+						WithTemplate(batchv1ac.JobTemplateSpec().
+							WithSpec(batchv1ac.JobSpec().
+								WithTemplate(corev1ac.PodTemplateSpec().
+									WithSpec(corev1ac.PodSpec().
+										WithInitContainers(corev1ac.Container().WithName("sidecar")).
+										WithContainers(corev1ac.Container().WithName(constants.Node))))))),
+			}},
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					ResourcesPerContainer(constants.Node, corev1.ResourceRequirements{}).
+					ResourcesPerContainer("sidecar", corev1.ResourceRequirements{}).
+					ResourcesPerContainer("metrics", corev1.ResourceRequirements{}).
+					Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.NotFound(resourcesPerContainerPath.Key("metrics"), "metrics"),
+			},
+		},
+		"fractional GPUs are invalid": {
+			info: nodeInfo,
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("500m"),
+						"nvidia.com/gpu":   resource.MustParse("0.5"),
+					}).
+					ResourcesPerContainer(constants.Node, corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{"amd.com/gpu": resource.MustParse("1500m")},
+					}).
+					Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(resourcesPerNodePath.Child("requests").Key("nvidia.com/gpu"), "500m",
+					"must be a whole number of GPUs unless the GPU sharing is enabled"),
+				field.Invalid(resourcesPerContainerPath.Key(constants.Node).Child("limits").Key("amd.com/gpu"), "1500m",
+					"must be a whole number of GPUs unless the GPU sharing is enabled"),
+			},
+		},
+		"whole GPUs are valid": {
+			info: nodeInfo,
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, corev1.ResourceList{
+						"nvidia.com/gpu": resource.MustParse("2"),
+					}).
+					Obj()).
+				Obj(),
+		},
+		"fractional GPUs are valid when the GPU sharing is enabled": {
+			cfg: &configapi.Configuration{
+				Resources: &configapi.Resources{GPUSharing: ptr.To(true)},
+			},
+			info: nodeInfo,
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, corev1.ResourceList{
+						"nvidia.com/gpu": resource.MustParse("0.5"),
+					}).
+					Obj()).
+				Obj(),
+		},
+		"must have the dataset initializer secret referenced by secretRef": {
+			info: initializerInfo(constants.DatasetInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Dataset: &trainer.DatasetInitializer{
+						StorageUri: ptr.To("hf://dataset"),
+						SecretRef:  &corev1.LocalObjectReference{Name: "dataset-secret"},
+					},
+				}).Obj(),
+			wantError: field.ErrorList{
+				field.NotFound(initializerPath.Child("dataset", "secretRef", "name"), "dataset-secret"),
+			},
+		},
+		"must have the expected key in the dataset initializer secret for hf storageUri": {
+			info: initializerInfo(constants.DatasetInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Dataset: &trainer.DatasetInitializer{
+						StorageUri: ptr.To("hf://dataset"),
+						SecretRef:  &corev1.LocalObjectReference{Name: "dataset-secret"},
+					},
+				}).Obj(),
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "dataset-secret", Namespace: metav1.NamespaceDefault},
+				Data:       map[string][]byte{"TOKEN": []byte("token")},
+			},
+			wantError: field.ErrorList{
+				field.Invalid(initializerPath.Child("dataset", "secretRef", "name"), "dataset-secret",
+					fmt.Sprintf("secret must have the %s key for the hf storageUri", jobsetplgconsts.InitializerEnvAccessToken)),
+			},
+		},
+		"valid model initializer secret with the expected keys for s3 storageUri passes": {
+			info: initializerInfo(constants.ModelInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Model: &trainer.ModelInitializer{
+						StorageUri: ptr.To("s3://bucket/model"),
+						SecretRef:  &corev1.LocalObjectReference{Name: "model-secret"},
+					},
+				}).Obj(),
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "model-secret", Namespace: metav1.NamespaceDefault},
+				Data: map[string][]byte{
+					jobsetplgconsts.InitializerEnvAccessKeyID:     []byte("id"),
+					jobsetplgconsts.InitializerEnvSecretAccessKey: []byte("key"),
+				},
+			},
+		},
+		"dataset initializer storageUri with unsupported scheme": {
+			info: initializerInfo(constants.DatasetInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Dataset: &trainer.DatasetInitializer{
+						StorageUri: ptr.To("ftp://example.com/dataset.tar.gz"),
+					},
+				}).Obj(),
+			wantError: field.ErrorList{
+				field.NotSupported(initializerPath.Child("dataset", "storageUri"), "ftp://example.com/dataset.tar.gz",
+					[]string{"az", "cache", "hf", "http", "https", "s3"}),
+			},
+		},
+		"dataset initializer datasets storageUri with unsupported scheme": {
+			info: initializerInfo(constants.DatasetInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Dataset: utiltesting.MakeTrainJobDatasetInitializerWrapper().
+						Datasets(
+							trainer.DatasetSource{StorageUri: "hf://my-org/train", MountPath: "/data/train"},
+							trainer.DatasetSource{StorageUri: "ftp://example.com/eval.tar.gz", MountPath: "/data/eval"},
+						).Obj(),
+				}).Obj(),
+			wantError: field.ErrorList{
+				field.NotSupported(initializerPath.Child("dataset", "datasets").Index(1).Child("storageUri"), "ftp://example.com/eval.tar.gz",
+					[]string{"az", "cache", "hf", "http", "https", "s3"}),
+			},
+		},
+		"dataset initializer gs storageUri is not supported": {
+			info: initializerInfo(constants.DatasetInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Dataset: &trainer.DatasetInitializer{
+						StorageUri: ptr.To("gs://bucket/dataset"),
+					},
+				}).Obj(),
+			wantError: field.ErrorList{
+				field.NotSupported(initializerPath.Child("dataset", "storageUri"), "gs://bucket/dataset",
+					[]string{"az", "cache", "hf", "http", "https", "s3"}),
+			},
+		},
+		"dataset initializer az storageUri passes": {
+			info: initializerInfo(constants.DatasetInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Dataset: &trainer.DatasetInitializer{
+						StorageUri: ptr.To("az://container/dataset"),
+					},
+				}).Obj(),
+		},
+		"dataset initializer https storageUri passes": {
+			info: initializerInfo(constants.DatasetInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Dataset: &trainer.DatasetInitializer{
+						StorageUri: ptr.To("https://example.com/dataset.zip"),
+					},
+				}).Obj(),
+		},
+		"must have the bucket in the model initializer gs storageUri": {
+			info: initializerInfo(constants.ModelInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Model: &trainer.ModelInitializer{
+						StorageUri: ptr.To("gs:///model"),
+					},
+				}).Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(initializerPath.Child("model", "storageUri"), "gs:///model",
+					"must have the bucket for the gs storageUri"),
+			},
+		},
+		"must have the container in the model initializer az storageUri": {
+			info: initializerInfo(constants.ModelInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Model: &trainer.ModelInitializer{
+						StorageUri: ptr.To("az:///model"),
+					},
+				}).Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(initializerPath.Child("model", "storageUri"), "az:///model",
+					"must have the container for the az storageUri"),
+			},
+		},
+		"model initializer secret must have the Azure storage account keys for az storageUri": {
+			info: initializerInfo(constants.ModelInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Model: &trainer.ModelInitializer{
+						StorageUri: ptr.To("az://container/model"),
+						SecretRef:  &corev1.LocalObjectReference{Name: "model-secret"},
+					},
+				}).Obj(),
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "model-secret", Namespace: metav1.NamespaceDefault},
+				Data: map[string][]byte{
+					jobsetplgconsts.InitializerEnvAzureStorageAccountName: []byte("account"),
+				},
+			},
+			wantError: field.ErrorList{
+				field.Invalid(initializerPath.Child("model", "secretRef", "name"), "model-secret",
+					"secret must have the AZURE_STORAGE_ACCOUNT_KEY key for the az storageUri"),
+			},
+		},
+		"valid model initializer secret with the expected key for gs storageUri passes": {
+			info: initializerInfo(constants.ModelInitializer),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Model: &trainer.ModelInitializer{
+						StorageUri: ptr.To("gs://bucket/model"),
+						SecretRef:  &corev1.LocalObjectReference{Name: "model-secret"},
+					},
+				}).Obj(),
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "model-secret", Namespace: metav1.NamespaceDefault},
+				Data: map[string][]byte{
+					jobsetplgconsts.InitializerEnvServiceAccountKey: []byte("{}"),
+				},
+			},
+		},
+		"initializer secretRef is not checked on update": {
+			info: initializerInfo(constants.ModelInitializer),
+			oldObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Model: &trainer.ModelInitializer{
+						StorageUri: ptr.To("hf://model"),
+						SecretRef:  &corev1.LocalObjectReference{Name: "model-secret"},
+					},
+				}).Obj(),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Initializer(&trainer.Initializer{
+					Model: &trainer.ModelInitializer{
+						StorageUri: ptr.To("hf://model"),
+						SecretRef:  &corev1.LocalObjectReference{Name: "model-secret"},
+					},
+				}).Obj(),
+		},
 		"runtimePatches contain invalid replicated job": {
 			info: &runtime.Info{
 				TemplateSpec: runtime.TemplateSpec{
@@ -1047,7 +1480,7 @@ func TestValidate(t *testing.T) {
 					fmt.Sprintf("must not have envs for the %s, %s, %s containers", constants.DatasetInitializer, constants.ModelInitializer, constants.Node)),
 			},
 		},
-		"allow runtimePatches when creating a new trainJob": {
+		"runtimePatches contain restartPolicy for container": {
 			info: &runtime.Info{
 				TemplateSpec: runtime.TemplateSpec{
 					ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
@@ -1072,8 +1505,7 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			oldObj: nil,
-			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+			newObj: utiltesting.MakeTrainJobWrapper("default", "test").
 				RuntimePatches([]trainer.RuntimePatch{
 					{
 						Manager: "test.io/manager",
@@ -1086,7 +1518,12 @@ func TestValidate(t *testing.T) {
 											Spec: &trainer.JobSpecPatch{
 												Template: &trainer.PodTemplatePatch{
 													Spec: &trainer.PodSpecPatch{
-														ServiceAccountName: ptr.To("service-account"),
+														Containers: []trainer.ContainerPatch{
+															{
+																Name:          constants.Node,
+																RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+															},
+														},
 													},
 												},
 											},
@@ -1096,11 +1533,41 @@ func TestValidate(t *testing.T) {
 							},
 						},
 					},
-				}).
-				Obj(),
-			wantError: nil,
+				}).Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(runtimePatchesPath,
+					[]trainer.RuntimePatch{
+						{
+							Manager: "test.io/manager",
+							TrainingRuntimeSpec: &trainer.TrainingRuntimeSpecPatch{
+								Template: &trainer.JobSetTemplatePatch{
+									Spec: &trainer.JobSetSpecPatch{
+										ReplicatedJobs: []trainer.ReplicatedJobPatch{{
+											Name: constants.Node,
+											Template: &trainer.JobTemplatePatch{
+												Spec: &trainer.JobSpecPatch{
+													Template: &trainer.PodTemplatePatch{
+														Spec: &trainer.PodSpecPatch{
+															Containers: []trainer.ContainerPatch{
+																{
+																	Name:          constants.Node,
+																	RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+																},
+															},
+														},
+													},
+												},
+											},
+										}},
+									},
+								},
+							},
+						},
+					},
+					fmt.Sprintf("must not have restartPolicy for container %s, only init containers can be sidecars", constants.Node)),
+			},
 		},
-		"allow updates to trainJob with no changes to runtimePatches": {
+		"allow runtimePatches when creating a new trainJob": {
 			info: &runtime.Info{
 				TemplateSpec: runtime.TemplateSpec{
 					ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
@@ -1125,7 +1592,8 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			oldObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+			oldObj: nil,
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
 				RuntimePatches([]trainer.RuntimePatch{
 					{
 						Manager: "test.io/manager",
@@ -1150,7 +1618,59 @@ func TestValidate(t *testing.T) {
 					},
 				}).
 				Obj(),
-			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+			wantError: nil,
+		},
+		"allow updates to trainJob with no changes to runtimePatches": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{
+						ReplicatedJobs: []jobsetv1alpha2ac.ReplicatedJobApplyConfiguration{
+							{
+								Name: ptr.To(constants.Node),
+								Template: &batchv1ac.JobTemplateSpecApplyConfiguration{
+									Spec: &batchv1ac.JobSpecApplyConfiguration{
+										Template: &corev1ac.PodTemplateSpecApplyConfiguration{
+											Spec: &corev1ac.PodSpecApplyConfiguration{
+												Containers: []corev1ac.ContainerApplyConfiguration{
+													{
+														Name: ptr.To(constants.Node),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			oldObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimePatches([]trainer.RuntimePatch{
+					{
+						Manager: "test.io/manager",
+						TrainingRuntimeSpec: &trainer.TrainingRuntimeSpecPatch{
+							Template: &trainer.JobSetTemplatePatch{
+								Spec: &trainer.JobSetSpecPatch{
+									ReplicatedJobs: []trainer.ReplicatedJobPatch{{
+										Name: constants.Node,
+										Template: &trainer.JobTemplatePatch{
+											Spec: &trainer.JobSpecPatch{
+												Template: &trainer.PodTemplatePatch{
+													Spec: &trainer.PodSpecPatch{
+														ServiceAccountName: ptr.To("service-account"),
+													},
+												},
+											},
+										},
+									}},
+								},
+							},
+						},
+					},
+				}).
+				Obj(),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
 				RuntimePatches([]trainer.RuntimePatch{
 					{
 						Manager: "test.io/manager",
@@ -2031,6 +2551,9 @@ func TestValidate(t *testing.T) {
 			if tc.jobSet != nil {
 				clientBuilder = clientBuilder.WithObjects(tc.jobSet)
 			}
+			if tc.secret != nil {
+				clientBuilder = clientBuilder.WithObjects(tc.secret)
+			}
 			if tc.clientErr != nil {
 				clientBuilder = clientBuilder.WithInterceptorFuncs(interceptor.Funcs{
 					Get: func(ctx context.Context, cli client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
@@ -2043,7 +2566,7 @@ func TestValidate(t *testing.T) {
 			}
 			cli := clientBuilder.Build()
 
-			p, err := New(ctx, cli, nil, nil)
+			p, err := New(ctx, cli, nil, tc.cfg)
 			if err != nil {
 				t.Fatalf("Failed to initialize JobSet plugin: %v", err)
 			}
@@ -2063,6 +2586,7 @@ func TestBuild(t *testing.T) {
 	cases := map[string]struct {
 		info      *runtime.Info
 		trainJob  *trainer.TrainJob
+		objs      []client.Object
 		wantObjs  []apiruntime.Object
 		wantError error
 	}{
@@ -2387,6 +2911,378 @@ func TestBuild(t *testing.T) {
 				},
 			},
 		},
+		"running JobSet is not re-rendered": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:       constants.Node,
+						Containers: []runtime.Container{{Name: constants.Node, Image: "train:hotfix"}},
+					}},
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(jobsetv1alpha2ac.ReplicatedJob().
+							WithName(constants.Node).
+							WithTemplate(batchv1ac.JobTemplateSpec().
+								WithSpec(batchv1ac.JobSpec().
+									WithTemplate(corev1ac.PodTemplateSpec().
+										WithSpec(corev1ac.PodSpec().
+											WithContainers(corev1ac.Container().WithName(constants.Node)),
+										),
+									),
+								),
+							),
+						),
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Suspend(false).
+				Obj(),
+			objs: []client.Object{
+				utiltesting.MakeJobSetWrapper(metav1.NamespaceDefault, "trainJob").
+					Suspend(false).
+					Obj(),
+			},
+		},
+		"running JobSet is not re-rendered when the force-rerender annotation is unchanged": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:       constants.Node,
+						Containers: []runtime.Container{{Name: constants.Node, Image: "train:hotfix"}},
+					}},
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(jobsetv1alpha2ac.ReplicatedJob().
+							WithName(constants.Node).
+							WithTemplate(batchv1ac.JobTemplateSpec().
+								WithSpec(batchv1ac.JobSpec().
+									WithTemplate(corev1ac.PodTemplateSpec().
+										WithSpec(corev1ac.PodSpec().
+											WithContainers(corev1ac.Container().WithName(constants.Node)),
+										),
+									),
+								),
+							),
+						),
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Annotation(constants.AnnotationForceRerender, "hotfix-1").
+				Suspend(false).
+				Obj(),
+			objs: []client.Object{
+				utiltesting.MakeJobSetWrapper(metav1.NamespaceDefault, "trainJob").
+					Annotation(constants.AnnotationForceRerender, "hotfix-1").
+					Suspend(false).
+					Obj(),
+			},
+		},
+		"running JobSet is not re-rendered until it is suspended when the force-rerender annotation changes": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:       constants.Node,
+						Containers: []runtime.Container{{Name: constants.Node, Image: "train:hotfix"}},
+					}},
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(jobsetv1alpha2ac.ReplicatedJob().
+							WithName(constants.Node).
+							WithTemplate(batchv1ac.JobTemplateSpec().
+								WithSpec(batchv1ac.JobSpec().
+									WithTemplate(corev1ac.PodTemplateSpec().
+										WithSpec(corev1ac.PodSpec().
+											WithContainers(corev1ac.Container().WithName(constants.Node)),
+										),
+									),
+								),
+							),
+						),
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Annotation(constants.AnnotationForceRerender, "hotfix-2").
+				Suspend(false).
+				Obj(),
+			objs: []client.Object{
+				utiltesting.MakeJobSetWrapper(metav1.NamespaceDefault, "trainJob").
+					Annotation(constants.AnnotationForceRerender, "hotfix-1").
+					Suspend(false).
+					Obj(),
+			},
+		},
+		"suspended JobSet is re-rendered with the changed force-rerender annotation": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:       constants.Node,
+						Containers: []runtime.Container{{Name: constants.Node, Image: "train:hotfix"}},
+					}},
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(jobsetv1alpha2ac.ReplicatedJob().
+							WithName(constants.Node).
+							WithTemplate(batchv1ac.JobTemplateSpec().
+								WithSpec(batchv1ac.JobSpec().
+									WithTemplate(corev1ac.PodTemplateSpec().
+										WithSpec(corev1ac.PodSpec().
+											WithContainers(corev1ac.Container().WithName(constants.Node)),
+										),
+									),
+								),
+							),
+						),
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Annotation(constants.AnnotationForceRerender, "hotfix-2").
+				Suspend(true).
+				Obj(),
+			objs: []client.Object{
+				utiltesting.MakeJobSetWrapper(metav1.NamespaceDefault, "trainJob").
+					Annotation(constants.AnnotationForceRerender, "hotfix-1").
+					Suspend(true).
+					Obj(),
+			},
+			wantObjs: []apiruntime.Object{
+				&jobsetv1alpha2.JobSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "trainJob",
+						Namespace: metav1.NamespaceDefault,
+						Annotations: map[string]string{
+							constants.AnnotationForceRerender: "hotfix-2",
+						},
+						OwnerReferences: []metav1.OwnerReference{
+							{APIVersion: trainer.GroupVersion.String(), Kind: trainer.TrainJobKind, Name: "trainJob", Controller: ptr.To(true)},
+						},
+					},
+					Spec: jobsetv1alpha2.JobSetSpec{
+						ReplicatedJobs: []jobsetv1alpha2.ReplicatedJob{
+							{
+								Name: constants.Node,
+								Template: batchv1.JobTemplateSpec{
+									Spec: batchv1.JobSpec{
+										Template: corev1.PodTemplateSpec{
+											Spec: corev1.PodSpec{
+												Containers: []corev1.Container{
+													{Name: constants.Node, Image: "train:hotfix"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						Suspend: ptr.To(true),
+					},
+				},
+			},
+		},
+		"suspended JobSet created without the dependsOn is resumed without the initializer dependencies": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.DatasetInitializer).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithLabels(map[string]string{constants.LabelTrainJobAncestor: constants.DatasetInitializer}).
+									WithSpec(batchv1ac.JobSpec().
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec()),
+										),
+									),
+								),
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.Node).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithLabels(map[string]string{constants.LabelTrainJobAncestor: constants.AncestorTrainer}).
+									WithSpec(batchv1ac.JobSpec().
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec()),
+										),
+									),
+								),
+						),
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Suspend(false).
+				Obj(),
+			objs: []client.Object{
+				utiltesting.MakeJobSetWrapper(metav1.NamespaceDefault, "trainJob").
+					Suspend(true).
+					Obj(),
+			},
+			wantObjs: []apiruntime.Object{
+				&jobsetv1alpha2.JobSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "trainJob",
+						Namespace: metav1.NamespaceDefault,
+						OwnerReferences: []metav1.OwnerReference{
+							{APIVersion: trainer.GroupVersion.String(), Kind: trainer.TrainJobKind, Name: "trainJob", Controller: ptr.To(true)},
+						},
+					},
+					Spec: jobsetv1alpha2.JobSetSpec{
+						ReplicatedJobs: []jobsetv1alpha2.ReplicatedJob{
+							{
+								Name:     constants.DatasetInitializer,
+								Replicas: 1,
+								Template: batchv1.JobTemplateSpec{
+									ObjectMeta: metav1.ObjectMeta{
+										Labels: map[string]string{constants.LabelTrainJobAncestor: constants.DatasetInitializer},
+									},
+								},
+							},
+							{
+								Name:     constants.Node,
+								Replicas: 1,
+								Template: batchv1.JobTemplateSpec{
+									ObjectMeta: metav1.ObjectMeta{
+										Labels: map[string]string{constants.LabelTrainJobAncestor: constants.AncestorTrainer},
+									},
+								},
+							},
+						},
+						Suspend: ptr.To(false),
+					},
+				},
+			},
+		},
+		"suspended JobSet with the dependsOn is resumed with the initializer dependencies": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.DatasetInitializer).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithLabels(map[string]string{constants.LabelTrainJobAncestor: constants.DatasetInitializer}).
+									WithSpec(batchv1ac.JobSpec().
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec()),
+										),
+									),
+								),
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.Node).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithLabels(map[string]string{constants.LabelTrainJobAncestor: constants.AncestorTrainer}).
+									WithSpec(batchv1ac.JobSpec().
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec()),
+										),
+									),
+								),
+						),
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Suspend(false).
+				Obj(),
+			objs: []client.Object{
+				utiltesting.MakeJobSetWrapper(metav1.NamespaceDefault, "trainJob").
+					InitializerDependsOn(constants.Node).
+					Suspend(true).
+					Obj(),
+			},
+			wantObjs: []apiruntime.Object{
+				&jobsetv1alpha2.JobSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "trainJob",
+						Namespace: metav1.NamespaceDefault,
+						OwnerReferences: []metav1.OwnerReference{
+							{APIVersion: trainer.GroupVersion.String(), Kind: trainer.TrainJobKind, Name: "trainJob", Controller: ptr.To(true)},
+						},
+					},
+					Spec: jobsetv1alpha2.JobSetSpec{
+						ReplicatedJobs: []jobsetv1alpha2.ReplicatedJob{
+							{
+								Name:     constants.DatasetInitializer,
+								Replicas: 1,
+								Template: batchv1.JobTemplateSpec{
+									ObjectMeta: metav1.ObjectMeta{
+										Labels: map[string]string{constants.LabelTrainJobAncestor: constants.DatasetInitializer},
+									},
+								},
+							},
+							{
+								Name:     constants.Node,
+								Replicas: 1,
+								Template: batchv1.JobTemplateSpec{
+									ObjectMeta: metav1.ObjectMeta{
+										Labels: map[string]string{constants.LabelTrainJobAncestor: constants.AncestorTrainer},
+									},
+								},
+								DependsOn: []jobsetv1alpha2.DependsOn{
+									{Name: constants.DatasetInitializer, Status: jobsetv1alpha2.DependencyComplete},
+								},
+							},
+						},
+						Suspend: ptr.To(false),
+					},
+				},
+			},
+		},
+		"client version annotation is propagated to JobSet": {
+			info: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(jobsetv1alpha2ac.ReplicatedJob().
+							WithName(constants.Node).
+							WithTemplate(batchv1ac.JobTemplateSpec().
+								WithSpec(batchv1ac.JobSpec().
+									WithTemplate(corev1ac.PodTemplateSpec().
+										WithSpec(corev1ac.PodSpec().
+											WithContainers(corev1ac.Container().WithName(constants.Node)),
+										),
+									),
+								),
+							),
+						),
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Annotation(constants.AnnotationClientVersion, "kubeflow-sdk/0.2.0").
+				Obj(),
+			wantObjs: []apiruntime.Object{
+				&jobsetv1alpha2.JobSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "trainJob",
+						Namespace: metav1.NamespaceDefault,
+						Annotations: map[string]string{
+							constants.AnnotationClientVersion: "kubeflow-sdk/0.2.0",
+						},
+						OwnerReferences: []metav1.OwnerReference{
+							{APIVersion: trainer.GroupVersion.String(), Kind: trainer.TrainJobKind, Name: "trainJob", Controller: ptr.To(true)},
+						},
+					},
+					Spec: jobsetv1alpha2.JobSetSpec{
+						ReplicatedJobs: []jobsetv1alpha2.ReplicatedJob{
+							{
+								Name: constants.Node,
+								Template: batchv1.JobTemplateSpec{
+									Spec: batchv1.JobSpec{
+										Template: corev1.PodTemplateSpec{
+											Spec: corev1.PodSpec{
+												Containers: []corev1.Container{
+													{Name: constants.Node},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -2394,7 +3290,7 @@ func TestBuild(t *testing.T) {
 			var cancel func()
 			ctx, cancel = context.WithCancel(ctx)
 			t.Cleanup(cancel)
-			cli := utiltesting.NewClientBuilder().Build()
+			cli := utiltesting.NewClientBuilder().WithObjects(tc.objs...).Build()
 			p, err := New(ctx, cli, nil, nil)
 			if err != nil {
 				t.Fatalf("Failed to initialize JobSet plugin: %v", err)
@@ -2757,3 +3653,336 @@ func TestSyncParallelCount(t *testing.T) {
 		})
 	}
 }
+
+func TestImagePullFailedCondition(t *testing.T) {
+	makePod := func(name, jobSetName string, containerStatuses ...corev1.ContainerStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels:    map[string]string{jobsetv1alpha2.JobSetNameKey: jobSetName},
+			},
+			Status: corev1.PodStatus{ContainerStatuses: containerStatuses},
+		}
+	}
+	waiting := func(name, image, reason, message string) corev1.ContainerStatus {
+		return corev1.ContainerStatus{
+			Name:  name,
+			Image: image,
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason, Message: message}},
+		}
+	}
+
+	cases := map[string]struct {
+		pods          []client.Object
+		wantCondition *metav1.Condition
+	}{
+		"no pods": {},
+		"pods pulled the images": {
+			pods: []client.Object{
+				makePod("trainjob-node-0-0", "trainjob", corev1.ContainerStatus{
+					Name:  constants.Node,
+					Image: "docker.io/trainer:latest",
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				}),
+				makePod("trainjob-node-0-1", "trainjob", waiting(constants.Node, "docker.io/trainer:latest", "ContainerCreating", "")),
+			},
+		},
+		"pod backing off the image pull": {
+			pods: []client.Object{
+				makePod("trainjob-node-0-1", "trainjob", waiting(constants.Node, "docker.io/trainer:missing", trainer.TrainJobImagePullBackOffReason, `Back-off pulling image "docker.io/trainer:missing"`)),
+				makePod("trainjob-node-0-0", "trainjob", waiting(constants.Node, "docker.io/trainer:missing", trainer.TrainJobErrImagePullReason, "")),
+			},
+			wantCondition: &metav1.Condition{
+				Type:    trainer.TrainJobImagePullFailed,
+				Status:  metav1.ConditionTrue,
+				Reason:  trainer.TrainJobErrImagePullReason,
+				Message: "container node in pod trainjob-node-0-0 failed to pull image docker.io/trainer:missing",
+			},
+		},
+		"pod of another TrainJob failing the image pull": {
+			pods: []client.Object{
+				makePod("other-node-0-0", "other", waiting(constants.Node, "docker.io/trainer:missing", trainer.TrainJobImagePullBackOffReason, "")),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			cli := utiltesting.NewClientBuilder().WithObjects(tc.pods...).Build()
+			j := &JobSet{client: cli}
+			trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainjob").Obj()
+
+			got, err := j.imagePullFailedCondition(ctx, trainJob)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantCondition, got); len(diff) != 0 {
+				t.Errorf("Unexpected condition (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCopyNumProcPerNodeLabels(t *testing.T) {
+	makePod := func(name, jobSetName, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   metav1.NamespaceDefault,
+				Labels:      map[string]string{jobsetv1alpha2.JobSetNameKey: jobSetName},
+				Annotations: map[string]string{constants.AnnotationNumProcPerNodeNodeLabel: "nvidia.com/gpu.count"},
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+		}
+	}
+	makeNode := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	labeledPod := makePod("trainjob-node-0-1", "trainjob", "gpu-node-b")
+	labeledPod.Labels[constants.LabelNumProcPerNode] = "4"
+	podWithoutAnnotation := makePod("trainjob-dataset-initializer-0-0", "trainjob", "gpu-node-a")
+	podWithoutAnnotation.Annotations = nil
+
+	cases := map[string]struct {
+		objs       []client.Object
+		wantLabels map[string]string
+	}{
+		"scheduled pod gets the node label": {
+			objs: []client.Object{
+				makeNode("gpu-node-a", map[string]string{"nvidia.com/gpu.count": "8"}),
+				makePod("trainjob-node-0-0", "trainjob", "gpu-node-a"),
+			},
+			wantLabels: map[string]string{
+				"trainjob-node-0-0": "8",
+			},
+		},
+		"pending pod, pod already labeled, and pod without the annotation are not modified": {
+			objs: []client.Object{
+				makeNode("gpu-node-a", map[string]string{"nvidia.com/gpu.count": "8"}),
+				makeNode("gpu-node-b", map[string]string{"nvidia.com/gpu.count": "8"}),
+				makePod("trainjob-node-0-0", "trainjob", ""),
+				labeledPod,
+				podWithoutAnnotation,
+			},
+			wantLabels: map[string]string{
+				"trainjob-node-0-1": "4",
+			},
+		},
+		"pod scheduled to the node without the label is not modified": {
+			objs: []client.Object{
+				makeNode("gpu-node-a", nil),
+				makePod("trainjob-node-0-0", "trainjob", "gpu-node-a"),
+			},
+			wantLabels: map[string]string{},
+		},
+		"pod of another TrainJob is not modified": {
+			objs: []client.Object{
+				makeNode("gpu-node-a", map[string]string{"nvidia.com/gpu.count": "8"}),
+				makePod("other-node-0-0", "other", "gpu-node-a"),
+			},
+			wantLabels: map[string]string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			cli := utiltesting.NewClientBuilder().WithObjects(tc.objs...).Build()
+			j := &JobSet{client: cli}
+			trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainjob").Obj()
+
+			if err := j.copyNumProcPerNodeLabels(ctx, trainJob); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var pods corev1.PodList
+			if err := cli.List(ctx, &pods); err != nil {
+				t.Fatalf("Failed to list Pods: %v", err)
+			}
+			gotLabels := map[string]string{}
+			for _, pod := range pods.Items {
+				if value, ok := pod.Labels[constants.LabelNumProcPerNode]; ok {
+					gotLabels[pod.Name] = value
+				}
+			}
+			if diff := cmp.Diff(tc.wantLabels, gotLabels); len(diff) != 0 {
+				t.Errorf("Unexpected Pod labels (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSpotFallbackCondition(t *testing.T) {
+	makePod := func(name, replicatedJobName string, unschedulable bool) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels: map[string]string{
+					jobsetv1alpha2.JobSetNameKey:        "trainjob",
+					jobsetv1alpha2.ReplicatedJobNameKey: replicatedJobName,
+				},
+			},
+		}
+		if unschedulable {
+			pod.Status.Conditions = []corev1.PodCondition{{
+				Type:   corev1.PodScheduled,
+				Status: corev1.ConditionFalse,
+				Reason: corev1.PodReasonUnschedulable,
+			}}
+		}
+		return pod
+	}
+	jobSet := &jobsetv1alpha2.JobSet{
+		Spec: jobsetv1alpha2.JobSetSpec{
+			ReplicatedJobs: []jobsetv1alpha2.ReplicatedJob{
+				{
+					Name: constants.DatasetInitializer,
+					Template: batchv1.JobTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{constants.LabelTrainJobAncestor: constants.DatasetInitializer}},
+					},
+				},
+				{
+					Name: constants.Node,
+					Template: batchv1.JobTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{constants.LabelTrainJobAncestor: constants.AncestorTrainer}},
+					},
+				},
+			},
+		},
+	}
+	wantFallback := &metav1.Condition{
+		Type:    trainer.TrainJobSpotFallback,
+		Status:  metav1.ConditionTrue,
+		Reason:  trainer.TrainJobSpotSchedulingFailedReason,
+		Message: constants.TrainJobSpotFallbackMessage,
+	}
+
+	cases := map[string]struct {
+		spot          *trainer.Spot
+		pods          []client.Object
+		wantCondition *metav1.Condition
+	}{
+		"spot without fallback": {
+			spot: &trainer.Spot{NodeLabels: map[string]string{"spot": "true"}},
+			pods: []client.Object{
+				makePod("trainjob-node-0-0", constants.Node, true),
+			},
+		},
+		"trainer pods below the scheduling failures": {
+			spot: &trainer.Spot{NodeLabels: map[string]string{"spot": "true"}, FallbackAfterSchedulingFailures: ptr.To[int32](2)},
+			pods: []client.Object{
+				makePod("trainjob-node-0-0", constants.Node, true),
+				makePod("trainjob-node-0-1", constants.Node, false),
+				makePod("trainjob-dataset-initializer-0-0", constants.DatasetInitializer, true),
+			},
+		},
+		"unschedulable initializer pods are not counted in the scheduling failures": {
+			spot: &trainer.Spot{NodeLabels: map[string]string{"spot": "true"}, FallbackAfterSchedulingFailures: ptr.To[int32](2)},
+			pods: []client.Object{
+				makePod("trainjob-node-0-0", constants.Node, true),
+				makePod("trainjob-dataset-initializer-0-0", constants.DatasetInitializer, true),
+				makePod("trainjob-dataset-initializer-0-1", constants.DatasetInitializer, true),
+			},
+		},
+		"trainer pods reaching the scheduling failures": {
+			spot: &trainer.Spot{NodeLabels: map[string]string{"spot": "true"}, FallbackAfterSchedulingFailures: ptr.To[int32](2)},
+			pods: []client.Object{
+				makePod("trainjob-node-0-0", constants.Node, true),
+				makePod("trainjob-node-0-1", constants.Node, true),
+			},
+			wantCondition: wantFallback,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			cli := utiltesting.NewClientBuilder().WithObjects(tc.pods...).Build()
+			j := &JobSet{client: cli}
+			trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainjob").Obj()
+			trainJob.Spec.Trainer = &trainer.Trainer{Spot: tc.spot}
+
+			got, err := j.spotFallbackCondition(ctx, trainJob, jobSet)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantCondition, got); len(diff) != 0 {
+				t.Errorf("Unexpected condition (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSchedulingPreflightFailedRequests(t *testing.T) {
+	cases := map[string]struct {
+		jobSets      []client.Object
+		wantRequests []reconcile.Request
+	}{
+		"no JobSets": {},
+		"only the JobSets kept suspended by the scheduling preflight are requeued": {
+			jobSets: []client.Object{
+				utiltesting.MakeJobSetWrapper("team-a", "blocked").
+					Annotation(constants.AnnotationSchedulingPreflightFailed, constants.TrainJobUnschedulableMessage).
+					Obj(),
+				utiltesting.MakeJobSetWrapper("team-a", "running").Obj(),
+			},
+			wantRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "blocked"}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			cli := utiltesting.NewClientBuilder().WithObjects(tc.jobSets...).Build()
+			j := &JobSet{client: cli}
+
+			got := j.schedulingPreflightFailedRequests(ctx, &corev1.Node{})
+			if diff := cmp.Diff(tc.wantRequests, got); len(diff) != 0 {
+				t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNodeCapacityChanged(t *testing.T) {
+	makeNode := func(cpu string, unschedulable bool) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node"},
+			Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+			},
+		}
+	}
+	cases := map[string]struct {
+		oldNode *corev1.Node
+		newNode *corev1.Node
+		want    bool
+	}{
+		"unchanged Node": {
+			oldNode: makeNode("8", false),
+			newNode: makeNode("8", false),
+		},
+		"allocatable resources are changed": {
+			oldNode: makeNode("8", false),
+			newNode: makeNode("16", false),
+			want:    true,
+		},
+		"Node becomes schedulable": {
+			oldNode: makeNode("8", true),
+			newNode: makeNode("8", false),
+			want:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isNodeCapacityChanged(tc.oldNode, tc.newNode); got != tc.want {
+				t.Errorf("isNodeCapacityChanged() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
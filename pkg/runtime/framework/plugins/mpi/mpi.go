@@ -25,10 +25,12 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"golang.org/x/crypto/ssh"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -49,11 +51,10 @@ import (
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 )
 
-// TODO : Support MPICH and IntelMPI implementations.
-
 type MPI struct {
-	client client.Client
-	scheme *apiruntime.Scheme
+	client    client.Client
+	scheme    *apiruntime.Scheme
+	secretTTL *metav1.Duration
 }
 
 var _ framework.CustomValidationPlugin = (*MPI)(nil)
@@ -66,11 +67,15 @@ const Name = "MPI"
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=create;get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;get;list;watch;update;patch
 
-func New(_ context.Context, client client.Client, _ client.FieldIndexer, _ *configapi.Configuration) (framework.Plugin, error) {
-	return &MPI{
+func New(_ context.Context, client client.Client, _ client.FieldIndexer, cfg *configapi.Configuration) (framework.Plugin, error) {
+	m := &MPI{
 		client: client,
 		scheme: client.Scheme(),
-	}, nil
+	}
+	if cfg != nil && cfg.GeneratedSecrets != nil {
+		m.secretTTL = cfg.GeneratedSecrets.TTL
+	}
+	return m, nil
 }
 
 func (m *MPI) Name() string {
@@ -139,36 +144,16 @@ func (m *MPI) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) er
 	}
 
 	// Add Secret and ConfigMap volumes to the Info object
+	mountHostfileOnNodes := ptr.Deref(info.RuntimePolicy.MLPolicySource.MPI.MountHostfileOnNodes, false)
 	for psIdx, ps := range info.TemplateSpec.PodSets {
 		if ps.Name != constants.Node && ps.Name != constants.Launcher {
 			continue
 		}
 		apply.UpsertVolumes(
 			&info.TemplateSpec.PodSets[psIdx].Volumes,
-			[]corev1ac.VolumeApplyConfiguration{
-				*corev1ac.Volume().
-					WithName(constants.MPISSHAuthVolumeName).
-					WithSecret(corev1ac.SecretVolumeSource().
-						WithSecretName(fmt.Sprintf("%s%s", trainJob.Name, constants.MPISSHAuthSecretSuffix)).
-						WithDefaultMode(constants.MPISSHAuthDefaultMode).
-						WithItems(
-							corev1ac.KeyToPath().
-								WithKey(corev1.SSHAuthPrivateKey).
-								WithPath(constants.MPISSHPrivateKeyFile).
-								WithMode(constants.MPISSHPrivateKeyFileMode),
-							corev1ac.KeyToPath().
-								WithKey(constants.MPISSHPublicKey).
-								WithPath(constants.MPISSHPublicKeyFile).
-								WithMode(constants.MPISSHPublicKeyFileMode),
-							corev1ac.KeyToPath().
-								WithKey(constants.MPISSHPublicKey).
-								WithPath(constants.MPISSHAuthorizedKeys).
-								WithMode(constants.MPISSHPublicKeyFileMode),
-						),
-					),
-			}...,
+			*SSHAuthVolume(constants.MPISSHAuthVolumeName, sshAuthSecretName(trainJob.Name)),
 		)
-		if ps.Name == constants.Launcher {
+		if ps.Name == constants.Launcher || mountHostfileOnNodes {
 			apply.UpsertVolumes(
 				&info.TemplateSpec.PodSets[psIdx].Volumes,
 				[]corev1ac.VolumeApplyConfiguration{
@@ -198,6 +183,14 @@ func (m *MPI) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) er
 						WithMountPath(*info.RuntimePolicy.MLPolicySource.MPI.SSHAuthMountPath),
 				}...,
 			)
+			if ps.Name == constants.Node && mountHostfileOnNodes {
+				apply.UpsertVolumeMounts(
+					&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].VolumeMounts,
+					*corev1ac.VolumeMount().
+						WithName(constants.MPIHostfileVolumeName).
+						WithMountPath(constants.MPIHostfileDir),
+				)
+			}
 			if ps.Name == constants.Launcher && (container.Name == constants.Node || container.Name == constants.Launcher) {
 				apply.UpsertVolumeMounts(
 					&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].VolumeMounts,
@@ -205,6 +198,12 @@ func (m *MPI) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) er
 						WithName(constants.MPIHostfileVolumeName).
 						WithMountPath(constants.MPIHostfileDir),
 				)
+				// Replace the launcher binary in the runtime command, e.g. mpirun with mpiexec.
+				if launcherBinary := info.RuntimePolicy.MLPolicySource.MPI.LauncherBinary; launcherBinary != nil && len(container.Command) > 0 {
+					command := slices.Clone(container.Command)
+					command[0] = *launcherBinary
+					info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Command = command
+				}
 				switch *info.RuntimePolicy.MLPolicySource.MPI.MPIImplementation {
 				case trainer.MPIImplementationOpenMPI:
 					apply.UpsertEnvVars(
@@ -222,6 +221,26 @@ func (m *MPI) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) er
 							WithName(constants.OpenMPIEnvKeyRSHArgs).
 							WithValue(constants.OpenMPIEnvDefaultValueRSHArgs),
 					)
+				case trainer.MPIImplementationIntel:
+					apply.UpsertEnvVars(
+						&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Env,
+						*corev1ac.EnvVar().
+							WithName(constants.IntelMPIEnvHostFileLocation).
+							WithValue(fmt.Sprintf("%s/%s", constants.MPIHostfileDir, constants.MPIHostfileName)),
+						*corev1ac.EnvVar().
+							WithName(constants.IntelMPIEnvHydraBootstrap).
+							WithValue(constants.IntelMPIEnvDefaultValueHydraBootstrap),
+					)
+				case trainer.MPIImplementationMPICH:
+					apply.UpsertEnvVars(
+						&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Env,
+						*corev1ac.EnvVar().
+							WithName(constants.MPICHEnvHostFileLocation).
+							WithValue(fmt.Sprintf("%s/%s", constants.MPIHostfileDir, constants.MPIHostfileName)),
+						*corev1ac.EnvVar().
+							WithName(constants.MPICHEnvHydraLauncher).
+							WithValue(constants.MPICHEnvDefaultValueHydraLauncher),
+					)
 				default:
 					return fmt.Errorf("MPI implementation for %v doesn't supported", info.RuntimePolicy.MLPolicySource.MPI.MPIImplementation)
 				}
@@ -262,21 +281,57 @@ func (m *MPI) Build(ctx context.Context, info *runtime.Info, trainJob *trainer.T
 
 	var objects []apiruntime.ApplyConfiguration
 
-	// SSHAuthSecret is immutable.
-	if err := m.client.Get(ctx, client.ObjectKey{Name: sshAuthSecretName(trainJob.Name), Namespace: trainJob.Namespace}, &corev1.Secret{}); err != nil {
-		if client.IgnoreNotFound(err) != nil {
-			return nil, err
-		}
-		secret, err := m.buildSSHAuthSecret(trainJob)
-		if err != nil {
-			return nil, fmt.Errorf("failed to build SSH Auth secret: %w", err)
-		}
+	secret, err := BuildSSHAuthSecret(ctx, m.client, sshAuthSecretName(trainJob.Name), trainJob, m.secretTTL)
+	if err != nil {
+		return nil, err
+	}
+	if secret != nil {
 		objects = append(objects, secret)
 	}
 	return append(objects, m.buildHostFileConfigMap(info, trainJob)), nil
 }
 
-func (m *MPI) buildSSHAuthSecret(trainJob *trainer.TrainJob) (*corev1ac.SecretApplyConfiguration, error) {
+// SSHAuthVolume returns the volume projecting the SSH auth Secret to the private key, public key
+// and authorized keys files. It is shared with the plugins launching the training processes over SSH, e.g. DeepSpeed.
+func SSHAuthVolume(volumeName, secretName string) *corev1ac.VolumeApplyConfiguration {
+	return corev1ac.Volume().
+		WithName(volumeName).
+		WithSecret(corev1ac.SecretVolumeSource().
+			WithSecretName(secretName).
+			WithDefaultMode(constants.MPISSHAuthDefaultMode).
+			WithItems(
+				corev1ac.KeyToPath().
+					WithKey(corev1.SSHAuthPrivateKey).
+					WithPath(constants.MPISSHPrivateKeyFile).
+					WithMode(constants.MPISSHPrivateKeyFileMode),
+				corev1ac.KeyToPath().
+					WithKey(constants.MPISSHPublicKey).
+					WithPath(constants.MPISSHPublicKeyFile).
+					WithMode(constants.MPISSHPublicKeyFileMode),
+				corev1ac.KeyToPath().
+					WithKey(constants.MPISSHPublicKey).
+					WithPath(constants.MPISSHAuthorizedKeys).
+					WithMode(constants.MPISSHPublicKeyFileMode),
+			),
+		)
+}
+
+// BuildSSHAuthSecret builds the SSH auth Secret with the generated keys owned by the TrainJob.
+// It returns nil when the Secret already exists, since the SSH auth Secret is immutable.
+// The secretTTL is set as the TTL annotation for the external Secret cleaners when it is not nil.
+// It is shared with the plugins launching the training processes over SSH, e.g. DeepSpeed.
+func BuildSSHAuthSecret(ctx context.Context, c client.Client, secretName string, trainJob *trainer.TrainJob, secretTTL *metav1.Duration) (*corev1ac.SecretApplyConfiguration, error) {
+	if err := c.Get(ctx, client.ObjectKey{Name: secretName, Namespace: trainJob.Namespace}, &corev1.Secret{}); err == nil || client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	secret, err := buildSSHAuthSecret(secretName, trainJob, secretTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SSH Auth secret: %w", err)
+	}
+	return secret, nil
+}
+
+func buildSSHAuthSecret(secretName string, trainJob *trainer.TrainJob, secretTTL *metav1.Duration) (*corev1ac.SecretApplyConfiguration, error) {
 	privateKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
 	if err != nil {
 		return nil, err
@@ -293,7 +348,11 @@ func (m *MPI) buildSSHAuthSecret(trainJob *trainer.TrainJob) (*corev1ac.SecretAp
 	if err != nil {
 		return nil, err
 	}
-	return corev1ac.Secret(sshAuthSecretName(trainJob.Name), trainJob.Namespace).
+	secret := corev1ac.Secret(secretName, trainJob.Namespace)
+	if secretTTL != nil {
+		secret.WithAnnotations(map[string]string{constants.AnnotationSecretTTL: secretTTL.Duration.String()})
+	}
+	return secret.
 		WithType(corev1.SecretTypeSSHAuth).
 		WithData(map[string][]byte{
 			corev1.SSHAuthPrivateKey:  privatePEM,
@@ -326,6 +385,10 @@ func (m *MPI) buildHostFileConfigMap(info *runtime.Info, trainJob *trainer.Train
 			for e := range ps.Endpoints {
 				fmt.Fprintf(&hostFile, "%s slots=%d\n", e, slots)
 			}
+		case trainer.MPIImplementationIntel, trainer.MPIImplementationMPICH:
+			for e := range ps.Endpoints {
+				fmt.Fprintf(&hostFile, "%s:%d\n", e, slots)
+			}
 		}
 	}
 	return corev1ac.ConfigMap(fmt.Sprintf("%s%s", trainJob.Name, constants.MPIHostfileConfigMapSuffix), trainJob.Namespace).
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipinstall
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+)
+
+const (
+	Name = "PipInstall"
+
+	// initContainerName is the name of the init container installing the pip packages.
+	initContainerName = "pip-install"
+
+	// volumeName is the name of the volume shared between the init container and the trainer container.
+	volumeName = "pip-packages"
+
+	// mountPath is the path where the pip packages are installed.
+	mountPath = "/opt/pip-packages"
+
+	// envNamePythonPath is the env name to add the installed pip packages to the Python path.
+	envNamePythonPath = "PYTHONPATH"
+)
+
+var (
+	_ framework.EnforceMLPolicyPlugin  = (*PipInstall)(nil)
+	_ framework.CustomValidationPlugin = (*PipInstall)(nil)
+)
+
+type PipInstall struct {
+	pipInstall *configapi.PipInstall
+}
+
+func New(_ context.Context, _ client.Client, _ client.FieldIndexer, cfg *configapi.Configuration) (framework.Plugin, error) {
+	p := &PipInstall{}
+	if cfg != nil {
+		p.pipInstall = cfg.PipInstall
+	}
+	return p, nil
+}
+
+func (p *PipInstall) Name() string {
+	return Name
+}
+
+func (p *PipInstall) Validate(_ context.Context, _ *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	if p.pipInstall != nil || newObj == nil || newObj.Spec.Trainer == nil || len(newObj.Spec.Trainer.PipPackages) == 0 {
+		return nil, allErrs
+	}
+	allErrs = append(allErrs, field.Forbidden(
+		field.NewPath("spec", "trainer", "pipPackages"),
+		"pip install is disabled in the Trainer controller configuration",
+	))
+	return nil, allErrs
+}
+
+func (p *PipInstall) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || trainJob == nil || p.pipInstall == nil ||
+		trainJob.Spec.Trainer == nil || len(trainJob.Spec.Trainer.PipPackages) == 0 {
+		return nil
+	}
+
+	trainerPS := info.FindPodSetByAncestor(constants.AncestorTrainer)
+	if trainerPS == nil {
+		return nil
+	}
+	trainerContainer := info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node)
+	if trainerContainer == nil {
+		return nil
+	}
+
+	volumeMount := *corev1ac.VolumeMount().
+		WithName(volumeName).
+		WithMountPath(mountPath)
+	apply.UpsertVolumes(&trainerPS.Volumes, *corev1ac.Volume().
+		WithName(volumeName).
+		WithEmptyDir(corev1ac.EmptyDirVolumeSource()))
+	if !slices.ContainsFunc(trainerPS.InitContainers, func(c runtime.Container) bool { return c.Name == initContainerName }) {
+		trainerPS.InitContainers = append(trainerPS.InitContainers, runtime.Container{
+			Name:         initContainerName,
+			Image:        p.pipInstall.Image,
+			Command:      append([]string{"pip", "install", "--no-cache-dir", "--target", mountPath}, trainJob.Spec.Trainer.PipPackages...),
+			VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{volumeMount},
+		})
+	}
+
+	// The installed packages take precedence over the Python path configured in the runtime.
+	pythonPath := mountPath
+	for _, e := range trainerContainer.Env {
+		if ptr.Deref(e.Name, "") == envNamePythonPath && len(ptr.Deref(e.Value, "")) != 0 {
+			pythonPath = mountPath + ":" + *e.Value
+		}
+	}
+	apply.UpsertVolumeMounts(&trainerContainer.VolumeMounts, volumeMount)
+	apply.UpsertEnvVars(&trainerContainer.Env, *corev1ac.EnvVar().
+		WithName(envNamePythonPath).
+		WithValue(pythonPath))
+	return nil
+}
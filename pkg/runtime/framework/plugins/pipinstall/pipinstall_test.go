@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipinstall
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestPipInstallEnforceMLPolicy(t *testing.T) {
+	pipInstallCfg := &configapi.Configuration{
+		PipInstall: &configapi.PipInstall{
+			Image: "python:3.12",
+		},
+	}
+	trainerInfo := func(env ...*corev1ac.EnvVarApplyConfiguration) *runtime.Info {
+		return runtime.NewInfo(
+			runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+				WithContainers(corev1ac.Container().
+					WithName(constants.Node).
+					WithEnv(env...),
+				),
+			),
+		)
+	}
+	volumeMount := corev1ac.VolumeMountApplyConfiguration{
+		Name:      ptr.To(volumeName),
+		MountPath: ptr.To(mountPath),
+	}
+
+	cases := map[string]struct {
+		cfg                *configapi.Configuration
+		info               *runtime.Info
+		trainJob           *trainer.TrainJob
+		wantVolumes        []corev1ac.VolumeApplyConfiguration
+		wantInitContainers []runtime.Container
+		wantContainer      runtime.Container
+	}{
+		"no action when pipInstall is not configured": {
+			cfg:  &configapi.Configuration{},
+			info: trainerInfo(),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					PipPackages("transformers").
+					Obj()).
+				Obj(),
+			wantContainer: runtime.Container{Name: constants.Node},
+		},
+		"no action when pipPackages are not set": {
+			cfg:  pipInstallCfg,
+			info: trainerInfo(),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Obj()).
+				Obj(),
+			wantContainer: runtime.Container{Name: constants.Node},
+		},
+		"pip install init container and shared volume are added": {
+			cfg:  pipInstallCfg,
+			info: trainerInfo(),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					PipPackages("transformers==4.46.0", "peft").
+					Obj()).
+				Obj(),
+			wantVolumes: []corev1ac.VolumeApplyConfiguration{{
+				Name: ptr.To(volumeName),
+				VolumeSourceApplyConfiguration: corev1ac.VolumeSourceApplyConfiguration{
+					EmptyDir: &corev1ac.EmptyDirVolumeSourceApplyConfiguration{},
+				},
+			}},
+			wantInitContainers: []runtime.Container{{
+				Name:         initContainerName,
+				Image:        "python:3.12",
+				Command:      []string{"pip", "install", "--no-cache-dir", "--target", mountPath, "transformers==4.46.0", "peft"},
+				VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{volumeMount},
+			}},
+			wantContainer: runtime.Container{
+				Name: constants.Node,
+				Env: []corev1ac.EnvVarApplyConfiguration{{
+					Name:  ptr.To(envNamePythonPath),
+					Value: ptr.To(mountPath),
+				}},
+				VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{volumeMount},
+			},
+		},
+		"runtime Python path is preserved after the pip packages": {
+			cfg:  pipInstallCfg,
+			info: trainerInfo(corev1ac.EnvVar().WithName(envNamePythonPath).WithValue("/workspace")),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					PipPackages("peft").
+					Obj()).
+				Obj(),
+			wantVolumes: []corev1ac.VolumeApplyConfiguration{{
+				Name: ptr.To(volumeName),
+				VolumeSourceApplyConfiguration: corev1ac.VolumeSourceApplyConfiguration{
+					EmptyDir: &corev1ac.EmptyDirVolumeSourceApplyConfiguration{},
+				},
+			}},
+			wantInitContainers: []runtime.Container{{
+				Name:         initContainerName,
+				Image:        "python:3.12",
+				Command:      []string{"pip", "install", "--no-cache-dir", "--target", mountPath, "peft"},
+				VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{volumeMount},
+			}},
+			wantContainer: runtime.Container{
+				Name: constants.Node,
+				Env: []corev1ac.EnvVarApplyConfiguration{{
+					Name:  ptr.To(envNamePythonPath),
+					Value: ptr.To(mountPath + ":/workspace"),
+				}},
+				VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{volumeMount},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			cliBuilder := utiltesting.NewClientBuilder()
+			p, err := New(ctx, cliBuilder.Build(), nil, tc.cfg)
+			if err != nil {
+				t.Fatalf("Failed to initialize PipInstall plugin: %v", err)
+			}
+			if err = p.(framework.EnforceMLPolicyPlugin).EnforceMLPolicy(tc.info, tc.trainJob); err != nil {
+				t.Fatalf("Unexpected error from EnforceMLPolicy: %v", err)
+			}
+			trainerPS := tc.info.FindPodSetByAncestor(constants.AncestorTrainer)
+			if diff := cmp.Diff(tc.wantVolumes, trainerPS.Volumes, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected trainer volumes (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantInitContainers, trainerPS.InitContainers, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected trainer init containers (-want,+got):\n%s", diff)
+			}
+			gotContainer := tc.info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node)
+			if diff := cmp.Diff(tc.wantContainer, *gotContainer, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected trainer container (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipInstallValidate(t *testing.T) {
+	cases := map[string]struct {
+		cfg       *configapi.Configuration
+		trainJob  *trainer.TrainJob
+		wantError field.ErrorList
+	}{
+		"no error when pipPackages are not set": {
+			cfg: &configapi.Configuration{},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().Obj()).
+				Obj(),
+		},
+		"no error when pipInstall is configured": {
+			cfg: &configapi.Configuration{
+				PipInstall: &configapi.PipInstall{Image: "python:3.12"},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					PipPackages("peft").
+					Obj()).
+				Obj(),
+		},
+		"pipPackages are rejected when pipInstall is not configured": {
+			cfg: &configapi.Configuration{},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					PipPackages("peft").
+					Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "trainer", "pipPackages"), ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			cliBuilder := utiltesting.NewClientBuilder()
+			p, err := New(ctx, cliBuilder.Build(), nil, tc.cfg)
+			if err != nil {
+				t.Fatalf("Failed to initialize PipInstall plugin: %v", err)
+			}
+			_, errs := p.(framework.CustomValidationPlugin).Validate(ctx, nil, nil, tc.trainJob)
+			if diff := cmp.Diff(tc.wantError, errs, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); len(diff) != 0 {
+				t.Errorf("Unexpected error from Validate (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
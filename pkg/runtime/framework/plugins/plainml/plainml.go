@@ -48,7 +48,8 @@ func (p *PlainML) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob
 		(info.RuntimePolicy.MLPolicySource != nil &&
 			(info.RuntimePolicy.MLPolicySource.Torch != nil ||
 				info.RuntimePolicy.MLPolicySource.MPI != nil ||
-				info.RuntimePolicy.MLPolicySource.JAX != nil)) {
+				info.RuntimePolicy.MLPolicySource.JAX != nil ||
+				info.RuntimePolicy.MLPolicySource.DeepSpeed != nil)) {
 		return nil
 	}
 
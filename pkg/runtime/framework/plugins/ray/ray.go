@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ray
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+
+	_ "embed"
+)
+
+//go:embed templates/entrypoint.sh
+var entrypointTemplate string
+
+type Ray struct{}
+
+var _ framework.CustomValidationPlugin = (*Ray)(nil)
+var _ framework.EnforceMLPolicyPlugin = (*Ray)(nil)
+var _ framework.ComponentBuilderPlugin = (*Ray)(nil)
+
+const Name = "Ray"
+
+func New(context.Context, client.Client, client.FieldIndexer, *configapi.Configuration) (framework.Plugin, error) {
+	return &Ray{}, nil
+}
+
+func (r *Ray) Name() string {
+	return Name
+}
+
+func (r *Ray) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil ||
+		runtimeInfo.RuntimePolicy.MLPolicySource.Ray == nil {
+		return nil, allErrs
+	}
+	if newObj.Spec.Trainer != nil {
+		specPath := field.NewPath("spec", "trainer", "env")
+		for i, env := range newObj.Spec.Trainer.Env {
+			if constants.RayReservedEnvNames.Has(env.Name) {
+				allErrs = append(allErrs, field.Forbidden(
+					specPath.Index(i),
+					fmt.Sprintf("%s is reserved for the Ray runtime", env.Name),
+				))
+			}
+		}
+	}
+	return nil, allErrs
+}
+
+func (r *Ray) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil ||
+		info.RuntimePolicy.MLPolicySource.Ray == nil {
+		return nil
+	}
+
+	// The head must be resolvable by the workers through the JobSet headless Service.
+	ensureJobSetNetwork(info)
+
+	// Wrap the original command with the entrypoint starting the head or the worker by the completion index.
+	// Also clear the existing args so only the Ray entrypoint controls execution.
+	originalCmd := getOriginalCommand(trainJob, info)
+	if trainJob.Spec.Trainer == nil {
+		trainJob.Spec.Trainer = &trainer.Trainer{}
+	}
+	trainJob.Spec.Trainer.Command = []string{"/bin/bash", fmt.Sprintf("%s/entrypoint.sh", constants.RayConfigVolumePath), originalCmd}
+	trainJob.Spec.Trainer.Args = nil
+
+	configMapName := entrypointConfigMapName(trainJob)
+	for psIdx, ps := range info.TemplateSpec.PodSets {
+		if ps.Name != constants.Node {
+			continue
+		}
+		apply.UpsertVolumes(&info.TemplateSpec.PodSets[psIdx].Volumes, *corev1ac.Volume().
+			WithName(configMapName).
+			WithConfigMap(corev1ac.ConfigMapVolumeSource().
+				WithName(configMapName).
+				WithDefaultMode(0755)))
+		for cIdx, container := range ps.Containers {
+			if container.Name != constants.Node {
+				continue
+			}
+			trainerContainer := &info.TemplateSpec.PodSets[psIdx].Containers[cIdx]
+			apply.UpsertVolumeMounts(&trainerContainer.VolumeMounts, *corev1ac.VolumeMount().
+				WithName(configMapName).
+				WithMountPath(constants.RayConfigVolumePath).
+				WithReadOnly(true))
+			apply.UpsertEnvVars(&trainerContainer.Env, *corev1ac.EnvVar().
+				WithName(constants.RayEnvAddress).
+				WithValue(headAddress(info, trainJob)))
+			apply.UpsertPort(&trainerContainer.Ports, *corev1ac.ContainerPort().
+				WithName(constants.RayHeadPortName).
+				WithContainerPort(constants.RayHeadPort))
+		}
+	}
+	return nil
+}
+
+func (r *Ray) SyncParallelCount(_ *runtime.Info) error { return nil }
+
+// Build creates the ConfigMap with the entrypoint starting the Ray head or worker.
+func (r *Ray) Build(_ context.Context, info *runtime.Info, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil ||
+		info.RuntimePolicy.MLPolicySource.Ray == nil {
+		return nil, nil
+	}
+	cm := corev1ac.ConfigMap(entrypointConfigMapName(trainJob), trainJob.Namespace).
+		WithData(map[string]string{
+			"entrypoint.sh": generateEntrypoint(headAddress(info, trainJob)),
+		}).
+		WithOwnerReferences(metav1ac.OwnerReference().
+			WithAPIVersion(trainer.SchemeGroupVersion.String()).
+			WithKind(trainer.TrainJobKind).
+			WithName(trainJob.Name).
+			WithUID(trainJob.UID).
+			WithController(true).
+			WithBlockOwnerDeletion(true))
+	return []apiruntime.ApplyConfiguration{cm}, nil
+}
+
+// ensureJobSetNetwork enables the DNS hostnames of the JobSet,
+// so that the headless Service is created for the Ray head.
+func ensureJobSetNetwork(info *runtime.Info) {
+	spec, ok := runtime.TemplateSpecApply[jobsetv1alpha2ac.JobSetSpecApplyConfiguration](info)
+	if !ok || spec == nil {
+		return
+	}
+	if spec.Network == nil {
+		spec.WithNetwork(jobsetv1alpha2ac.Network())
+	}
+	spec.Network.WithEnableDNSHostnames(true)
+}
+
+// headAddress returns the address of the Ray head: <trainjob-name>-node-0-0.<subdomain>:<port>
+func headAddress(info *runtime.Info, trainJob *trainer.TrainJob) string {
+	subdomain := trainJob.Name
+	if spec, ok := runtime.TemplateSpecApply[jobsetv1alpha2ac.JobSetSpecApplyConfiguration](info); ok && spec != nil &&
+		spec.Network != nil && spec.Network.Subdomain != nil {
+		subdomain = *spec.Network.Subdomain
+	}
+	return fmt.Sprintf("%s-%s-0-0.%s:%d", trainJob.Name, constants.Node, subdomain, constants.RayHeadPort)
+}
+
+// getOriginalCommand derives the original training command run as the Ray driver on the head.
+func getOriginalCommand(trainJob *trainer.TrainJob, info *runtime.Info) string {
+	var command []string
+	var args []string
+	if trainerContainer := info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node); trainerContainer != nil {
+		command = trainerContainer.Command
+	}
+	if trainJob.Spec.Trainer != nil {
+		if trainJob.Spec.Trainer.Command != nil {
+			command = trainJob.Spec.Trainer.Command
+		}
+		if trainJob.Spec.Trainer.Args != nil {
+			args = trainJob.Spec.Trainer.Args
+		}
+	}
+	return strings.TrimSpace(strings.Join(append(command, args...), " "))
+}
+
+// generateEntrypoint generates the entrypoint, where the node with the completion index 0
+// starts the Ray head and runs the driver, and the other nodes join the head as the workers.
+func generateEntrypoint(headAddress string) string {
+	return fmt.Sprintf(entrypointTemplate, generateHeadCommand(), generateWorkerCommand(headAddress))
+}
+
+// generateHeadCommand generates the command to start the Ray head.
+func generateHeadCommand() string {
+	return fmt.Sprintf("ray start --head --port=%d", constants.RayHeadPort)
+}
+
+// generateWorkerCommand generates the command to start the Ray worker joining the head.
+func generateWorkerCommand(headAddress string) string {
+	return fmt.Sprintf("ray start --address=%s", headAddress)
+}
+
+func entrypointConfigMapName(trainJob *trainer.TrainJob) string {
+	return fmt.Sprintf("%s-ray-entrypoint", trainJob.Name)
+}
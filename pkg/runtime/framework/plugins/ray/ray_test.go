@@ -0,0 +1,434 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ray
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestRayValidate(t *testing.T) {
+	cases := map[string]struct {
+		runtimeInfo *runtime.Info
+		trainJob    *trainer.TrainJob
+		wantErrs    field.ErrorList
+	}{
+		"no error when runtime is not Ray": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Env(corev1.EnvVar{Name: constants.RayEnvAddress, Value: "custom:6379"}).
+						Obj(),
+				).
+				Obj(),
+		},
+		"error when using reserved env names": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							RayPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Env(
+							corev1.EnvVar{Name: "TRAIN_EPOCHS", Value: "10"},
+							corev1.EnvVar{Name: constants.RayEnvAddress, Value: "custom:6379"},
+						).
+						Obj(),
+				).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec", "trainer", "env").Index(1),
+					fmt.Sprintf("%s is reserved for the Ray runtime", constants.RayEnvAddress),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize Ray plugin: %v", err)
+			}
+
+			_, errs := p.(framework.CustomValidationPlugin).Validate(ctx, tc.runtimeInfo, nil, tc.trainJob)
+			if diff := cmp.Diff(tc.wantErrs, errs, cmpopts.IgnoreFields(field.Error{}, "Detail")); len(diff) != 0 {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRayEnforceMLPolicy(t *testing.T) {
+	cases := map[string]struct {
+		info         *runtime.Info
+		trainJob     *trainer.TrainJob
+		wantInfo     *runtime.Info
+		wantTrainJob *trainer.TrainJob
+	}{
+		"no action when info is nil": {
+			trainJob:     utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+			wantTrainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+		},
+		"no action when mlPolicySource Ray is null": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+			wantInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().Obj(),
+				),
+			),
+			wantTrainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+		},
+		"head and workers are started by the entrypoint wrapping the trainer command": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							RayPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithTemplateSpecObjApply(jobsetv1alpha2ac.JobSetSpec()),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node).WithCommand("python")),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(3).
+						Container("ray:latest", nil, []string{"train.py", "--epochs=10"}, nil).
+						Obj(),
+				).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						RayPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithNetwork(jobsetv1alpha2ac.Network().WithEnableDNSHostnames(true)),
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Volumes: []corev1ac.VolumeApplyConfiguration{
+							*corev1ac.Volume().
+								WithName("test-job-ray-entrypoint").
+								WithConfigMap(corev1ac.ConfigMapVolumeSource().
+									WithName("test-job-ray-entrypoint").
+									WithDefaultMode(0755)),
+						},
+						Containers: []runtime.Container{{
+							Name:    constants.Node,
+							Command: []string{"python"},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								*corev1ac.EnvVar().
+									WithName(constants.RayEnvAddress).
+									WithValue("test-job-node-0-0.test-job:6379"),
+							},
+							Ports: []corev1ac.ContainerPortApplyConfiguration{
+								*corev1ac.ContainerPort().
+									WithName(constants.RayHeadPortName).
+									WithContainerPort(constants.RayHeadPort),
+							},
+							VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+								*corev1ac.VolumeMount().
+									WithName("test-job-ray-entrypoint").
+									WithMountPath(constants.RayConfigVolumePath).
+									WithReadOnly(true),
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			wantTrainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(3).
+						Container("ray:latest", []string{"/bin/bash", "/etc/ray-config/entrypoint.sh", "python train.py --epochs=10"}, nil, nil).
+						Obj(),
+				).
+				Obj(),
+		},
+		"head address uses the JobSet subdomain": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							RayPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithTemplateSpecObjApply(jobsetv1alpha2ac.JobSetSpec().
+					WithNetwork(jobsetv1alpha2ac.Network().WithSubdomain("ray-cluster"))),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("ray:latest", []string{"python", "train.py"}, nil, nil).
+						Obj(),
+				).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						RayPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithNetwork(jobsetv1alpha2ac.Network().
+							WithSubdomain("ray-cluster").
+							WithEnableDNSHostnames(true)),
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Volumes: []corev1ac.VolumeApplyConfiguration{
+							*corev1ac.Volume().
+								WithName("test-job-ray-entrypoint").
+								WithConfigMap(corev1ac.ConfigMapVolumeSource().
+									WithName("test-job-ray-entrypoint").
+									WithDefaultMode(0755)),
+						},
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								*corev1ac.EnvVar().
+									WithName(constants.RayEnvAddress).
+									WithValue("test-job-node-0-0.ray-cluster:6379"),
+							},
+							Ports: []corev1ac.ContainerPortApplyConfiguration{
+								*corev1ac.ContainerPort().
+									WithName(constants.RayHeadPortName).
+									WithContainerPort(constants.RayHeadPort),
+							},
+							VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+								*corev1ac.VolumeMount().
+									WithName("test-job-ray-entrypoint").
+									WithMountPath(constants.RayConfigVolumePath).
+									WithReadOnly(true),
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			wantTrainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("ray:latest", []string{"/bin/bash", "/etc/ray-config/entrypoint.sh", "python train.py"}, nil, nil).
+						Obj(),
+				).
+				Obj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize Ray plugin: %v", err)
+			}
+
+			if err = p.(framework.EnforceMLPolicyPlugin).EnforceMLPolicy(tc.info, tc.trainJob); err != nil {
+				t.Errorf("Unexpected error from EnforceMLPolicy: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantInfo, tc.info, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected RuntimeInfo (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantTrainJob, tc.trainJob, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected TrainJob (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRayBuild(t *testing.T) {
+	cases := map[string]struct {
+		info     *runtime.Info
+		trainJob *trainer.TrainJob
+		wantObjs []apiruntime.ApplyConfiguration
+	}{
+		"no action when mlPolicySource Ray is null": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").Obj(),
+		},
+		"entrypoint ConfigMap is built with the head address": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							RayPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("test-uid").
+				Obj(),
+			wantObjs: []apiruntime.ApplyConfiguration{
+				corev1ac.ConfigMap("test-job-ray-entrypoint", metav1.NamespaceDefault).
+					WithData(map[string]string{
+						"entrypoint.sh": generateEntrypoint("test-job-node-0-0.test-job:6379"),
+					}).
+					WithOwnerReferences(metav1ac.OwnerReference().
+						WithAPIVersion(trainer.SchemeGroupVersion.String()).
+						WithKind(trainer.TrainJobKind).
+						WithName("test-job").
+						WithUID("test-uid").
+						WithController(true).
+						WithBlockOwnerDeletion(true)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize Ray plugin: %v", err)
+			}
+
+			objs, err := p.(framework.ComponentBuilderPlugin).Build(ctx, tc.info, tc.trainJob)
+			if err != nil {
+				t.Errorf("Unexpected error from Build: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantObjs, objs); len(diff) != 0 {
+				t.Errorf("Unexpected objects from Build (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateEntrypoint(t *testing.T) {
+	cases := map[string]struct {
+		headAddress     string
+		wantHeadCommand string
+		wantWorkerCmd   string
+	}{
+		"head listens on the GCS port and workers join the head address": {
+			headAddress:     "test-job-node-0-0.test-job:6379",
+			wantHeadCommand: "ray start --head --port=6379",
+			wantWorkerCmd:   "ray start --address=test-job-node-0-0.test-job:6379",
+		},
+		"workers join the head address with the custom subdomain": {
+			headAddress:     "test-job-node-0-0.ray-cluster:6379",
+			wantHeadCommand: "ray start --head --port=6379",
+			wantWorkerCmd:   "ray start --address=test-job-node-0-0.ray-cluster:6379",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantHeadCommand, generateHeadCommand()); len(diff) != 0 {
+				t.Errorf("Unexpected head command (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantWorkerCmd, generateWorkerCommand(tc.headAddress)); len(diff) != 0 {
+				t.Errorf("Unexpected worker command (-want,+got):\n%s", diff)
+			}
+			entrypoint := generateEntrypoint(tc.headAddress)
+			if strings.Contains(entrypoint, "%!") {
+				t.Errorf("Unexpected formatting error in the entrypoint:\n%s", entrypoint)
+			}
+			for _, want := range []string{
+				`if [ "${JOB_COMPLETION_INDEX}" = "0" ]; then`,
+				tc.wantHeadCommand + " || exit $?",
+				tc.wantWorkerCmd + " --block",
+			} {
+				if !strings.Contains(entrypoint, want) {
+					t.Errorf("Entrypoint does not contain %q:\n%s", want, entrypoint)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+# Copyright The Kubeflow Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+#!/bin/bash
+
+# The original training entrypoint, which is run as the Ray driver on the head
+command="$@"
+
+# The node with the completion index 0 is the Ray head
+if [ "${JOB_COMPLETION_INDEX}" = "0" ]; then
+
+  if [ -z "${command}" ]; then
+    echo "Starting the Ray head: %[1]s"
+    %[1]s --block
+    exit $?
+  fi
+
+  echo "Starting the Ray head: %[1]s"
+  %[1]s || exit $?
+
+  echo "Running the Ray driver: ${command}"
+  ${command}
+  retval=$?
+
+  # Stop the head, so that the workers blocked on the cluster exit
+  ray stop
+  exit ${retval}
+
+# The other nodes join the Ray cluster as the workers
+else
+  echo "Starting the Ray worker: %[2]s"
+  %[2]s --block
+
+  # The worker is blocked until the head stops once the driver completes
+  exit 0
+fi
@@ -18,6 +18,8 @@ package plugins
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -25,11 +27,17 @@ import (
 	"github.com/kubeflow/trainer/v2/pkg/features"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/coscheduling"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/deepspeed"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/flux"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/gpuenv"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/horovod"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jax"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/mpi"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/pipinstall"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/plainml"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/ray"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/tensorflow"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/torch"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/trainjobstatus"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/volcano"
@@ -49,6 +57,12 @@ func NewRegistry() Registry {
 		jobset.Name:       jobset.New,
 		jax.Name:          jax.New,
 		xgboost.Name:      xgboost.New,
+		gpuenv.Name:       gpuenv.New,
+		pipinstall.Name:   pipinstall.New,
+		deepspeed.Name:    deepspeed.New,
+		tensorflow.Name:   tensorflow.New,
+		ray.Name:          ray.New,
+		horovod.Name:      horovod.New,
 	}
 
 	if features.Enabled(features.TrainJobStatus) {
@@ -57,3 +71,11 @@ func NewRegistry() Registry {
 
 	return registry
 }
+
+// Names returns the sorted names of the plugins which can be enabled or disabled in the Configuration,
+// including the plugins registered only when their feature gate is enabled.
+func Names() []string {
+	registry := NewRegistry()
+	registry[trainjobstatus.Name] = trainjobstatus.New
+	return slices.Sorted(maps.Keys(registry))
+}
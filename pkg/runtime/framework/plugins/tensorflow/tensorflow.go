@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tensorflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+)
+
+type TensorFlow struct{}
+
+var _ framework.EnforceMLPolicyPlugin = (*TensorFlow)(nil)
+var _ framework.CustomValidationPlugin = (*TensorFlow)(nil)
+
+const Name = "TensorFlow"
+
+func New(context.Context, client.Client, client.FieldIndexer, *configapi.Configuration) (framework.Plugin, error) {
+	return &TensorFlow{}, nil
+}
+
+func (t *TensorFlow) Name() string {
+	return Name
+}
+
+func (t *TensorFlow) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil ||
+		runtimeInfo.RuntimePolicy.MLPolicySource.TensorFlow == nil {
+		return nil, allErrs
+	}
+	if newObj.Spec.Trainer != nil {
+		specPath := field.NewPath("spec", "trainer", "env")
+		for i, env := range newObj.Spec.Trainer.Env {
+			if constants.TensorFlowReservedEnvNames.Has(env.Name) {
+				allErrs = append(allErrs, field.Forbidden(
+					specPath.Index(i),
+					fmt.Sprintf("%s is reserved for the TensorFlow runtime", env.Name),
+				))
+			}
+		}
+	}
+	return nil, allErrs
+}
+
+// tfCluster is the cluster spec of the TF_CONFIG env.
+type tfCluster struct {
+	Worker []string `json:"worker"`
+}
+
+func (t *TensorFlow) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil ||
+		info.RuntimePolicy.MLPolicySource.TensorFlow == nil {
+		return nil
+	}
+
+	trainerPS := info.FindPodSetByAncestor(constants.AncestorTrainer)
+	if trainerPS == nil {
+		return nil
+	}
+
+	// Set the number of nodes from TrainJob if specified.
+	if trainerPS.Count != nil &&
+		trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumNodes != nil {
+		*trainerPS.Count = *trainJob.Spec.Trainer.NumNodes
+	}
+
+	if trainJob.Spec.Trainer == nil {
+		return nil
+	}
+	trainerContainer := info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node)
+	if trainerContainer == nil {
+		return nil
+	}
+
+	// Build the cluster spec with the worker addresses: <trainjob-name>-node-0-<index>.<trainjob-name>:<port>
+	numNodes := ptr.Deref(trainerPS.Count, 1)
+	cluster := tfCluster{Worker: make([]string, 0, numNodes)}
+	for i := range numNodes {
+		cluster.Worker = append(cluster.Worker, fmt.Sprintf("%s-%s-0-%d.%s:%d",
+			trainJob.Name, constants.Node, i, trainJob.Name, info.TrainerPort()))
+	}
+	clusterSpec, err := json.Marshal(cluster)
+	if err != nil {
+		return err
+	}
+
+	// The task index is the Job completion index, which the kubelet substitutes in the TF_CONFIG env
+	// through the dependent env reference. The worker with the index 0 acts as the chief.
+	apply.UpsertEnvVars(&trainerContainer.Env,
+		*corev1ac.EnvVar().
+			WithName(constants.TensorFlowEnvTaskIndex).
+			WithValueFrom(corev1ac.EnvVarSource().
+				WithFieldRef(corev1ac.ObjectFieldSelector().
+					WithFieldPath(constants.JobCompletionIndexFieldPath))),
+		*corev1ac.EnvVar().
+			WithName(constants.TensorFlowEnvTFConfig).
+			WithValue(fmt.Sprintf(`{"cluster":%s,"task":{"type":"%s","index":$(%s)}}`,
+				clusterSpec, constants.TensorFlowTaskTypeWorker, constants.TensorFlowEnvTaskIndex)),
+	)
+
+	// Add container port for the worker communication.
+	apply.UpsertPort(&trainerContainer.Ports, info.TrainerContainerPort())
+
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"slices"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -38,15 +39,30 @@ import (
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 )
 
-type Torch struct{}
+type Torch struct {
+	rendezvousWait *configapi.RendezvousWait
+	// defaultNumProcPerNode is the fallback number of processes per node
+	// when it can not be derived from the trainer resources.
+	defaultNumProcPerNode int32
+}
 
 var _ framework.EnforceMLPolicyPlugin = (*Torch)(nil)
 var _ framework.CustomValidationPlugin = (*Torch)(nil)
 
-const Name = "Torch"
+const (
+	Name = "Torch"
+
+	// rendezvousWaitContainerName is the name of the init container waiting for the rank-0 node hostname.
+	rendezvousWaitContainerName = "rendezvous-wait"
+)
 
-func New(context.Context, client.Client, client.FieldIndexer, *configapi.Configuration) (framework.Plugin, error) {
-	return &Torch{}, nil
+func New(_ context.Context, _ client.Client, _ client.FieldIndexer, cfg *configapi.Configuration) (framework.Plugin, error) {
+	t := &Torch{defaultNumProcPerNode: 1}
+	if cfg != nil {
+		t.rendezvousWait = cfg.RendezvousWait
+		t.defaultNumProcPerNode = ptr.Deref(cfg.DefaultNumProcPerNode, 1)
+	}
+	return t, nil
 }
 
 func (t *Torch) Name() string {
@@ -114,13 +130,19 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 
 	// TrainJob contains the actual information for the Trainer.
 	trainerPS := info.FindPodSetByAncestor(constants.AncestorTrainer)
+	if trainerPS != nil && info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node) == nil {
+		return fmt.Errorf("%w: the trainer PodSet %q must have the %q container", runtime.ErrorTrainerContainerNotFound, trainerPS.Name, constants.Node)
+	}
 	if trainerPS != nil && trainerPS.Count != nil && trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumNodes != nil {
 		*trainerPS.Count = *trainJob.Spec.Trainer.NumNodes
 	}
 
-	numProcPerNode := intstr.FromString("auto")
+	numProcPerNode := ptr.Deref(info.RuntimePolicy.MLPolicySource.Torch.NumProcPerNode, intstr.FromString("auto"))
+	// The number of processes per node is read from the Node label unless the TrainJob sets it.
+	numProcPerNodeLabel := ptr.Deref(info.RuntimePolicy.MLPolicySource.Torch.NumProcPerNodeLabel, "")
 	if trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumProcPerNode != nil {
 		numProcPerNode = intstr.FromInt32(*trainJob.Spec.Trainer.NumProcPerNode)
+		numProcPerNodeLabel = ""
 	}
 
 	// Determine numProcPerNode based on the resourcesPerNode.
@@ -131,7 +153,16 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 	gpuQ := runtime.GetNumGPUPerNode(&resourcesPerNode)
 	// If no GPU is set in resource, calculate numProcPerNode based on CPU.
 	if numProcPerNode.String() == "auto" && gpuQ == 0 {
-		numProcPerNode = intstr.FromInt(max(1, getNumCPUPerNode(&resourcesPerNode)))
+		numProcPerNode = intstr.FromInt(t.numProcPerNodeOrDefault(getNumCPUPerNode(&resourcesPerNode)))
+	}
+	// The cpu, gpu, and memory modes resolve to the requested CPUs, GPUs, and memory respectively.
+	switch numProcPerNode.String() {
+	case "cpu":
+		numProcPerNode = intstr.FromInt(t.numProcPerNodeOrDefault(getNumCPUPerNode(&resourcesPerNode)))
+	case "gpu":
+		numProcPerNode = intstr.FromInt(max(1, gpuQ))
+	case "memory":
+		numProcPerNode = intstr.FromInt(t.numProcPerNodeOrDefault(getNumMemoryProcPerNode(&resourcesPerNode, info.RuntimePolicy.MLPolicySource.Torch.MemoryPerProcess)))
 	}
 
 	// Update envs for Info object.
@@ -142,13 +173,35 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 		}
 	}
 
+	// The elastic training proceeds with any number of nodes between minNodes and maxNodes.
+	torchPolicy := info.RuntimePolicy.MLPolicySource.Torch
+	elastic := torchPolicy.MinNodes != nil && torchPolicy.MaxNodes != nil
+	nnodes := fmt.Sprintf("%d", ptr.Deref(ptr.Deref(trainerPS, runtime.PodSet{}).Count, 1))
+	if elastic {
+		nnodes = fmt.Sprintf("%d:%d", *torchPolicy.MinNodes, *torchPolicy.MaxNodes)
+	}
+
+	numProcPerNodeEnv := corev1ac.EnvVar().
+		WithName(constants.TorchEnvNumProcPerNode).
+		WithValue(numProcPerNode.String())
+	if len(numProcPerNodeLabel) != 0 {
+		// The downward API can not reference the Node labels, so the Node label is copied
+		// to the trainer Pod label by the JobSet plugin once the Pod is scheduled.
+		if info.Scheduler.PodAnnotations == nil {
+			info.Scheduler.PodAnnotations = make(map[string]string)
+		}
+		info.Scheduler.PodAnnotations[constants.AnnotationNumProcPerNodeNodeLabel] = numProcPerNodeLabel
+		numProcPerNodeEnv = corev1ac.EnvVar().
+			WithName(constants.TorchEnvNumProcPerNode).
+			WithValueFrom(corev1ac.EnvVarSource().
+				WithFieldRef(corev1ac.ObjectFieldSelector().
+					WithFieldPath(fmt.Sprintf("metadata.labels['%s']", constants.LabelNumProcPerNode))))
+	}
 	petEnvs := []corev1ac.EnvVarApplyConfiguration{
 		*corev1ac.EnvVar().
 			WithName(constants.TorchEnvNumNodes).
-			WithValue(fmt.Sprintf("%d", ptr.Deref(ptr.Deref(trainerPS, runtime.PodSet{}).Count, 1))),
-		*corev1ac.EnvVar().
-			WithName(constants.TorchEnvNumProcPerNode).
-			WithValue(numProcPerNode.String()),
+			WithValue(nnodes),
+		*numProcPerNodeEnv,
 		*corev1ac.EnvVar().
 			WithName(constants.TorchEnvNodeRank).
 			WithValueFrom(corev1ac.EnvVarSource().
@@ -156,13 +209,46 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 					WithFieldPath(constants.JobCompletionIndexFieldPath))),
 	}
 
+	// Add the world size when the number of nodes and the number of processes per node are known.
+	if !elastic && len(numProcPerNodeLabel) == 0 && numProcPerNode.Type == intstr.Int && !slices.ContainsFunc(ptr.Deref(trainJob.Spec.Trainer, trainer.Trainer{}).Env, func(e corev1.EnvVar) bool {
+		return e.Name == constants.TorchEnvWorldSize
+	}) {
+		numNodes := ptr.Deref(ptr.Deref(trainerPS, runtime.PodSet{}).Count, 1)
+		petEnvs = append(petEnvs, *corev1ac.EnvVar().
+			WithName(constants.TorchEnvWorldSize).
+			WithValue(fmt.Sprintf("%d", numNodes*numProcPerNode.IntVal)))
+	}
+
+	masterAddr := fmt.Sprintf("%s-%s-0-0.%s", trainJob.Name, constants.Node, trainJob.Name)
+	// The dynamic rendezvous backends use the rendezvous endpoint instead of the static master address.
+	rdzvBackend := ptr.Deref(torchPolicy.RdzvBackend, trainer.RdzvBackendStatic)
+	if elastic && torchPolicy.RdzvBackend == nil {
+		rdzvBackend = trainer.RdzvBackendC10d
+	}
+	if rdzvBackend != trainer.RdzvBackendStatic {
+		rdzvEndpoint := ptr.Deref(torchPolicy.RdzvEndpoint, "")
+		if len(rdzvEndpoint) == 0 {
+			rdzvEndpoint = fmt.Sprintf("%s:%d", masterAddr, info.TrainerPort())
+		}
+		petEnvs = append(petEnvs,
+			*corev1ac.EnvVar().
+				WithName(constants.TorchEnvRdzvBackend).
+				WithValue(string(rdzvBackend)),
+			*corev1ac.EnvVar().
+				WithName(constants.TorchEnvRdzvEndpoint).
+				WithValue(rdzvEndpoint),
+			*corev1ac.EnvVar().
+				WithName(constants.TorchEnvRdzvID).
+				WithValue(string(trainJob.UID)),
+		)
+	}
 	masterEnvVars := []corev1ac.EnvVarApplyConfiguration{
 		*corev1ac.EnvVar().
 			WithName(constants.TorchEnvMasterAddr).
-			WithValue(fmt.Sprintf("%s-%s-0-0.%s", trainJob.Name, constants.Node, trainJob.Name)),
+			WithValue(masterAddr),
 		*corev1ac.EnvVar().
 			WithName(constants.TorchEnvMasterPort).
-			WithValue(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+			WithValue(fmt.Sprintf("%d", info.TrainerPort())),
 	}
 
 	// Inject PET_* envs into trainer main container (always).
@@ -185,7 +271,7 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 				newCommand = append(newCommand,
 					fmt.Sprintf("%s=%s-%s-0-0.%s:%d",
 						constants.TorchTuneArgRdzvEndpoint,
-						trainJob.Name, constants.Node, trainJob.Name, constants.ContainerTrainerPort,
+						trainJob.Name, constants.Node, trainJob.Name, info.TrainerPort(),
 					),
 				)
 			}
@@ -200,7 +286,20 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 			trainJob.Spec.Trainer.Command = append(trainJob.Spec.Trainer.Command, newCommand...)
 		}
 		// Add container port for the headless service.
-		apply.UpsertPort(&trainerContainer.Ports, *corev1ac.ContainerPort().WithContainerPort(constants.ContainerTrainerPort))
+		apply.UpsertPort(&trainerContainer.Ports, info.TrainerContainerPort())
+
+		// Wait for the rank-0 node hostname to be resolvable, since the rendezvous can start before the DNS propagation.
+		if t.rendezvousWait != nil && ptr.Deref(trainerPS.Count, 1) > 1 &&
+			!slices.ContainsFunc(trainerPS.InitContainers, func(c runtime.Container) bool { return c.Name == rendezvousWaitContainerName }) {
+			trainerPS.InitContainers = append(trainerPS.InitContainers, runtime.Container{
+				Name:  rendezvousWaitContainerName,
+				Image: t.rendezvousWait.Image,
+				Command: []string{"sh", "-c", fmt.Sprintf(
+					"for i in $(seq 1 %d); do nslookup %s && exit 0; sleep 1; done; echo \"%s is not resolvable\"; exit 1",
+					ptr.Deref(t.rendezvousWait.MaxRetries, 60), masterAddr, masterAddr,
+				)},
+			})
+		}
 	}
 
 	// Inject PET_* envs into additional containers specified by envInjection config.
@@ -224,6 +323,15 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 	return nil
 }
 
+// numProcPerNodeOrDefault returns the number of processes per node derived from the resources,
+// or the configured default when the resources are unknown.
+func (t *Torch) numProcPerNodeOrDefault(numProc int) int {
+	if numProc > 0 {
+		return numProc
+	}
+	return int(t.defaultNumProcPerNode)
+}
+
 // getNumCPUPerNode calculates the number of CPU processes per node based on the provided resources.
 func getNumCPUPerNode(res *corev1.ResourceRequirements) int {
 	if res == nil {
@@ -238,3 +346,16 @@ func getNumCPUPerNode(res *corev1.ResourceRequirements) int {
 	}
 	return int(requestCpuQ.Value())
 }
+
+// getNumMemoryProcPerNode calculates the number of processes per node fitting in the memory budget per process.
+// It returns 0 if the memory is not set in the provided resources or the memory budget is not positive.
+func getNumMemoryProcPerNode(res *corev1.ResourceRequirements, memoryPerProcess *resource.Quantity) int {
+	if res == nil || memoryPerProcess == nil || memoryPerProcess.Sign() <= 0 {
+		return 0
+	}
+	memoryQ := res.Requests.Memory()
+	if memoryQ.IsZero() {
+		memoryQ = res.Limits.Memory()
+	}
+	return int(memoryQ.Value() / memoryPerProcess.Value())
+}
@@ -34,6 +34,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
@@ -43,6 +44,7 @@ import (
 
 func TestTorchEnforceMLPolicy(t *testing.T) {
 	cases := map[string]struct {
+		cfg               *configapi.Configuration
 		info              *runtime.Info
 		trainJob          *trainer.TrainJob
 		wantInfo          *runtime.Info
@@ -57,6 +59,38 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithLabels(map[string]string{"key": "value"}),
 			),
 		},
+		"trainer PodSet without the trainer container": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName("sidecar")),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(2).Obj()).
+				Obj(),
+			wantInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName("sidecar")),
+				),
+			),
+			wantMLPolicyError: fmt.Errorf("%w: the trainer PodSet %q must have the %q container", runtime.ErrorTrainerContainerNotFound, constants.Node, constants.Node),
+		},
 		"no action when mlPolicySource torch is null": {
 			info: runtime.NewInfo(
 				runtime.WithMLPolicySource(
@@ -127,6 +161,88 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("trainJob-node-0-0.trainJob"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"WORLD_SIZE is numNodes multiplied by numProcPerNode": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(
+						corev1ac.Container().WithName(constants.Node),
+					),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(4).
+						NumProcPerNode(8).
+						Obj()).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](4),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("4"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("8"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("32"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
 									Value: ptr.To("trainJob-node-0-0.trainJob"),
@@ -142,13 +258,1357 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
+		"trainerPort from the runtime is used for the master port and the container port": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithTrainerPort(30500).
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(
+						corev1ac.Container().WithName(constants.Node),
+					),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(2).
+						Obj()).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+					TrainerPort: ptr.To[int32](30500),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](2),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](30500),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("2"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("1"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("trainJob-node-0-0.trainJob"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To("30500"),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"trainerPortName from the runtime names the trainer container port": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithTrainerPort(30500).
+						WithTrainerPortName("master").
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(
+						corev1ac.Container().WithName(constants.Node),
+					),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(2).
+						Obj()).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+					TrainerPort:     ptr.To[int32](30500),
+					TrainerPortName: ptr.To("master"),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](2),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								Name:          ptr.To("master"),
+								ContainerPort: ptr.To[int32](30500),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("2"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("1"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("trainJob-node-0-0.trainJob"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To("30500"),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
 		"nproc_per_node=auto with CPU limit": {
 			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
-						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("4"),
-						}).
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("4"),
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("4"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("4"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=auto with no CPU resources": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, nil).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("1"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=auto with no CPU resources falls back to the configured defaultNumProcPerNode": {
+			cfg: &configapi.Configuration{
+				DefaultNumProcPerNode: ptr.To[int32](2),
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, nil).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("2"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=auto with low CPU limit": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("2"), // Low CPU limit
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("2"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=auto with CPU request but no limit": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("3"),
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("3"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("3"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=auto with millicore CPU limit": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("2.5"), // 2.5 CPU cores
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("3"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("3"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=auto with fractional CPU limit": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("0.7"), // 0.7 CPU cores
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("1"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=auto with GPU request should remain auto": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							"example.com/gpu": resource.MustParse("2"),
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("auto"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"explicitly set nproc_per_node should be preserved": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumProcPerNode(3).
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("8"),
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("3"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("3"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=auto with millicore CPU limit in m format": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("2500m"), // 2.5 CPU cores in millicore format
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("3"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("3"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("test-job-node-0-0.test-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=cpu with CPU limit": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "cpu-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("4"),
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("4"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("4"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("cpu-job-node-0-0.cpu-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=memory derives the process count from the memory budget per process": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "memory-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2"),
+							corev1.ResourceMemory: resource.MustParse("32Gi"),
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicyWithMemoryPerProcess(resource.MustParse("8Gi")).
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicyWithMemoryPerProcess(resource.MustParse("8Gi")).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("4"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("4"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("memory-job-node-0-0.memory-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"nproc_per_node=memory falls back to the default process count without the memory request": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "memory-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("test:image", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("2"),
+						}).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicyWithMemoryPerProcess(resource.MustParse("8Gi")).
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicyWithMemoryPerProcess(resource.MustParse("8Gi")).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("1"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("1"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("memory-job-node-0-0.memory-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"elastic training sets PET_NNODES to minNodes:maxNodes with the c10d rendezvous": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "elastic-job").
+				UID("elastic-uid").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(2).
+						NumProcPerNode(4).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicyWithElastic(1, 4).
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicyWithElastic(1, 4).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](2),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("1:4"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("4"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvBackend),
+									Value: ptr.To(string(trainer.RdzvBackendC10d)),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvEndpoint),
+									Value: ptr.To(fmt.Sprintf("elastic-job-node-0-0.elastic-job:%d", constants.ContainerTrainerPort)),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvID),
+									Value: ptr.To("elastic-uid"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("elastic-job-node-0-0.elastic-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"elastic training with equal minNodes and maxNodes keeps the min:max format": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "elastic-job").
+				UID("elastic-uid").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(3).
+						NumProcPerNode(4).
+						Obj(),
+				).
+				Obj(),
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicyWithElastic(3, 3).
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TorchPolicyWithElastic(3, 3).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](3),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.TorchEnvNumNodes),
+									Value: ptr.To("3:3"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
+									Value: ptr.To("4"),
+								},
+								{
+									Name: ptr.To(constants.TorchEnvNodeRank),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvBackend),
+									Value: ptr.To(string(trainer.RdzvBackendC10d)),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvEndpoint),
+									Value: ptr.To(fmt.Sprintf("elastic-job-node-0-0.elastic-job:%d", constants.ContainerTrainerPort)),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvID),
+									Value: ptr.To("elastic-uid"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterAddr),
+									Value: ptr.To("elastic-job-node-0-0.elastic-job"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvMasterPort),
+									Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+		"static rendezvous backend keeps the master address envs only": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "rdzv-job").
+				UID("rdzv-uid").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(2).
+						NumProcPerNode(4).
 						Obj(),
 				).
 				Obj(),
@@ -156,7 +1616,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithMLPolicySource(
 					utiltesting.MakeMLPolicyWrapper().
 						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
-							TorchPolicy().
+							TorchPolicyWithRdzv(trainer.RdzvBackendStatic, nil).
 							Obj(),
 						).
 						Obj(),
@@ -170,14 +1630,14 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
-						TorchPolicy().
+						TorchPolicyWithRdzv(trainer.RdzvBackendStatic, nil).
 						Obj(),
 				},
 				TemplateSpec: runtime.TemplateSpec{
 					PodSets: []runtime.PodSet{{
 						Name:              constants.Node,
 						Ancestor:          ptr.To(constants.AncestorTrainer),
-						Count:             ptr.To[int32](1),
+						Count:             ptr.To[int32](2),
 						SinglePodRequests: make(corev1.ResourceList),
 						Containers: []runtime.Container{{
 							Name: constants.Node,
@@ -187,7 +1647,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 							Env: []corev1ac.EnvVarApplyConfiguration{
 								{
 									Name:  ptr.To(constants.TorchEnvNumNodes),
-									Value: ptr.To("1"),
+									Value: ptr.To("2"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
@@ -201,9 +1661,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("8"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("rdzv-job-node-0-0.rdzv-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -216,11 +1680,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=auto with no CPU resources": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+		"c10d rendezvous backend defaults the endpoint to the rank-0 node": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "rdzv-job").
+				UID("rdzv-uid").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
-						Container("test:image", nil, nil, nil).
+						NumNodes(2).
+						NumProcPerNode(4).
 						Obj(),
 				).
 				Obj(),
@@ -228,7 +1694,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithMLPolicySource(
 					utiltesting.MakeMLPolicyWrapper().
 						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
-							TorchPolicy().
+							TorchPolicyWithRdzv(trainer.RdzvBackendC10d, nil).
 							Obj(),
 						).
 						Obj(),
@@ -242,14 +1708,14 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
-						TorchPolicy().
+						TorchPolicyWithRdzv(trainer.RdzvBackendC10d, nil).
 						Obj(),
 				},
 				TemplateSpec: runtime.TemplateSpec{
 					PodSets: []runtime.PodSet{{
 						Name:              constants.Node,
 						Ancestor:          ptr.To(constants.AncestorTrainer),
-						Count:             ptr.To[int32](1),
+						Count:             ptr.To[int32](2),
 						SinglePodRequests: make(corev1.ResourceList),
 						Containers: []runtime.Container{{
 							Name: constants.Node,
@@ -259,11 +1725,11 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 							Env: []corev1ac.EnvVarApplyConfiguration{
 								{
 									Name:  ptr.To(constants.TorchEnvNumNodes),
-									Value: ptr.To("1"),
+									Value: ptr.To("2"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("1"),
+									Value: ptr.To("4"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -273,9 +1739,25 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("8"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvBackend),
+									Value: ptr.To(string(trainer.RdzvBackendC10d)),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvEndpoint),
+									Value: ptr.To(fmt.Sprintf("rdzv-job-node-0-0.rdzv-job:%d", constants.ContainerTrainerPort)),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvID),
+									Value: ptr.To("rdzv-uid"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("rdzv-job-node-0-0.rdzv-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -288,13 +1770,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=auto with low CPU limit": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+		"etcd rendezvous backend uses the configured endpoint": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "rdzv-job").
+				UID("rdzv-uid").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
-						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("2"), // Low CPU limit
-						}).
+						NumNodes(2).
+						NumProcPerNode(4).
 						Obj(),
 				).
 				Obj(),
@@ -302,7 +1784,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithMLPolicySource(
 					utiltesting.MakeMLPolicyWrapper().
 						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
-							TorchPolicy().
+							TorchPolicyWithRdzv(trainer.RdzvBackendEtcd, ptr.To("etcd.example.com:2379")).
 							Obj(),
 						).
 						Obj(),
@@ -316,14 +1798,14 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
-						TorchPolicy().
+						TorchPolicyWithRdzv(trainer.RdzvBackendEtcd, ptr.To("etcd.example.com:2379")).
 						Obj(),
 				},
 				TemplateSpec: runtime.TemplateSpec{
 					PodSets: []runtime.PodSet{{
 						Name:              constants.Node,
 						Ancestor:          ptr.To(constants.AncestorTrainer),
-						Count:             ptr.To[int32](1),
+						Count:             ptr.To[int32](2),
 						SinglePodRequests: make(corev1.ResourceList),
 						Containers: []runtime.Container{{
 							Name: constants.Node,
@@ -333,11 +1815,11 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 							Env: []corev1ac.EnvVarApplyConfiguration{
 								{
 									Name:  ptr.To(constants.TorchEnvNumNodes),
-									Value: ptr.To("1"),
+									Value: ptr.To("2"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("2"),
+									Value: ptr.To("4"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -347,9 +1829,25 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("8"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvBackend),
+									Value: ptr.To(string(trainer.RdzvBackendEtcd)),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvEndpoint),
+									Value: ptr.To("etcd.example.com:2379"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvID),
+									Value: ptr.To("rdzv-uid"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("rdzv-job-node-0-0.rdzv-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -362,13 +1860,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=auto with CPU request but no limit": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+		"etcd-v2 rendezvous backend uses the configured endpoint": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "rdzv-job").
+				UID("rdzv-uid").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
-						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("3"),
-						}).
+						NumNodes(2).
+						NumProcPerNode(4).
 						Obj(),
 				).
 				Obj(),
@@ -376,7 +1874,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithMLPolicySource(
 					utiltesting.MakeMLPolicyWrapper().
 						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
-							TorchPolicy().
+							TorchPolicyWithRdzv(trainer.RdzvBackendEtcdV2, ptr.To("etcd.example.com:2379")).
 							Obj(),
 						).
 						Obj(),
@@ -390,14 +1888,14 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
-						TorchPolicy().
+						TorchPolicyWithRdzv(trainer.RdzvBackendEtcdV2, ptr.To("etcd.example.com:2379")).
 						Obj(),
 				},
 				TemplateSpec: runtime.TemplateSpec{
 					PodSets: []runtime.PodSet{{
 						Name:              constants.Node,
 						Ancestor:          ptr.To(constants.AncestorTrainer),
-						Count:             ptr.To[int32](1),
+						Count:             ptr.To[int32](2),
 						SinglePodRequests: make(corev1.ResourceList),
 						Containers: []runtime.Container{{
 							Name: constants.Node,
@@ -407,11 +1905,11 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 							Env: []corev1ac.EnvVarApplyConfiguration{
 								{
 									Name:  ptr.To(constants.TorchEnvNumNodes),
-									Value: ptr.To("1"),
+									Value: ptr.To("2"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("3"),
+									Value: ptr.To("4"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -421,9 +1919,25 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("8"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvBackend),
+									Value: ptr.To(string(trainer.RdzvBackendEtcdV2)),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvEndpoint),
+									Value: ptr.To("etcd.example.com:2379"),
+								},
+								{
+									Name:  ptr.To(constants.TorchEnvRdzvID),
+									Value: ptr.To("rdzv-uid"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("rdzv-job-node-0-0.rdzv-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -436,12 +1950,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=auto with millicore CPU limit": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+		"nproc_per_node=auto resolves to GPU count when GPU resources present": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "cpu-gpu-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
 						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("2.5"), // 2.5 CPU cores
+							corev1.ResourceCPU: resource.MustParse("6"),
+							"example.com/gpu":  resource.MustParse("2"),
 						}).
 						Obj(),
 				).
@@ -485,7 +2000,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("3"),
+									Value: ptr.To("auto"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -497,7 +2012,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("cpu-gpu-job-node-0-0.cpu-gpu-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -510,12 +2025,12 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=auto with fractional CPU limit": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+		"nproc_per_node=cpu with fractional CPU": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "cpu-frac-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
 						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("0.7"), // 0.7 CPU cores
+							corev1.ResourceCPU: resource.MustParse("3.7"),
 						}).
 						Obj(),
 				).
@@ -559,7 +2074,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("1"),
+									Value: ptr.To("4"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -569,9 +2084,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("4"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("cpu-frac-job-node-0-0.cpu-frac-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -584,12 +2103,12 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=auto with GPU request should remain auto": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+		"nproc_per_node is read from the Node label copied to the trainer Pod label through the downward API": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "label-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
 						Container("test:image", nil, nil, corev1.ResourceList{
-							"example.com/gpu": resource.MustParse("2"),
+							"nvidia.com/gpu": resource.MustParse("4"),
 						}).
 						Obj(),
 				).
@@ -598,7 +2117,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithMLPolicySource(
 					utiltesting.MakeMLPolicyWrapper().
 						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
-							TorchPolicy().
+							TorchPolicyWithNumProcPerNodeLabel("nvidia.com/gpu.count").
 							Obj(),
 						).
 						Obj(),
@@ -612,7 +2131,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
-						TorchPolicy().
+						TorchPolicyWithNumProcPerNodeLabel("nvidia.com/gpu.count").
 						Obj(),
 				},
 				TemplateSpec: runtime.TemplateSpec{
@@ -632,8 +2151,12 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 									Value: ptr.To("1"),
 								},
 								{
-									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("auto"),
+									Name: ptr.To(constants.TorchEnvNumProcPerNode),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(fmt.Sprintf("metadata.labels['%s']", constants.LabelNumProcPerNode)),
+										},
+									},
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -645,7 +2168,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("label-job-node-0-0.label-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -655,16 +2178,21 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 						}},
 					}},
 				},
-				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+				Scheduler: &runtime.Scheduler{
+					PodLabels: make(map[string]string),
+					PodAnnotations: map[string]string{
+						constants.AnnotationNumProcPerNodeNodeLabel: "nvidia.com/gpu.count",
+					},
+				},
 			},
 		},
-		"explicitly set nproc_per_node should be preserved": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+		"nproc_per_node=gpu resolves to the NVIDIA GPU count": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "nvidia-gpu-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
-						NumProcPerNode(3).
 						Container("test:image", nil, nil, corev1.ResourceList{
 							corev1.ResourceCPU: resource.MustParse("8"),
+							"nvidia.com/gpu":   resource.MustParse("4"),
 						}).
 						Obj(),
 				).
@@ -673,7 +2201,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithMLPolicySource(
 					utiltesting.MakeMLPolicyWrapper().
 						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
-							TorchPolicy().
+							TorchPolicyWithNumProcPerNode(intstr.FromString("gpu")).
 							Obj(),
 						).
 						Obj(),
@@ -687,7 +2215,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
-						TorchPolicy().
+						TorchPolicyWithNumProcPerNode(intstr.FromString("gpu")).
 						Obj(),
 				},
 				TemplateSpec: runtime.TemplateSpec{
@@ -708,7 +2236,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("3"),
+									Value: ptr.To("4"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -718,9 +2246,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("4"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("nvidia-gpu-job-node-0-0.nvidia-gpu-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -733,12 +2265,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=auto with millicore CPU limit in m format": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "test-job").
+		"nproc_per_node=gpu resolves to the AMD GPU count": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "amd-gpu-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
 						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("2500m"), // 2.5 CPU cores in millicore format
+							corev1.ResourceCPU: resource.MustParse("8"),
+							"amd.com/gpu":      resource.MustParse("2"),
 						}).
 						Obj(),
 				).
@@ -747,7 +2280,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithMLPolicySource(
 					utiltesting.MakeMLPolicyWrapper().
 						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
-							TorchPolicy().
+							TorchPolicyWithNumProcPerNode(intstr.FromString("gpu")).
 							Obj(),
 						).
 						Obj(),
@@ -761,7 +2294,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
-						TorchPolicy().
+						TorchPolicyWithNumProcPerNode(intstr.FromString("gpu")).
 						Obj(),
 				},
 				TemplateSpec: runtime.TemplateSpec{
@@ -782,7 +2315,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("3"),
+									Value: ptr.To("2"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -792,9 +2325,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("test-job-node-0-0.test-job"),
+									Value: ptr.To("amd-gpu-job-node-0-0.amd-gpu-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -807,12 +2344,12 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=cpu with CPU limit": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "cpu-job").
+		"nproc_per_node=gpu falls back to 1 without GPU resources": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "no-gpu-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
 						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("4"),
+							corev1.ResourceCPU: resource.MustParse("8"),
 						}).
 						Obj(),
 				).
@@ -821,7 +2358,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				runtime.WithMLPolicySource(
 					utiltesting.MakeMLPolicyWrapper().
 						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
-							TorchPolicy().
+							TorchPolicyWithNumProcPerNode(intstr.FromString("gpu")).
 							Obj(),
 						).
 						Obj(),
@@ -835,7 +2372,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
-						TorchPolicy().
+						TorchPolicyWithNumProcPerNode(intstr.FromString("gpu")).
 						Obj(),
 				},
 				TemplateSpec: runtime.TemplateSpec{
@@ -856,7 +2393,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("4"),
+									Value: ptr.To("1"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -866,9 +2403,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("1"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("cpu-job-node-0-0.cpu-job"),
+									Value: ptr.To("no-gpu-job-node-0-0.no-gpu-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -881,13 +2422,15 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=auto resolves to GPU count when GPU resources present": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "cpu-gpu-job").
+		"multi-node multi-GPU training with complete info": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "gpu-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
-						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("6"),
-							"example.com/gpu":  resource.MustParse("2"),
+						NumNodes(4).
+						Container("pytorch/pytorch:2.0.0-cuda11.7-cudnn8-runtime", nil, nil, corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("8"),
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+							"example.com/gpu":     resource.MustParse("4"), // 4 GPUs per node
 						}).
 						Obj(),
 				).
@@ -901,12 +2444,19 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 						).
 						Obj(),
 				),
-				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+				runtime.WithLabels(map[string]string{
+					"app": "pytorch-training",
+					"env": "production",
+				}),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 2, corev1.PodSpec{}, corev1ac.PodSpec().
 					WithContainers(corev1ac.Container().WithName(constants.Node)),
 				),
 			),
 			wantInfo: &runtime.Info{
-				Labels:      make(map[string]string),
+				Labels: map[string]string{
+					"app": "pytorch-training",
+					"env": "production",
+				},
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
@@ -917,7 +2467,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 					PodSets: []runtime.PodSet{{
 						Name:              constants.Node,
 						Ancestor:          ptr.To(constants.AncestorTrainer),
-						Count:             ptr.To[int32](1),
+						Count:             ptr.To[int32](4),
 						SinglePodRequests: make(corev1.ResourceList),
 						Containers: []runtime.Container{{
 							Name: constants.Node,
@@ -927,7 +2477,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 							Env: []corev1ac.EnvVarApplyConfiguration{
 								{
 									Name:  ptr.To(constants.TorchEnvNumNodes),
-									Value: ptr.To("1"),
+									Value: ptr.To("4"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
@@ -943,7 +2493,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("cpu-gpu-job-node-0-0.cpu-gpu-job"),
+									Value: ptr.To("gpu-job-node-0-0.gpu-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -956,13 +2506,18 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"nproc_per_node=cpu with fractional CPU": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "cpu-frac-job").
+		"multi-node training waits for the rank-0 node hostname with rendezvousWait": {
+			cfg: &configapi.Configuration{
+				RendezvousWait: &configapi.RendezvousWait{
+					Image:      "busybox:1.37",
+					MaxRetries: ptr.To[int32](30),
+				},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "dns-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
-						Container("test:image", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU: resource.MustParse("3.7"),
-						}).
+						NumNodes(2).
+						NumProcPerNode(1).
 						Obj(),
 				).
 				Obj(),
@@ -991,8 +2546,16 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 					PodSets: []runtime.PodSet{{
 						Name:              constants.Node,
 						Ancestor:          ptr.To(constants.AncestorTrainer),
-						Count:             ptr.To[int32](1),
+						Count:             ptr.To[int32](2),
 						SinglePodRequests: make(corev1.ResourceList),
+						InitContainers: []runtime.Container{{
+							Name:  rendezvousWaitContainerName,
+							Image: "busybox:1.37",
+							Command: []string{
+								"sh", "-c",
+								`for i in $(seq 1 30); do nslookup dns-job-node-0-0.dns-job && exit 0; sleep 1; done; echo "dns-job-node-0-0.dns-job is not resolvable"; exit 1`,
+							},
+						}},
 						Containers: []runtime.Container{{
 							Name: constants.Node,
 							Ports: []corev1ac.ContainerPortApplyConfiguration{{
@@ -1001,11 +2564,11 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 							Env: []corev1ac.EnvVarApplyConfiguration{
 								{
 									Name:  ptr.To(constants.TorchEnvNumNodes),
-									Value: ptr.To("1"),
+									Value: ptr.To("2"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("4"),
+									Value: ptr.To("1"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -1015,9 +2578,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("cpu-frac-job-node-0-0.cpu-frac-job"),
+									Value: ptr.To("dns-job-node-0-0.dns-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -1030,16 +2597,18 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
-		"multi-node multi-GPU training with complete info": {
-			trainJob: utiltesting.MakeTrainJobWrapper("default", "gpu-job").
+		"single-node training does not wait for the rank-0 node hostname with rendezvousWait": {
+			cfg: &configapi.Configuration{
+				RendezvousWait: &configapi.RendezvousWait{
+					Image:      "busybox:1.37",
+					MaxRetries: ptr.To[int32](30),
+				},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "dns-job").
 				Trainer(
 					utiltesting.MakeTrainJobTrainerWrapper().
-						NumNodes(4).
-						Container("pytorch/pytorch:2.0.0-cuda11.7-cudnn8-runtime", nil, nil, corev1.ResourceList{
-							corev1.ResourceCPU:    resource.MustParse("8"),
-							corev1.ResourceMemory: resource.MustParse("16Gi"),
-							"example.com/gpu":     resource.MustParse("4"), // 4 GPUs per node
-						}).
+						NumNodes(1).
+						NumProcPerNode(1).
 						Obj(),
 				).
 				Obj(),
@@ -1052,19 +2621,12 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 						).
 						Obj(),
 				),
-				runtime.WithLabels(map[string]string{
-					"app": "pytorch-training",
-					"env": "production",
-				}),
-				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 2, corev1.PodSpec{}, corev1ac.PodSpec().
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
 					WithContainers(corev1ac.Container().WithName(constants.Node)),
 				),
 			),
 			wantInfo: &runtime.Info{
-				Labels: map[string]string{
-					"app": "pytorch-training",
-					"env": "production",
-				},
+				Labels:      make(map[string]string),
 				Annotations: make(map[string]string),
 				RuntimePolicy: runtime.RuntimePolicy{
 					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
@@ -1075,7 +2637,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 					PodSets: []runtime.PodSet{{
 						Name:              constants.Node,
 						Ancestor:          ptr.To(constants.AncestorTrainer),
-						Count:             ptr.To[int32](4),
+						Count:             ptr.To[int32](1),
 						SinglePodRequests: make(corev1.ResourceList),
 						Containers: []runtime.Container{{
 							Name: constants.Node,
@@ -1085,11 +2647,11 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 							Env: []corev1ac.EnvVarApplyConfiguration{
 								{
 									Name:  ptr.To(constants.TorchEnvNumNodes),
-									Value: ptr.To("4"),
+									Value: ptr.To("1"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvNumProcPerNode),
-									Value: ptr.To("auto"),
+									Value: ptr.To("1"),
 								},
 								{
 									Name: ptr.To(constants.TorchEnvNodeRank),
@@ -1099,9 +2661,13 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("1"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
-									Value: ptr.To("gpu-job-node-0-0.gpu-job"),
+									Value: ptr.To("dns-job-node-0-0.dns-job"),
 								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterPort),
@@ -1271,6 +2837,10 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("1"),
+								},
 							},
 							Ports: []corev1ac.ContainerPortApplyConfiguration{{
 								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
@@ -1355,6 +2925,10 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("16"),
+								},
 							},
 							Ports: []corev1ac.ContainerPortApplyConfiguration{{
 								ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
@@ -1438,6 +3012,10 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
 									Value: ptr.To("test-job-node-0-0.test-job"),
@@ -1470,6 +3048,10 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("2"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
 									Value: ptr.To("test-job-node-0-0.test-job"),
@@ -1563,6 +3145,10 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name:  ptr.To(constants.TorchEnvWorldSize),
+									Value: ptr.To("1"),
+								},
 								{
 									Name:  ptr.To(constants.TorchEnvMasterAddr),
 									Value: ptr.To("test-job-node-0-0.test-job"),
@@ -1768,6 +3354,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 									{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 									}},
+									{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("2")},
 									{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 									{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 								},
@@ -1780,6 +3367,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 									{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 									}},
+									{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("2")},
 									{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 									{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 								},
@@ -1794,6 +3382,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 									FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 								}},
+								{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("2")},
 								{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 								{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 							},
@@ -1863,6 +3452,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 									{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 									}},
+									{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("1")},
 									{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 									{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 								},
@@ -1876,6 +3466,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 									{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 									}},
+									{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("1")},
 									{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 									{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 								},
@@ -1939,6 +3530,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 									FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 								}},
+								{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("1")},
 								{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 								{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 							},
@@ -1998,6 +3590,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 								{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 									FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 								}},
+								{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("1")},
 								{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 								{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 							},
@@ -2065,6 +3658,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 											FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 										}},
+										{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("2")},
 										{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 										{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 									},
@@ -2078,6 +3672,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 										{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 											FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 										}},
+										{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("2")},
 										{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 										{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 									},
@@ -2096,6 +3691,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 									{Name: ptr.To(constants.TorchEnvNodeRank), ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
 										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{FieldPath: ptr.To(constants.JobCompletionIndexFieldPath)},
 									}},
+									{Name: ptr.To(constants.TorchEnvWorldSize), Value: ptr.To("2")},
 									{Name: ptr.To(constants.TorchEnvMasterAddr), Value: ptr.To("test-job-node-0-0.test-job")},
 									{Name: ptr.To(constants.TorchEnvMasterPort), Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort))},
 								},
@@ -2116,7 +3712,7 @@ func TestTorchEnforceMLPolicy(t *testing.T) {
 			ctx, cancel = context.WithCancel(ctx)
 			t.Cleanup(cancel)
 			cliBuilder := utiltesting.NewClientBuilder()
-			p, err := New(ctx, cliBuilder.Build(), nil, nil)
+			p, err := New(ctx, cliBuilder.Build(), nil, tc.cfg)
 			if err != nil {
 				t.Fatalf("Failed to initialize Torch plugin: %v", err)
 			}
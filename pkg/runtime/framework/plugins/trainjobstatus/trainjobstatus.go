@@ -51,7 +51,7 @@ const (
 	tokenVolumeName = "kubeflow-trainer-token"
 
 	// Service account token configuration
-	tokenExpirySeconds = 3600
+	defaultTokenExpirySeconds = 3600
 
 	// Server tls config
 	caCertKey = "ca.crt"
@@ -74,7 +74,7 @@ func (p *Status) Name() string {
 }
 
 func (p *Status) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
-	if info == nil || trainJob == nil {
+	if info == nil || trainJob == nil || progressReportingDisabled(trainJob) {
 		return nil
 	}
 
@@ -83,7 +83,7 @@ func (p *Status) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 		return err
 	}
 	volumeMount := createTokenVolumeMount()
-	volume := createTokenVolume(trainJob)
+	volume := createTokenVolume(trainJob, p.tokenExpirySeconds())
 
 	// Inject into all trainer containers
 	trainerPS := info.FindPodSetByAncestor(constants.AncestorTrainer)
@@ -101,7 +101,7 @@ func (p *Status) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 func (p *Status) SyncParallelCount(_ *runtime.Info) error { return nil }
 
 func (p *Status) Build(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) ([]apiruntime.ApplyConfiguration, error) {
-	if info == nil || trainJob == nil {
+	if info == nil || trainJob == nil || progressReportingDisabled(trainJob) {
 		return nil, nil
 	}
 
@@ -113,6 +113,11 @@ func (p *Status) Build(ctx context.Context, info *runtime.Info, trainJob *traine
 	return []apiruntime.ApplyConfiguration{configMap}, nil
 }
 
+// progressReportingDisabled returns true if the TrainJob opted out of the progress reporting to the status server.
+func progressReportingDisabled(trainJob *trainer.TrainJob) bool {
+	return trainJob.Annotations[constants.AnnotationDisableProgressReporting] == "true"
+}
+
 func (p *Status) createEnvVars(trainJob *trainer.TrainJob) ([]corev1ac.EnvVarApplyConfiguration, error) {
 	if p.cfg.StatusServer.Port == nil {
 		return nil, fmt.Errorf("missing status server port")
@@ -142,7 +147,15 @@ func createTokenVolumeMount() corev1ac.VolumeMountApplyConfiguration {
 		WithReadOnly(true)
 }
 
-func createTokenVolume(trainJob *trainer.TrainJob) corev1ac.VolumeApplyConfiguration {
+// tokenExpirySeconds returns the expiry of the projected service account token from the configuration.
+func (p *Status) tokenExpirySeconds() int64 {
+	if p.cfg.Progress == nil || p.cfg.Progress.TokenExpirySeconds == nil {
+		return defaultTokenExpirySeconds
+	}
+	return *p.cfg.Progress.TokenExpirySeconds
+}
+
+func createTokenVolume(trainJob *trainer.TrainJob, expirySeconds int64) corev1ac.VolumeApplyConfiguration {
 	configMapName := fmt.Sprintf("%s-tls-config", trainJob.Name)
 
 	return *corev1ac.Volume().
@@ -154,7 +167,7 @@ func createTokenVolume(trainJob *trainer.TrainJob) corev1ac.VolumeApplyConfigura
 						WithServiceAccountToken(
 							corev1ac.ServiceAccountTokenProjection().
 								WithAudience(statusserver.TokenAudience(trainJob.Namespace, trainJob.Name)).
-								WithExpirationSeconds(tokenExpirySeconds).
+								WithExpirationSeconds(expirySeconds).
 								WithPath(tokenFileName),
 						),
 					corev1ac.VolumeProjection().
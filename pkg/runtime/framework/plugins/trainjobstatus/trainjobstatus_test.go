@@ -44,6 +44,7 @@ func TestEnforceMLPolicy(t *testing.T) {
 	cases := map[string]struct {
 		info      *runtime.Info
 		trainJob  *trainer.TrainJob
+		progress  *configapi.Progress
 		wantInfo  *runtime.Info
 		wantError error
 	}{
@@ -78,6 +79,41 @@ func TestEnforceMLPolicy(t *testing.T) {
 				},
 			},
 		},
+		"does nothing if the progress reporting is disabled": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:     "trainer",
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Count:    ptr.To[int32](2),
+							Containers: []runtime.Container{
+								{Name: constants.Node},
+							},
+						},
+					},
+				},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("test-uid").
+				Annotation(constants.AnnotationDisableProgressReporting, "true").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(2).Obj()).
+				Obj(),
+			wantInfo: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:     "trainer",
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Count:    ptr.To[int32](2),
+							Containers: []runtime.Container{
+								{Name: constants.Node},
+							},
+						},
+					},
+				},
+			},
+		},
 		"injects runtime configuration into trainer containers": {
 			info: &runtime.Info{
 				TemplateSpec: runtime.TemplateSpec{
@@ -136,7 +172,7 @@ func TestEnforceMLPolicy(t *testing.T) {
 													WithServiceAccountToken(
 														corev1ac.ServiceAccountTokenProjection().
 															WithAudience("trainer.kubeflow.org/v1alpha1/namespaces/default/trainjobs/test-job/status").
-															WithExpirationSeconds(tokenExpirySeconds).
+															WithExpirationSeconds(defaultTokenExpirySeconds).
 															WithPath(tokenFileName),
 													),
 												corev1ac.VolumeProjection().
@@ -236,7 +272,89 @@ func TestEnforceMLPolicy(t *testing.T) {
 													WithServiceAccountToken(
 														corev1ac.ServiceAccountTokenProjection().
 															WithAudience("trainer.kubeflow.org/v1alpha1/namespaces/default/trainjobs/test-job/status").
-															WithExpirationSeconds(tokenExpirySeconds).
+															WithExpirationSeconds(defaultTokenExpirySeconds).
+															WithPath(tokenFileName),
+													),
+												corev1ac.VolumeProjection().
+													WithConfigMap(
+														corev1ac.ConfigMapProjection().
+															WithName("test-job-tls-config").
+															WithItems(
+																corev1ac.KeyToPath().
+																	WithKey(caCertKey).
+																	WithPath(caCertFileName),
+															),
+													),
+											),
+									),
+							},
+						},
+					},
+				},
+			},
+		},
+		"uses the configured token expiry": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:     "trainer",
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Count:    ptr.To[int32](1),
+							Containers: []runtime.Container{
+								{Name: constants.Node},
+							},
+						},
+					},
+				},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("test-uid").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(1).Obj()).
+				Obj(),
+			progress: &configapi.Progress{
+				TokenExpirySeconds: ptr.To[int64](86400),
+			},
+			wantInfo: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:     "trainer",
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Count:    ptr.To[int32](1),
+							Containers: []runtime.Container{
+								{
+									Name: constants.Node,
+									Env: []corev1ac.EnvVarApplyConfiguration{
+										*corev1ac.EnvVar().
+											WithName(envNameStatusURL).
+											WithValue("https://kubeflow-trainer-controller-manager.kubeflow-system.svc:10443/apis/trainer.kubeflow.org/v1alpha1/namespaces/default/trainjobs/test-job/status"),
+										*corev1ac.EnvVar().
+											WithName(envNameCACert).
+											WithValue(fmt.Sprintf("%s/%s", configMountPath, caCertFileName)),
+										*corev1ac.EnvVar().
+											WithName(envNameToken).
+											WithValue(fmt.Sprintf("%s/%s", configMountPath, tokenFileName)),
+									},
+									VolumeMounts: []corev1ac.VolumeMountApplyConfiguration{
+										*corev1ac.VolumeMount().
+											WithName(tokenVolumeName).
+											WithMountPath(configMountPath).
+											WithReadOnly(true),
+									},
+								},
+							},
+							Volumes: []corev1ac.VolumeApplyConfiguration{
+								*corev1ac.Volume().
+									WithName(tokenVolumeName).
+									WithProjected(
+										corev1ac.ProjectedVolumeSource().
+											WithSources(
+												corev1ac.VolumeProjection().
+													WithServiceAccountToken(
+														corev1ac.ServiceAccountTokenProjection().
+															WithAudience("trainer.kubeflow.org/v1alpha1/namespaces/default/trainjobs/test-job/status").
+															WithExpirationSeconds(86400).
 															WithPath(tokenFileName),
 													),
 												corev1ac.VolumeProjection().
@@ -277,6 +395,7 @@ func TestEnforceMLPolicy(t *testing.T) {
 					QPS:   ptr.To[float32](5),
 					Burst: ptr.To[int32](10),
 				},
+				Progress: tc.progress,
 			}
 
 			p, err := New(ctx, cli, nil, cfg)
@@ -336,6 +455,28 @@ func TestBuild(t *testing.T) {
 			trainJob: nil,
 			wantObjs: nil,
 		},
+		"no action when the progress reporting is disabled": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:     "trainer",
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Count:    ptr.To[int32](2),
+							Containers: []runtime.Container{
+								{Name: constants.Node},
+							},
+						},
+					},
+				},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("test-uid").
+				Annotation(constants.AnnotationDisableProgressReporting, "true").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(2).Obj()).
+				Obj(),
+			wantObjs: nil,
+		},
 		"creates ConfigMap with CA cert from secret": {
 			objs: []client.Object{
 				&corev1.Secret{
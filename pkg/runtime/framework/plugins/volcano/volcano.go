@@ -27,6 +27,7 @@ import (
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -50,9 +51,11 @@ import (
 
 	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
+	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
 )
 
 type Volcano struct {
@@ -153,7 +156,10 @@ func (v *Volcano) Build(ctx context.Context, info *runtime.Info, trainJob *train
 		}
 		oldPodGroup = nil
 	}
-	if oldPodGroup != nil && !ptr.Deref(trainJob.Spec.Suspend, false) {
+	// The PodGroup of the single-node canary is recomputed once the trainer is scaled to numNodes.
+	canaryReplaced := oldPodGroup != nil && metav1.HasAnnotation(oldPodGroup.ObjectMeta, constants.AnnotationCanary) &&
+		!trainjob.IsCanaryPending(trainJob)
+	if oldPodGroup != nil && !ptr.Deref(trainJob.Spec.Suspend, false) && !canaryReplaced {
 		return nil, nil
 	}
 
@@ -176,6 +182,9 @@ func (v *Volcano) Build(ctx context.Context, info *runtime.Info, trainJob *train
 		WithSpec(volcanov1beta1ac.PodGroupSpec().
 			WithMinMember(totalMembers).
 			WithMinResources(totalResources))
+	if trainjob.IsCanaryPending(trainJob) {
+		pg.WithAnnotations(map[string]string{constants.AnnotationCanary: "true"})
+	}
 
 	// Configure queue via annotations `scheduling.volcano.sh/queue-name`.
 	// The field is initially set in TrainingRuntime, but can be overridden by the TrainJob.
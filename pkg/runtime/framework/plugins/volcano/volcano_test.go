@@ -46,6 +46,7 @@ import (
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
@@ -303,6 +304,110 @@ func TestVolcano(t *testing.T) {
 			expectEnforcePodGroupError: nil,
 			expectBuildError:           nil,
 		},
+		"PodGroup of the trainer canary is recomputed once the trainer is scaled to numNodes": {
+			trainJob: &trainer.TrainJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "job-canary", Namespace: "test-ns", UID: "3"},
+				Spec: trainer.TrainJobSpec{
+					Suspend: ptr.To(false),
+					Trainer: &trainer.Trainer{Canary: ptr.To(true)},
+				},
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainJobCanary,
+						Status: metav1.ConditionTrue,
+						Reason: trainer.TrainJobCanarySucceededReason,
+					}},
+				},
+			},
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: jobSetSpecApply,
+					PodSets: []runtime.PodSet{{
+						Name:  "worker",
+						Count: ptr.To[int32](4),
+						SinglePodRequests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					}},
+				},
+				RuntimePolicy: runtime.RuntimePolicy{
+					PodGroupPolicy: &trainer.PodGroupPolicy{
+						PodGroupPolicySource: trainer.PodGroupPolicySource{
+							Volcano: &trainer.VolcanoPodGroupPolicySource{},
+						},
+					},
+				},
+				Scheduler: &runtime.Scheduler{},
+			},
+			objs: []client.Object{
+				&volcanov1beta1.PodGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "job-canary",
+						Namespace:   "test-ns",
+						Annotations: map[string]string{constants.AnnotationCanary: "true"},
+					},
+					Spec: volcanov1beta1.PodGroupSpec{MinMember: 1},
+				},
+			},
+			expectInfo: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: jobSetSpecApply,
+					PodSets: []runtime.PodSet{{
+						Name:  "worker",
+						Count: ptr.To[int32](4),
+						SinglePodRequests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					}},
+				},
+				RuntimePolicy: runtime.RuntimePolicy{
+					PodGroupPolicy: &trainer.PodGroupPolicy{
+						PodGroupPolicySource: trainer.PodGroupPolicySource{
+							Volcano: &trainer.VolcanoPodGroupPolicySource{},
+						},
+					},
+				},
+				Scheduler: &runtime.Scheduler{
+					PodAnnotations: map[string]string{
+						volcanov1beta1.KubeGroupNameAnnotationKey: "job-canary",
+					},
+				},
+			},
+			expectObjs: []apiruntime.Object{
+				&volcanov1beta1.PodGroup{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: volcanov1beta1.SchemeGroupVersion.String(),
+						Kind:       "PodGroup",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "job-canary",
+						Namespace: "test-ns",
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion:         trainer.GroupVersion.String(),
+								Kind:               trainer.TrainJobKind,
+								Name:               "job-canary",
+								UID:                types.UID(strconv.Itoa(3)),
+								Controller:         ptr.To(true),
+								BlockOwnerDeletion: ptr.To(true),
+							},
+						},
+					},
+					Spec: volcanov1beta1.PodGroupSpec{
+						MinMember: 4,
+						MinResources: &corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("4Gi"),
+						},
+						PriorityClassName: "high-priority",
+					},
+				},
+			},
+			expectEnforcePodGroupError: nil,
+			expectBuildError:           nil,
+		},
 		"Error when getting existing PodGroup": {
 			trainJob: &trainer.TrainJob{
 				ObjectMeta: metav1.ObjectMeta{Name: "job-error", Namespace: "test-ns", UID: "3"},
@@ -26,6 +26,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 
 	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
@@ -51,6 +52,7 @@ func (x *XGBoost) Name() string {
 }
 
 func (x *XGBoost) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
 	var allErrs field.ErrorList
 	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil ||
 		runtimeInfo.RuntimePolicy.MLPolicySource.XGBoost == nil {
@@ -66,8 +68,34 @@ func (x *XGBoost) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newO
 				))
 			}
 		}
+		// The TrainJob resources replace the runtime resources rather than being added to them,
+		// so the number of workers per node is only derived from the TrainJob resources.
+		if newObj.Spec.Trainer.ResourcesPerNode != nil && runtime.ExtractResourcePerNodeFromRuntime(runtimeInfo) != nil {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s overrides the resources of the %s container in the runtime rather than being summed with them; the number of XGBoost workers per node is derived from the TrainJob resources only",
+				field.NewPath("spec", "trainer", "resourcesPerNode"), constants.Node,
+			))
+		}
+	}
+	// The reserved envs set by the runtime are reported against the runtimeRef,
+	// since they come from the runtime template rather than the TrainJob.
+	runtimeRefPath := field.NewPath("spec", "runtimeRef")
+	for _, ps := range runtimeInfo.TemplateSpec.PodSets {
+		for _, container := range ps.Containers {
+			if container.Name != constants.Node {
+				continue
+			}
+			for _, env := range container.Env {
+				if name := ptr.Deref(env.Name, ""); constants.XGBoostReservedEnvNames.Has(name) {
+					allErrs = append(allErrs, field.Forbidden(
+						runtimeRefPath,
+						fmt.Sprintf("%s is reserved for the XGBoost runtime, but it is set by the runtime in the %s container of the %s job", name, container.Name, ps.Name),
+					))
+				}
+			}
+		}
 	}
-	return nil, allErrs
+	return warnings, allErrs
 }
 
 func (x *XGBoost) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
@@ -82,6 +110,9 @@ func (x *XGBoost) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob
 	if trainerPS == nil {
 		return nil
 	}
+	if info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node) == nil {
+		return fmt.Errorf("%w: the trainer PodSet %q must have the %q container", runtime.ErrorTrainerContainerNotFound, trainerPS.Name, constants.Node)
+	}
 
 	// Set the number of nodes from TrainJob if specified.
 	if trainerPS.Count != nil &&
@@ -125,7 +156,7 @@ func (x *XGBoost) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob
 				// DMLC_TRACKER_PORT - Default tracker port.
 				*corev1ac.EnvVar().
 					WithName(constants.XGBoostEnvTrackerPort).
-					WithValue(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+					WithValue(fmt.Sprintf("%d", info.TrainerPort())),
 				// DMLC_TASK_ID - Worker rank from Job completion index.
 				*corev1ac.EnvVar().
 					WithName(constants.XGBoostEnvTaskID).
@@ -139,11 +170,111 @@ func (x *XGBoost) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob
 			)
 
 			// Add container port for tracker communication.
-			apply.UpsertPort(&trainerContainer.Ports,
-				*corev1ac.ContainerPort().
-					WithContainerPort(constants.ContainerTrainerPort))
+			apply.UpsertPort(&trainerContainer.Ports, info.TrainerContainerPort())
+
+			// Configure the parameter-server topology.
+			if numServers := ptr.Deref(info.RuntimePolicy.MLPolicySource.XGBoost.NumServers, 0); numServers > 0 {
+				numServerEnv := *corev1ac.EnvVar().
+					WithName(constants.XGBoostEnvNumServer).
+					WithValue(fmt.Sprintf("%d", numServers))
+				apply.UpsertEnvVars(&trainerContainer.Env,
+					*corev1ac.EnvVar().
+						WithName(constants.XGBoostEnvRole).
+						WithValue(constants.XGBoostRoleWorker),
+					numServerEnv,
+				)
+				serverPS, err := ensureServerPodSet(info)
+				if err != nil {
+					return err
+				}
+				if serverPS == nil {
+					return nil
+				}
+				serverPS.Count = ptr.To(numServers)
+				for i := range serverPS.Containers {
+					if serverPS.Containers[i].Name != constants.Node {
+						continue
+					}
+					apply.UpsertEnvVars(&serverPS.Containers[i].Env,
+						*corev1ac.EnvVar().
+							WithName(constants.XGBoostEnvTrackerURI).
+							WithValue(trackerURI),
+						*corev1ac.EnvVar().
+							WithName(constants.XGBoostEnvTrackerPort).
+							WithValue(fmt.Sprintf("%d", info.TrainerPort())),
+						// JOB_COMPLETION_INDEX must be defined before DMLC_TASK_ID to be referenced by it.
+						*corev1ac.EnvVar().
+							WithName(constants.JobCompletionIndexEnv).
+							WithValueFrom(corev1ac.EnvVarSource().
+								WithFieldRef(corev1ac.ObjectFieldSelector().
+									WithFieldPath(constants.JobCompletionIndexFieldPath))),
+						// DMLC_TASK_ID - Server task ID in a separate range from the worker ranks.
+						*corev1ac.EnvVar().
+							WithName(constants.XGBoostEnvTaskID).
+							WithValue(fmt.Sprintf("%s$(%s)", constants.XGBoostServerTaskIDPrefix, constants.JobCompletionIndexEnv)),
+						*corev1ac.EnvVar().
+							WithName(constants.XGBoostEnvNumWorker).
+							WithValue(fmt.Sprintf("%d", totalWorkers)),
+						numServerEnv,
+						*corev1ac.EnvVar().
+							WithName(constants.XGBoostEnvRole).
+							WithValue(constants.XGBoostRoleServer),
+					)
+				}
+			}
 		}
 	}
 
 	return nil
 }
+
+// ensureServerPodSet returns the PodSet for the XGBoost parameter servers.
+// If the runtime doesn't define the server Job, it is created from the trainer Job template.
+func ensureServerPodSet(info *runtime.Info) (*runtime.PodSet, error) {
+	if serverPS := info.FindPodSetByName(constants.XGBoostServer); serverPS != nil {
+		return serverPS, nil
+	}
+	jobSetSpec, ok := runtime.TemplateSpecApply[jobsetv1alpha2ac.JobSetSpecApplyConfiguration](info)
+	if !ok {
+		return nil, nil
+	}
+	trainerPS := info.FindPodSetByAncestor(constants.AncestorTrainer)
+	if trainerPS == nil {
+		return nil, nil
+	}
+	for _, rJob := range jobSetSpec.ReplicatedJobs {
+		if rJob.Name == nil || *rJob.Name != trainerPS.Name {
+			continue
+		}
+		// Copy the trainer Job template, so the server Job doesn't share any references with it.
+		serverJob, err := apply.DeepCopy(&rJob)
+		if err != nil {
+			return nil, err
+		}
+		serverJob.WithName(constants.XGBoostServer).WithReplicas(1)
+		if serverJob.Template != nil && serverJob.Template.ObjectMetaApplyConfiguration != nil {
+			delete(serverJob.Template.Labels, constants.LabelTrainJobAncestor)
+		}
+		jobSetSpec.ReplicatedJobs = append(jobSetSpec.ReplicatedJobs, *serverJob)
+
+		serverPS := runtime.PodSet{
+			Name:              constants.XGBoostServer,
+			Count:             ptr.To[int32](1),
+			Volumes:           serverJob.Template.Spec.Template.Spec.Volumes,
+			SinglePodRequests: trainerPS.SinglePodRequests.DeepCopy(),
+		}
+		for _, c := range serverJob.Template.Spec.Template.Spec.Containers {
+			serverPS.Containers = append(serverPS.Containers, runtime.Container{
+				Name:         ptr.Deref(c.Name, ""),
+				Image:        ptr.Deref(c.Image, ""),
+				Command:      c.Command,
+				Env:          c.Env,
+				Ports:        c.Ports,
+				VolumeMounts: c.VolumeMounts,
+			})
+		}
+		info.TemplateSpec.PodSets = append(info.TemplateSpec.PodSets, serverPS)
+		return &info.TemplateSpec.PodSets[len(info.TemplateSpec.PodSets)-1], nil
+	}
+	return nil, nil
+}
@@ -31,9 +31,11 @@ import (
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	"k8s.io/klog/v2/ktesting"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
@@ -42,9 +44,10 @@ import (
 
 func TestXGBoostValidate(t *testing.T) {
 	cases := map[string]struct {
-		runtimeInfo *runtime.Info
-		trainJob    *trainer.TrainJob
-		wantErrs    field.ErrorList
+		runtimeInfo  *runtime.Info
+		trainJob     *trainer.TrainJob
+		wantErrs     field.ErrorList
+		wantWarnings admission.Warnings
 	}{
 		"no error when runtimeInfo is nil": {
 			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
@@ -159,6 +162,38 @@ func TestXGBoostValidate(t *testing.T) {
 				),
 			},
 		},
+		"error when the runtime sets reserved DMLC_TRACKER_PORT env": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							XGBoostPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().
+						WithName(constants.Node).
+						WithEnv(corev1ac.EnvVar().WithName(constants.XGBoostEnvTrackerPort).WithValue("9091")),
+					),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(1).
+						Obj(),
+				).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Forbidden(
+					field.NewPath("spec", "runtimeRef"),
+					fmt.Sprintf("%s is reserved for the XGBoost runtime, but it is set by the runtime in the %s container of the %s job",
+						constants.XGBoostEnvTrackerPort, constants.Node, constants.Node),
+				),
+			},
+		},
 		"multiple errors when using multiple reserved envs": {
 			runtimeInfo: runtime.NewInfo(
 				runtime.WithMLPolicySource(
@@ -192,6 +227,91 @@ func TestXGBoostValidate(t *testing.T) {
 				),
 			},
 		},
+		"warning when resources are set in both Runtime and TrainJob": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							XGBoostPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithTemplateSpecObjApply(
+					jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.Node).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithSpec(batchv1ac.JobSpec().
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec().
+												WithContainers(
+													corev1ac.Container().
+														WithName(constants.Node).
+														WithResources(corev1ac.ResourceRequirements().
+															WithRequests(corev1.ResourceList{
+																"example.com/gpu": resource.MustParse("1"),
+															}),
+														),
+												),
+											),
+										),
+									),
+								),
+						),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("xgboost/xgboost:latest", nil, nil, corev1.ResourceList{
+							"example.com/gpu": resource.MustParse("3"),
+						}).
+						Obj(),
+				).
+				Obj(),
+			wantWarnings: admission.Warnings{
+				"spec.trainer.resourcesPerNode overrides the resources of the node container in the runtime rather than being summed with them; the number of XGBoost workers per node is derived from the TrainJob resources only",
+			},
+		},
+		"no warning when resources are only set in TrainJob": {
+			runtimeInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							XGBoostPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithTemplateSpecObjApply(
+					jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.Node).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithSpec(batchv1ac.JobSpec().
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec().
+												WithContainers(corev1ac.Container().WithName(constants.Node)),
+											),
+										),
+									),
+								),
+						),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						Container("xgboost/xgboost:latest", nil, nil, corev1.ResourceList{
+							"example.com/gpu": resource.MustParse("3"),
+						}).
+						Obj(),
+				).
+				Obj(),
+		},
 	}
 
 	for name, tc := range cases {
@@ -207,10 +327,13 @@ func TestXGBoostValidate(t *testing.T) {
 			}
 
 			// Test Validate
-			_, errs := p.(framework.CustomValidationPlugin).Validate(ctx, tc.runtimeInfo, nil, tc.trainJob)
+			warnings, errs := p.(framework.CustomValidationPlugin).Validate(ctx, tc.runtimeInfo, nil, tc.trainJob)
 			if diff := cmp.Diff(tc.wantErrs, errs, cmpopts.IgnoreFields(field.Error{}, "Detail")); len(diff) != 0 {
 				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.wantWarnings, warnings); len(diff) != 0 {
+				t.Errorf("Unexpected validation warnings (-want,+got):\n%s", diff)
+			}
 		})
 	}
 }
@@ -243,6 +366,38 @@ func TestXGBoostEnforceMLPolicy(t *testing.T) {
 				),
 			),
 		},
+		"trainer PodSet without the trainer container": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							XGBoostPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName("sidecar")),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().NumNodes(2).Obj()).
+				Obj(),
+			wantInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							XGBoostPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName("sidecar")),
+				),
+			),
+			wantMLPolicyError: runtime.ErrorTrainerContainerNotFound,
+		},
 		"no env injection when trainJob.Spec.Trainer is nil": {
 			info: runtime.NewInfo(
 				runtime.WithMLPolicySource(
@@ -347,6 +502,75 @@ func TestXGBoostEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
+		"trainerPort from the runtime is used for the tracker port and the container port": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithTrainerPort(30500).
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							XGBoostPolicy().
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().WithName(constants.Node)),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(1).
+						Obj()).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						XGBoostPolicy().
+						Obj(),
+					TrainerPort: ptr.To[int32](30500),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:              constants.Node,
+						Ancestor:          ptr.To(constants.AncestorTrainer),
+						Count:             ptr.To[int32](1),
+						SinglePodRequests: make(corev1.ResourceList),
+						Containers: []runtime.Container{{
+							Name: constants.Node,
+							Ports: []corev1ac.ContainerPortApplyConfiguration{{
+								ContainerPort: ptr.To[int32](30500),
+							}},
+							Env: []corev1ac.EnvVarApplyConfiguration{
+								{
+									Name:  ptr.To(constants.XGBoostEnvTrackerURI),
+									Value: ptr.To(fmt.Sprintf("test-job-%s-0-0.test-job", constants.Node)),
+								},
+								{
+									Name:  ptr.To(constants.XGBoostEnvTrackerPort),
+									Value: ptr.To("30500"),
+								},
+								{
+									Name: ptr.To(constants.XGBoostEnvTaskID),
+									ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+										FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+											FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+										},
+									},
+								},
+								{
+									Name:  ptr.To(constants.XGBoostEnvNumWorker),
+									Value: ptr.To("1"),
+								},
+							},
+						}},
+					}},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
 		"multi-node XGBoost training (CPU)": {
 			info: runtime.NewInfo(
 				runtime.WithMLPolicySource(
@@ -414,6 +638,187 @@ func TestXGBoostEnforceMLPolicy(t *testing.T) {
 				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
 			},
 		},
+		"parameter-server topology adds the server PodSet": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(
+					utiltesting.MakeMLPolicyWrapper().
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							XGBoostPolicyWithNumServers(2).
+							Obj(),
+						).
+						Obj(),
+				),
+				runtime.WithTemplateSpecObjApply(jobsetv1alpha2ac.JobSetSpec().
+					WithReplicatedJobs(
+						jobsetv1alpha2ac.ReplicatedJob().
+							WithName(constants.Node).
+							WithTemplate(batchv1ac.JobTemplateSpec().
+								WithLabels(map[string]string{
+									constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+								}).
+								WithSpec(batchv1ac.JobSpec().
+									WithTemplate(corev1ac.PodTemplateSpec().
+										WithSpec(corev1ac.PodSpec().
+											WithContainers(corev1ac.Container().
+												WithName(constants.Node).
+												WithImage("xgboost/xgboost:latest"),
+											),
+										),
+									),
+								),
+							),
+					),
+				),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+					WithContainers(corev1ac.Container().
+						WithName(constants.Node).
+						WithImage("xgboost/xgboost:latest"),
+					),
+				),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "ps-job").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(3).
+						Obj()).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						XGBoostPolicyWithNumServers(2).
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:              constants.Node,
+							Ancestor:          ptr.To(constants.AncestorTrainer),
+							Count:             ptr.To[int32](3),
+							SinglePodRequests: make(corev1.ResourceList),
+							Containers: []runtime.Container{{
+								Name:  constants.Node,
+								Image: "xgboost/xgboost:latest",
+								Ports: []corev1ac.ContainerPortApplyConfiguration{{
+									ContainerPort: ptr.To(constants.ContainerTrainerPort),
+								}},
+								Env: []corev1ac.EnvVarApplyConfiguration{
+									{
+										Name:  ptr.To(constants.XGBoostEnvTrackerURI),
+										Value: ptr.To(fmt.Sprintf("ps-job-%s-0-0.ps-job", constants.Node)),
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvTrackerPort),
+										Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+									},
+									{
+										Name: ptr.To(constants.XGBoostEnvTaskID),
+										ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+											FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+												FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+											},
+										},
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvNumWorker),
+										Value: ptr.To("3"),
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvRole),
+										Value: ptr.To(constants.XGBoostRoleWorker),
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvNumServer),
+										Value: ptr.To("2"),
+									},
+								},
+							}},
+						},
+						{
+							Name:              constants.XGBoostServer,
+							Count:             ptr.To[int32](2),
+							SinglePodRequests: make(corev1.ResourceList),
+							Containers: []runtime.Container{{
+								Name:  constants.Node,
+								Image: "xgboost/xgboost:latest",
+								Env: []corev1ac.EnvVarApplyConfiguration{
+									{
+										Name:  ptr.To(constants.XGBoostEnvTrackerURI),
+										Value: ptr.To(fmt.Sprintf("ps-job-%s-0-0.ps-job", constants.Node)),
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvTrackerPort),
+										Value: ptr.To(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+									},
+									{
+										Name: ptr.To(constants.JobCompletionIndexEnv),
+										ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+											FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+												FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+											},
+										},
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvTaskID),
+										Value: ptr.To("server-$(JOB_COMPLETION_INDEX)"),
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvNumWorker),
+										Value: ptr.To("3"),
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvNumServer),
+										Value: ptr.To("2"),
+									},
+									{
+										Name:  ptr.To(constants.XGBoostEnvRole),
+										Value: ptr.To(constants.XGBoostRoleServer),
+									},
+								},
+							}},
+						},
+					},
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.Node).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithLabels(map[string]string{
+										constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+									}).
+									WithSpec(batchv1ac.JobSpec().
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec().
+												WithContainers(corev1ac.Container().
+													WithName(constants.Node).
+													WithImage("xgboost/xgboost:latest"),
+												),
+											),
+										),
+									),
+								),
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.XGBoostServer).
+								WithReplicas(1).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithLabels(map[string]string{}).
+									WithSpec(batchv1ac.JobSpec().
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec().
+												WithContainers(corev1ac.Container().
+													WithName(constants.Node).
+													WithImage("xgboost/xgboost:latest"),
+												),
+											),
+										),
+									),
+								),
+						),
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
 		"XGBoost training with GPU resources": {
 			info: runtime.NewInfo(
 				runtime.WithMLPolicySource(
@@ -946,9 +1351,116 @@ func TestXGBoostEnforceMLPolicy(t *testing.T) {
 			if diff := cmp.Diff(tc.wantInfo, tc.info,
 				cmpopts.SortSlices(func(a, b string) bool { return a < b }),
 				cmpopts.SortMaps(func(a, b string) bool { return a < b }),
+				cmpopts.EquateEmpty(),
 			); len(diff) != 0 {
 				t.Errorf("Unexpected RuntimeInfo (-want,+got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestEnsureServerPodSet(t *testing.T) {
+	trainerJob := func() *jobsetv1alpha2ac.ReplicatedJobApplyConfiguration {
+		return jobsetv1alpha2ac.ReplicatedJob().
+			WithName(constants.Node).
+			WithTemplate(batchv1ac.JobTemplateSpec().
+				WithLabels(map[string]string{
+					constants.LabelTrainJobAncestor: constants.AncestorTrainer,
+				}).
+				WithSpec(batchv1ac.JobSpec().
+					WithTemplate(corev1ac.PodTemplateSpec().
+						WithSpec(corev1ac.PodSpec().
+							WithContainers(corev1ac.Container().
+								WithName(constants.Node).
+								WithImage("xgboost/xgboost:latest").
+								WithEnv(corev1ac.EnvVar().WithName("TEST_VAR").WithValue("test-value")),
+							),
+						),
+					),
+				),
+			)
+	}
+	trainerPodSpec := corev1ac.PodSpec().
+		WithContainers(corev1ac.Container().
+			WithName(constants.Node).
+			WithImage("xgboost/xgboost:latest"),
+		)
+	cases := map[string]struct {
+		info         *runtime.Info
+		wantPodSet   *runtime.PodSet
+		wantRJobs    []string
+		wantTrainJob *jobsetv1alpha2ac.ReplicatedJobApplyConfiguration
+	}{
+		"server PodSet is created from the trainer Job template": {
+			info: runtime.NewInfo(
+				runtime.WithTemplateSpecObjApply(jobsetv1alpha2ac.JobSetSpec().WithReplicatedJobs(trainerJob())),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, trainerPodSpec),
+			),
+			wantPodSet: &runtime.PodSet{
+				Name:              constants.XGBoostServer,
+				Count:             ptr.To[int32](1),
+				SinglePodRequests: make(corev1.ResourceList),
+				Containers: []runtime.Container{{
+					Name:  constants.Node,
+					Image: "xgboost/xgboost:latest",
+					Env: []corev1ac.EnvVarApplyConfiguration{
+						*corev1ac.EnvVar().WithName("TEST_VAR").WithValue("test-value"),
+					},
+				}},
+			},
+			wantRJobs:    []string{constants.Node, constants.XGBoostServer},
+			wantTrainJob: trainerJob(),
+		},
+		"server PodSet defined by the runtime is reused": {
+			info: runtime.NewInfo(
+				runtime.WithTemplateSpecObjApply(jobsetv1alpha2ac.JobSetSpec().WithReplicatedJobs(
+					trainerJob(),
+					jobsetv1alpha2ac.ReplicatedJob().WithName(constants.XGBoostServer),
+				)),
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, corev1.PodSpec{}, trainerPodSpec),
+				runtime.WithPodSet(constants.XGBoostServer, nil, 2, corev1.PodSpec{}, corev1ac.PodSpec()),
+			),
+			wantPodSet: &runtime.PodSet{
+				Name:              constants.XGBoostServer,
+				Count:             ptr.To[int32](2),
+				SinglePodRequests: make(corev1.ResourceList),
+			},
+			wantRJobs:    []string{constants.Node, constants.XGBoostServer},
+			wantTrainJob: trainerJob(),
+		},
+		"no server PodSet without the trainer PodSet": {
+			info: runtime.NewInfo(
+				runtime.WithTemplateSpecObjApply(jobsetv1alpha2ac.JobSetSpec()),
+			),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ensureServerPodSet(tc.info)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPodSet, got, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected server PodSet (-want,+got):\n%s", diff)
+			}
+			jobSetSpec, _ := runtime.TemplateSpecApply[jobsetv1alpha2ac.JobSetSpecApplyConfiguration](tc.info)
+			var gotRJobs []string
+			for _, rJob := range jobSetSpec.ReplicatedJobs {
+				gotRJobs = append(gotRJobs, ptr.Deref(rJob.Name, ""))
+			}
+			if diff := cmp.Diff(tc.wantRJobs, gotRJobs); len(diff) != 0 {
+				t.Errorf("Unexpected replicated Jobs (-want,+got):\n%s", diff)
+			}
+			if got == nil {
+				return
+			}
+			// The changes to the server Job must not leak into the trainer Job template.
+			for i := range got.Containers {
+				apply.UpsertEnvVars(&got.Containers[i].Env, *corev1ac.EnvVar().WithName("TEST_VAR").WithValue("updated"))
+			}
+			if diff := cmp.Diff(tc.wantTrainJob, &jobSetSpec.ReplicatedJobs[0]); len(diff) != 0 {
+				t.Errorf("Unexpected trainer Job template (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
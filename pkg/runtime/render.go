@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+)
+
+// Render runs the full plugin pipeline of the runtime for the TrainJob without persisting anything,
+// and returns the JobSet and the other objects, e.g. ConfigMaps and Secrets, the TrainJob would create.
+// The TrainJob is not mutated by the plugins.
+func Render(ctx context.Context, rt Runtime, trainJob *trainer.TrainJob) (*jobsetv1alpha2.JobSet, []client.Object, error) {
+	objs, err := rt.DryRunObjects(ctx, trainJob.DeepCopy())
+	if err != nil {
+		return nil, nil, err
+	}
+	var jobSet *jobsetv1alpha2.JobSet
+	var others []client.Object
+	for _, obj := range objs {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting the rendered object: %w", err)
+		}
+		u := &unstructured.Unstructured{Object: content}
+		if u.GroupVersionKind() != jobsetv1alpha2.GroupVersion.WithKind(constants.JobSetKind) {
+			others = append(others, u)
+			continue
+		}
+		jobSet = &jobsetv1alpha2.JobSet{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(content, jobSet); err != nil {
+			return nil, nil, fmt.Errorf("converting the rendered JobSet: %w", err)
+		}
+	}
+	return jobSet, others, nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package runtime
 
 import (
+	"errors"
 	"iter"
 	"maps"
 	"slices"
@@ -33,6 +34,10 @@ import (
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 )
 
+// ErrorTrainerContainerNotFound is returned by the plugins when the trainer PodSet
+// does not have the trainer container they mutate.
+var ErrorTrainerContainerNotFound = errors.New("trainer container is not found in the runtime template")
+
 type Info struct {
 	// Labels and Annotations to add to the RuntimeJobTemplate.
 	Labels      map[string]string
@@ -49,6 +54,15 @@ type Info struct {
 type RuntimePolicy struct {
 	MLPolicySource *trainer.MLPolicySource
 	PodGroupPolicy *trainer.PodGroupPolicy
+	TrainerPort    *int32
+	// TrainerPortName is the name of the trainer port in the trainer node container.
+	TrainerPortName *string
+	// AdditionalPorts are the named ports injected into the trainer node container.
+	AdditionalPorts []trainer.NamedPort
+	// SingleProcessPerNode restricts the TrainJob numProcPerNode to 1.
+	SingleProcessPerNode bool
+	// NumNodes is the number of nodes declared in the runtime MLPolicy.
+	NumNodes *int32
 	//FluxPolicySource *trainer.FluxMLPolicySource
 }
 
@@ -119,6 +133,11 @@ func WithMLPolicySource(mlPolicy *trainer.MLPolicy) InfoOption {
 	return func(o *InfoOptions) {
 		if mlPolicy != nil {
 			o.runtimePolicy.MLPolicySource = &mlPolicy.MLPolicySource
+			o.runtimePolicy.TrainerPort = mlPolicy.TrainerPort
+			o.runtimePolicy.TrainerPortName = mlPolicy.TrainerPortName
+			o.runtimePolicy.AdditionalPorts = mlPolicy.AdditionalPorts
+			o.runtimePolicy.SingleProcessPerNode = ptr.Deref(mlPolicy.SingleProcessPerNode, false)
+			o.runtimePolicy.NumNodes = mlPolicy.NumNodes
 		}
 	}
 }
@@ -213,6 +232,22 @@ func (i *Info) FindContainerByPodSetAncestorContainerName(psAncestor, containerN
 	return nil
 }
 
+// TrainerPort returns the port for the trainer nodes communication configured in the runtime,
+// or the default trainer port if it isn't configured.
+func (i *Info) TrainerPort() int32 {
+	return ptr.Deref(i.RuntimePolicy.TrainerPort, constants.ContainerTrainerPort)
+}
+
+// TrainerContainerPort returns the trainer port for the trainer node container,
+// which is named if the name is configured in the runtime.
+func (i *Info) TrainerContainerPort() corev1ac.ContainerPortApplyConfiguration {
+	port := corev1ac.ContainerPort().WithContainerPort(i.TrainerPort())
+	if i.RuntimePolicy.TrainerPortName != nil {
+		port.WithName(*i.RuntimePolicy.TrainerPortName)
+	}
+	return *port
+}
+
 func (i *Info) FindPodSetByAncestor(ancestor string) *PodSet {
 	if idx := slices.IndexFunc(i.TemplateSpec.PodSets, func(ps PodSet) bool { return ptr.Equal(ps.Ancestor, &ancestor) }); idx != -1 {
 		return &i.TemplateSpec.PodSets[idx]
@@ -21,9 +21,11 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
@@ -174,6 +176,15 @@ func (j *JobSetWrapper) Replicas(replicas int32, rJobNames ...string) *JobSetWra
 	return j
 }
 
+func (j *JobSetWrapper) PodFailurePolicy(rJobName string, podFailurePolicy *batchv1.PodFailurePolicy) *JobSetWrapper {
+	for i, rJob := range j.Spec.ReplicatedJobs {
+		if rJob.Name == rJobName {
+			j.Spec.ReplicatedJobs[i].Template.Spec.PodFailurePolicy = podFailurePolicy
+		}
+	}
+	return j
+}
+
 func (j *JobSetWrapper) NumNodes(numNodes int32) *JobSetWrapper {
 	for i, rJob := range j.Spec.ReplicatedJobs {
 		if rJob.Name == constants.Node {
@@ -424,6 +435,16 @@ func (j *JobSetWrapper) Env(rJobName, containerName string, envs ...corev1.EnvVa
 	return j
 }
 
+// TrainJobIdentityEnv appends the identity env of the TrainJob, which has the same name and namespace as the JobSet,
+// to the trainer container of the replicated Job.
+func (j *JobSetWrapper) TrainJobIdentityEnv(rJobName string, uid types.UID) *JobSetWrapper {
+	return j.Env(rJobName, constants.Node,
+		corev1.EnvVar{Name: jobsetplgconsts.EnvTrainJobName, Value: j.Name},
+		corev1.EnvVar{Name: jobsetplgconsts.EnvTrainJobNamespace, Value: j.Namespace},
+		corev1.EnvVar{Name: jobsetplgconsts.EnvTrainJobUID, Value: string(uid)},
+	)
+}
+
 func (j *JobSetWrapper) ContainerSecurityContext(rJobName, containerName string, securityContext corev1.SecurityContext) *JobSetWrapper {
 	for i, rJob := range j.Spec.ReplicatedJobs {
 		if rJob.Name == rJobName {
@@ -494,6 +515,20 @@ func (j *JobSetWrapper) ReplicatedJobLabel(key, value string, rJobNames ...strin
 	return j
 }
 
+func (j *JobSetWrapper) ReplicatedJobAnnotation(key, value string, rJobNames ...string) *JobSetWrapper {
+	for i, rJob := range j.Spec.ReplicatedJobs {
+		if !slices.Contains(rJobNames, rJob.Name) {
+			continue
+		}
+
+		if rJob.Template.Annotations == nil {
+			j.Spec.ReplicatedJobs[i].Template.Annotations = make(map[string]string, 1)
+		}
+		j.Spec.ReplicatedJobs[i].Template.Annotations[key] = value
+	}
+	return j
+}
+
 func (j *JobSetWrapper) PodLabel(key, value string) *JobSetWrapper {
 	for i, rJob := range j.Spec.ReplicatedJobs {
 		if rJob.Template.Spec.Template.Labels == nil {
@@ -581,6 +616,19 @@ func (j *JobSetWrapper) DependsOn(rJobName string, dependsOn ...jobsetv1alpha2.D
 	return j
 }
 
+// InitializerDependsOn makes the rJob depend on the completion of the dataset and model initializers.
+func (j *JobSetWrapper) InitializerDependsOn(rJobName string) *JobSetWrapper {
+	return j.DependsOn(rJobName,
+		jobsetv1alpha2.DependsOn{Name: constants.DatasetInitializer, Status: jobsetv1alpha2.DependencyComplete},
+		jobsetv1alpha2.DependsOn{Name: constants.ModelInitializer, Status: jobsetv1alpha2.DependencyComplete},
+	)
+}
+
+func (j *JobSetWrapper) FailurePolicy(failurePolicy *jobsetv1alpha2.FailurePolicy) *JobSetWrapper {
+	j.Spec.FailurePolicy = failurePolicy
+	return j
+}
+
 func (j *JobSetWrapper) ReplicatedJobsStatuses(statuses []jobsetv1alpha2.ReplicatedJobStatus) *JobSetWrapper {
 	j.Status.ReplicatedJobsStatus = statuses
 	return j
@@ -620,11 +668,24 @@ func (t *TrainJobWrapper) UID(uid string) *TrainJobWrapper {
 	return t
 }
 
+func (t *TrainJobWrapper) Annotation(key, value string) *TrainJobWrapper {
+	if t.Annotations == nil {
+		t.Annotations = make(map[string]string, 1)
+	}
+	t.Annotations[key] = value
+	return t
+}
+
 func (t *TrainJobWrapper) ActiveDeadlineSeconds(deadline int64) *TrainJobWrapper {
 	t.Spec.ActiveDeadlineSeconds = deadline
 	return t
 }
 
+func (t *TrainJobWrapper) CleanPodPolicy(policy trainer.CleanPodPolicy) *TrainJobWrapper {
+	t.Spec.RunPolicy = &trainer.RunPolicy{CleanPodPolicy: &policy}
+	return t
+}
+
 func (t *TrainJobWrapper) RuntimeRef(gvk schema.GroupVersionKind, name string) *TrainJobWrapper {
 	runtimeRef := trainer.RuntimeRef{
 		Name: name,
@@ -649,11 +710,21 @@ func (t *TrainJobWrapper) Trainer(trainer *trainer.Trainer) *TrainJobWrapper {
 	return t
 }
 
+func (t *TrainJobWrapper) Checkpoint(checkpoint *trainer.CheckpointConfig) *TrainJobWrapper {
+	t.Spec.Checkpoint = checkpoint
+	return t
+}
+
 func (t *TrainJobWrapper) RuntimePatches(patches []trainer.RuntimePatch) *TrainJobWrapper {
 	t.Spec.RuntimePatches = patches
 	return t
 }
 
+func (t *TrainJobWrapper) StartTime(startTime metav1.Time) *TrainJobWrapper {
+	t.Status.StartTime = &startTime
+	return t
+}
+
 func (t *TrainJobWrapper) ManagedBy(m string) *TrainJobWrapper {
 	t.Spec.ManagedBy = &m
 	return t
@@ -693,11 +764,24 @@ func (t *TrainJobTrainerWrapper) Container(image string, command []string, args
 	return t
 }
 
+func (t *TrainJobTrainerWrapper) ResourcesPerContainer(name string, resources corev1.ResourceRequirements) *TrainJobTrainerWrapper {
+	if t.Trainer.ResourcesPerContainer == nil {
+		t.Trainer.ResourcesPerContainer = make(map[string]corev1.ResourceRequirements)
+	}
+	t.Trainer.ResourcesPerContainer[name] = resources
+	return t
+}
+
 func (t *TrainJobTrainerWrapper) Env(env ...corev1.EnvVar) *TrainJobTrainerWrapper {
 	t.Trainer.Env = env
 	return t
 }
 
+func (t *TrainJobTrainerWrapper) PipPackages(packages ...string) *TrainJobTrainerWrapper {
+	t.Trainer.PipPackages = packages
+	return t
+}
+
 func (t *TrainJobTrainerWrapper) Obj() *trainer.Trainer {
 	return &t.Trainer
 }
@@ -722,6 +806,11 @@ func (t *TrainJobInitializerWrapper) ModelInitializer(modelInitializer *trainer.
 	return t
 }
 
+func (t *TrainJobInitializerWrapper) TrainerDependency(dependency trainer.InitializerDependency) *TrainJobInitializerWrapper {
+	t.Initializer.TrainerDependency = &dependency
+	return t
+}
+
 func (t *TrainJobInitializerWrapper) Obj() *trainer.Initializer {
 	return &t.Initializer
 }
@@ -741,16 +830,31 @@ func (t *TrainJobDatasetInitializerWrapper) StorageUri(storageUri string) *Train
 	return t
 }
 
+func (t *TrainJobDatasetInitializerWrapper) Datasets(datasets ...trainer.DatasetSource) *TrainJobDatasetInitializerWrapper {
+	t.DatasetInitializer.Datasets = datasets
+	return t
+}
+
 func (t *TrainJobDatasetInitializerWrapper) Env(env ...corev1.EnvVar) *TrainJobDatasetInitializerWrapper {
 	t.DatasetInitializer.Env = env
 	return t
 }
 
+func (t *TrainJobDatasetInitializerWrapper) EnvFrom(envFrom ...corev1.EnvFromSource) *TrainJobDatasetInitializerWrapper {
+	t.DatasetInitializer.EnvFrom = envFrom
+	return t
+}
+
 func (t *TrainJobDatasetInitializerWrapper) SecretRef(secretRef corev1.LocalObjectReference) *TrainJobDatasetInitializerWrapper {
 	t.DatasetInitializer.SecretRef = &secretRef
 	return t
 }
 
+func (t *TrainJobDatasetInitializerWrapper) Parallelism(parallelism int32) *TrainJobDatasetInitializerWrapper {
+	t.DatasetInitializer.Parallelism = &parallelism
+	return t
+}
+
 func (t *TrainJobDatasetInitializerWrapper) Obj() *trainer.DatasetInitializer {
 	return &t.DatasetInitializer
 }
@@ -775,6 +879,11 @@ func (t *TrainJobModelInitializerWrapper) Env(env ...corev1.EnvVar) *TrainJobMod
 	return t
 }
 
+func (t *TrainJobModelInitializerWrapper) EnvFrom(envFrom ...corev1.EnvFromSource) *TrainJobModelInitializerWrapper {
+	t.ModelInitializer.EnvFrom = envFrom
+	return t
+}
+
 func (t *TrainJobModelInitializerWrapper) SecretRef(secretRef corev1.LocalObjectReference) *TrainJobModelInitializerWrapper {
 	t.ModelInitializer.SecretRef = &secretRef
 	return t
@@ -1151,6 +1260,11 @@ func (s *TrainingRuntimeSpecWrapper) DependsOn(
 	return s
 }
 
+func (s *TrainingRuntimeSpecWrapper) FailurePolicy(failurePolicy *jobsetv1alpha2.FailurePolicy) *TrainingRuntimeSpecWrapper {
+	s.Template.Spec.FailurePolicy = failurePolicy
+	return s
+}
+
 func (s *TrainingRuntimeSpecWrapper) Replicas(replicas int32, rJobNames ...string) *TrainingRuntimeSpecWrapper {
 	for i, rJob := range s.Template.Spec.ReplicatedJobs {
 		if slices.Contains(rJobNames, rJob.Name) {
@@ -1160,6 +1274,15 @@ func (s *TrainingRuntimeSpecWrapper) Replicas(replicas int32, rJobNames ...strin
 	return s
 }
 
+func (s *TrainingRuntimeSpecWrapper) PodFailurePolicy(rJobName string, podFailurePolicy *batchv1.PodFailurePolicy) *TrainingRuntimeSpecWrapper {
+	for i, rJob := range s.Template.Spec.ReplicatedJobs {
+		if rJob.Name == rJobName {
+			s.Template.Spec.ReplicatedJobs[i].Template.Spec.PodFailurePolicy = podFailurePolicy
+		}
+	}
+	return s
+}
+
 func (s *TrainingRuntimeSpecWrapper) InitContainer(rJobName, containerName, image string, envs ...corev1.EnvVar) *TrainingRuntimeSpecWrapper {
 	for i, rJob := range s.Template.Spec.ReplicatedJobs {
 		if rJob.Name == rJobName {
@@ -1228,6 +1351,15 @@ func (s *TrainingRuntimeSpecWrapper) PodGroupPolicyCoscheduling(src *trainer.Cos
 	return s
 }
 
+func (s *TrainingRuntimeSpecWrapper) PodGroupPolicyVolcano(src *trainer.VolcanoPodGroupPolicySource) *TrainingRuntimeSpecWrapper {
+	s.PodGroupPolicy = &trainer.PodGroupPolicy{
+		PodGroupPolicySource: trainer.PodGroupPolicySource{
+			Volcano: src,
+		},
+	}
+	return s
+}
+
 func (s *TrainingRuntimeSpecWrapper) PodGroupPolicyCoschedulingSchedulingTimeout(timeout int32) *TrainingRuntimeSpecWrapper {
 	if s.PodGroupPolicy == nil || s.PodGroupPolicy.Coscheduling == nil {
 		return s.PodGroupPolicyCoscheduling(&trainer.CoschedulingPodGroupPolicySource{
@@ -1238,6 +1370,16 @@ func (s *TrainingRuntimeSpecWrapper) PodGroupPolicyCoschedulingSchedulingTimeout
 	return s
 }
 
+func (s *TrainingRuntimeSpecWrapper) PodGroupPolicyCoschedulingQueue(queue string) *TrainingRuntimeSpecWrapper {
+	if s.PodGroupPolicy == nil || s.PodGroupPolicy.Coscheduling == nil {
+		return s.PodGroupPolicyCoscheduling(&trainer.CoschedulingPodGroupPolicySource{
+			Queue: queue,
+		})
+	}
+	s.PodGroupPolicy.Coscheduling.Queue = queue
+	return s
+}
+
 func (s *TrainingRuntimeSpecWrapper) Obj() trainer.TrainingRuntimeSpec {
 	return s.TrainingRuntimeSpec
 }
@@ -1257,6 +1399,26 @@ func (m *MLPolicyWrapper) WithNumNodes(numNodes int32) *MLPolicyWrapper {
 	return m
 }
 
+func (m *MLPolicyWrapper) WithTrainerPort(trainerPort int32) *MLPolicyWrapper {
+	m.TrainerPort = &trainerPort
+	return m
+}
+
+func (m *MLPolicyWrapper) WithTrainerPortName(trainerPortName string) *MLPolicyWrapper {
+	m.TrainerPortName = &trainerPortName
+	return m
+}
+
+func (m *MLPolicyWrapper) WithAdditionalPorts(ports ...trainer.NamedPort) *MLPolicyWrapper {
+	m.AdditionalPorts = append(m.AdditionalPorts, ports...)
+	return m
+}
+
+func (m *MLPolicyWrapper) WithSingleProcessPerNode(singleProcessPerNode bool) *MLPolicyWrapper {
+	m.SingleProcessPerNode = &singleProcessPerNode
+	return m
+}
+
 func (m *MLPolicyWrapper) WithMLPolicySource(source trainer.MLPolicySource) *MLPolicyWrapper {
 	m.MLPolicySource = source
 	return m
@@ -1284,6 +1446,34 @@ func (m *MLPolicySourceWrapper) TorchPolicyWithEnvInjection(envInjection *traine
 	return m
 }
 
+func (m *MLPolicySourceWrapper) TorchPolicyWithMemoryPerProcess(memoryPerProcess resource.Quantity) *MLPolicySourceWrapper {
+	m.Torch = &trainer.TorchMLPolicySource{
+		NumProcPerNode:   ptr.To(intstr.FromString("memory")),
+		MemoryPerProcess: &memoryPerProcess,
+	}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) TorchPolicyWithNumProcPerNode(numProcPerNode intstr.IntOrString) *MLPolicySourceWrapper {
+	m.Torch = &trainer.TorchMLPolicySource{NumProcPerNode: &numProcPerNode}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) TorchPolicyWithNumProcPerNodeLabel(numProcPerNodeLabel string) *MLPolicySourceWrapper {
+	m.Torch = &trainer.TorchMLPolicySource{NumProcPerNodeLabel: &numProcPerNodeLabel}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) TorchPolicyWithElastic(minNodes, maxNodes int32) *MLPolicySourceWrapper {
+	m.Torch = &trainer.TorchMLPolicySource{MinNodes: &minNodes, MaxNodes: &maxNodes}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) TorchPolicyWithRdzv(rdzvBackend trainer.RdzvBackend, rdzvEndpoint *string) *MLPolicySourceWrapper {
+	m.Torch = &trainer.TorchMLPolicySource{RdzvBackend: &rdzvBackend, RdzvEndpoint: rdzvEndpoint}
+	return m
+}
+
 func (w *MLPolicySourceWrapper) JAXPolicy() *MLPolicySourceWrapper {
 	w.JAX = &trainer.JAXMLPolicySource{}
 	return w
@@ -1294,6 +1484,11 @@ func (w *MLPolicySourceWrapper) XGBoostPolicy() *MLPolicySourceWrapper {
 	return w
 }
 
+func (w *MLPolicySourceWrapper) XGBoostPolicyWithNumServers(numServers int32) *MLPolicySourceWrapper {
+	w.XGBoost = &trainer.XGBoostMLPolicySource{NumServers: &numServers}
+	return w
+}
+
 func (m *MLPolicySourceWrapper) MPIPolicy(numProcPerNode *int32, MPImplementation trainer.MPIImplementation, sshAuthMountPath *string, runLauncherAsNode *bool) *MLPolicySourceWrapper {
 	if m.MPI == nil {
 		m.MPI = &trainer.MPIMLPolicySource{}
@@ -1305,6 +1500,48 @@ func (m *MLPolicySourceWrapper) MPIPolicy(numProcPerNode *int32, MPImplementatio
 	return m
 }
 
+func (m *MLPolicySourceWrapper) MPIPolicyWithLauncherBinary(launcherBinary string) *MLPolicySourceWrapper {
+	if m.MPI == nil {
+		m.MPI = &trainer.MPIMLPolicySource{}
+	}
+	m.MPI.LauncherBinary = &launcherBinary
+	return m
+}
+
+func (m *MLPolicySourceWrapper) MPIPolicyWithMountHostfileOnNodes(mountHostfileOnNodes bool) *MLPolicySourceWrapper {
+	if m.MPI == nil {
+		m.MPI = &trainer.MPIMLPolicySource{}
+	}
+	m.MPI.MountHostfileOnNodes = &mountHostfileOnNodes
+	return m
+}
+
+func (m *MLPolicySourceWrapper) DeepSpeedPolicy(numProcPerNode *int32, sshAuthMountPath *string) *MLPolicySourceWrapper {
+	if m.DeepSpeed == nil {
+		m.DeepSpeed = &trainer.DeepSpeedMLPolicySource{}
+	}
+	m.DeepSpeed.NumProcPerNode = numProcPerNode
+	m.DeepSpeed.SSHAuthMountPath = sshAuthMountPath
+	return m
+}
+
+func (m *MLPolicySourceWrapper) TensorFlowPolicy() *MLPolicySourceWrapper {
+	m.TensorFlow = &trainer.TensorFlowMLPolicySource{}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) RayPolicy() *MLPolicySourceWrapper {
+	m.Ray = &trainer.RayMLPolicySource{}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) HorovodPolicy(glooIface *string) *MLPolicySourceWrapper {
+	m.Horovod = &trainer.HorovodMLPolicySource{
+		GlooIface: glooIface,
+	}
+	return m
+}
+
 func (m *MLPolicySourceWrapper) FluxPolicy(numProcPerNode *int32) *MLPolicySourceWrapper {
 	if m.Flux == nil {
 		m.Flux = &trainer.FluxMLPolicySource{}
@@ -1336,6 +1573,22 @@ func MakeSchedulerPluginsPodGroup(namespace, name string) *SchedulerPluginsPodGr
 	}
 }
 
+func (p *SchedulerPluginsPodGroupWrapper) Queue(queue string) *SchedulerPluginsPodGroupWrapper {
+	if p.Labels == nil {
+		p.Labels = make(map[string]string, 1)
+	}
+	p.Labels[constants.LabelPodGroupQueue] = queue
+	return p
+}
+
+func (p *SchedulerPluginsPodGroupWrapper) Annotation(key, value string) *SchedulerPluginsPodGroupWrapper {
+	if p.Annotations == nil {
+		p.Annotations = make(map[string]string, 1)
+	}
+	p.Annotations[key] = value
+	return p
+}
+
 func (p *SchedulerPluginsPodGroupWrapper) MinMember(members int32) *SchedulerPluginsPodGroupWrapper {
 	p.Spec.MinMember = members
 	return p
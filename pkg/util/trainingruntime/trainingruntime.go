@@ -18,13 +18,24 @@ package trainingruntime
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 )
 
+// acceleratorResources maps the accelerator vendors declared by the runtime to their GPU resource names.
+var acceleratorResources = map[string]corev1.ResourceName{
+	"nvidia": "nvidia.com/gpu",
+	"amd":    "amd.com/gpu",
+	"intel":  "gpu.intel.com/i915",
+}
+
 // IsSupportDeprecated returns true if TrainingRuntime labels indicate support=deprecated.
 func IsSupportDeprecated(labels map[string]string) bool {
 	if labels == nil {
@@ -34,6 +45,41 @@ func IsSupportDeprecated(labels map[string]string) bool {
 	return ok && val == constants.SupportDeprecated
 }
 
+// ValidateAccelerator validates that the TrainJob doesn't request the GPUs of a vendor
+// other than the accelerator declared by the runtime labels.
+func ValidateAccelerator(labels map[string]string, trainJob *trainer.TrainJob) field.ErrorList {
+	accelerator, ok := labels[constants.LabelAccelerator]
+	if !ok {
+		return nil
+	}
+	if _, ok := acceleratorResources[accelerator]; !ok {
+		return nil
+	}
+	if trainJob.Spec.Trainer == nil || trainJob.Spec.Trainer.ResourcesPerNode == nil {
+		return nil
+	}
+	resourcesPerNode := trainJob.Spec.Trainer.ResourcesPerNode
+	var allErrs field.ErrorList
+	vendors := make([]string, 0, len(acceleratorResources))
+	for vendor := range acceleratorResources {
+		vendors = append(vendors, vendor)
+	}
+	slices.Sort(vendors)
+	for _, vendor := range vendors {
+		resourceName := acceleratorResources[vendor]
+		if vendor == accelerator {
+			continue
+		}
+		_, inRequests := resourcesPerNode.Requests[resourceName]
+		_, inLimits := resourcesPerNode.Limits[resourceName]
+		if inRequests || inLimits {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "trainer", "resourcesPerNode"), resourceName,
+				fmt.Sprintf("must not request %s GPUs, the runtime is built for the %s accelerator", vendor, accelerator)))
+		}
+	}
+	return allErrs
+}
+
 // resourceRequirementsPatchMeta defines the strategic merge patch strategy for ResourceRequirements.
 // Claims are merged by name, matching the Kubernetes strategic merge patch semantic.
 type resourceRequirementsPatchMeta struct {
@@ -22,8 +22,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
 
 func TestIsSupportDeprecated(t *testing.T) {
@@ -88,6 +92,84 @@ func TestIsSupportDeprecated(t *testing.T) {
 	}
 }
 
+func TestValidateAccelerator(t *testing.T) {
+	resourcesPerNodePath := field.NewPath("spec", "trainer", "resourcesPerNode")
+	trainJobWithResources := func(resources corev1.ResourceList) *trainer.TrainJob {
+		return utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+			Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+				Container("test:trainjob", nil, nil, resources).
+				Obj()).
+			Obj()
+	}
+	cases := map[string]struct {
+		labels   map[string]string
+		trainJob *trainer.TrainJob
+		wantErr  field.ErrorList
+	}{
+		"runtime without the accelerator label": {
+			trainJob: trainJobWithResources(corev1.ResourceList{
+				"amd.com/gpu": resource.MustParse("1"),
+			}),
+		},
+		"runtime with the unknown accelerator": {
+			labels: map[string]string{constants.LabelAccelerator: "tpu"},
+			trainJob: trainJobWithResources(corev1.ResourceList{
+				"amd.com/gpu": resource.MustParse("1"),
+			}),
+		},
+		"TrainJob without the trainer": {
+			labels:   map[string]string{constants.LabelAccelerator: "nvidia"},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").Obj(),
+		},
+		"TrainJob requesting the GPUs of the runtime accelerator": {
+			labels: map[string]string{constants.LabelAccelerator: "nvidia"},
+			trainJob: trainJobWithResources(corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("1"),
+			}),
+		},
+		"CPU-only TrainJob": {
+			labels: map[string]string{constants.LabelAccelerator: "nvidia"},
+			trainJob: trainJobWithResources(corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			}),
+		},
+		"TrainJob requesting AMD GPUs with the NVIDIA runtime": {
+			labels: map[string]string{constants.LabelAccelerator: "nvidia"},
+			trainJob: trainJobWithResources(corev1.ResourceList{
+				"amd.com/gpu": resource.MustParse("1"),
+			}),
+			wantErr: field.ErrorList{
+				field.Invalid(resourcesPerNodePath, corev1.ResourceName("amd.com/gpu"),
+					"must not request amd GPUs, the runtime is built for the nvidia accelerator"),
+			},
+		},
+		"TrainJob limiting NVIDIA GPUs with the AMD runtime": {
+			labels: map[string]string{constants.LabelAccelerator: "amd"},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(&trainer.Trainer{
+					ResourcesPerNode: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							"nvidia.com/gpu": resource.MustParse("2"),
+						},
+					},
+				}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourcesPerNodePath, corev1.ResourceName("nvidia.com/gpu"),
+					"must not request nvidia GPUs, the runtime is built for the amd accelerator"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := ValidateAccelerator(tc.labels, tc.trainJob)
+			if diff := cmp.Diff(tc.wantErr, gotErr); len(diff) != 0 {
+				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestMergeResourceRequirements(t *testing.T) {
 	cases := map[string]struct {
 		base     corev1.ResourceRequirements
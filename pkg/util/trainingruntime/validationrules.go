@@ -0,0 +1,127 @@
+/*
+Copyright The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trainingruntime
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+)
+
+const (
+	// validationRuleVariable is the CEL variable name holding the TrainJob.
+	validationRuleVariable = "trainJob"
+
+	// validationRuleCostLimit limits the CEL evaluation cost of a single validation rule.
+	validationRuleCostLimit uint64 = 1000000
+)
+
+// ValidationRule is the CEL rule declared by the runtime and evaluated against the TrainJob at admission.
+type ValidationRule struct {
+	// Expression is the CEL expression which must evaluate to true for the TrainJob to be admitted.
+	// The TrainJob is accessible with the `trainJob` variable.
+	Expression string `json:"expression"`
+	// Message is the message returned when the expression evaluates to false.
+	Message string `json:"message,omitempty"`
+}
+
+// compiledValidationRule is the validation rule with the compiled CEL program.
+type compiledValidationRule struct {
+	ValidationRule
+	program cel.Program
+}
+
+// ValidateValidationRules validates that the runtime annotations declare valid CEL validation rules.
+func ValidateValidationRules(annotations map[string]string, annotationsPath *field.Path) field.ErrorList {
+	if _, err := compileValidationRules(annotations); err != nil {
+		return field.ErrorList{
+			field.Invalid(annotationsPath.Key(constants.AnnotationValidationRules), annotations[constants.AnnotationValidationRules], err.Error()),
+		}
+	}
+	return nil
+}
+
+// ValidateTrainJob evaluates the runtime CEL validation rules against the TrainJob.
+func ValidateTrainJob(annotations map[string]string, trainJob *trainer.TrainJob) field.ErrorList {
+	rules, err := compileValidationRules(annotations)
+	if err != nil {
+		return field.ErrorList{
+			field.InternalError(field.NewPath("spec", "runtimeRef"), fmt.Errorf("invalid runtime validation rules: %w", err)),
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	obj, err := apiruntime.DefaultUnstructuredConverter.ToUnstructured(trainJob)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec"), err)}
+	}
+	var allErrs field.ErrorList
+	for _, rule := range rules {
+		message := rule.Message
+		if len(message) == 0 {
+			message = fmt.Sprintf("failed the runtime validation rule: %s", rule.Expression)
+		}
+		out, _, err := rule.program.Eval(map[string]any{validationRuleVariable: obj})
+		if err != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), fmt.Sprintf("%s: %v", message, err)))
+			continue
+		}
+		if passed, ok := out.Value().(bool); !ok || !passed {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), message))
+		}
+	}
+	return allErrs
+}
+
+// compileValidationRules parses the validation rules from the runtime annotations and compiles them.
+func compileValidationRules(annotations map[string]string) ([]compiledValidationRule, error) {
+	value, ok := annotations[constants.AnnotationValidationRules]
+	if !ok {
+		return nil, nil
+	}
+	var rules []ValidationRule
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return nil, fmt.Errorf("must be a JSON list of validation rules: %w", err)
+	}
+	env, err := cel.NewEnv(cel.Variable(validationRuleVariable, cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+	compiled := make([]compiledValidationRule, 0, len(rules))
+	for i, rule := range rules {
+		ast, issues := env.Compile(rule.Expression)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("rule %d: expression must evaluate to bool, got %v", i, ast.OutputType())
+		}
+		program, err := env.Program(ast, cel.CostLimit(validationRuleCostLimit))
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		compiled = append(compiled, compiledValidationRule{ValidationRule: rule, program: program})
+	}
+	return compiled, nil
+}
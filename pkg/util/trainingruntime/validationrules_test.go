@@ -0,0 +1,140 @@
+/*
+Copyright The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trainingruntime
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+const requiresGPURules = `[{
+	"expression": "has(trainJob.spec.trainer) && has(trainJob.spec.trainer.resourcesPerNode) && has(trainJob.spec.trainer.resourcesPerNode.requests) && 'nvidia.com/gpu' in trainJob.spec.trainer.resourcesPerNode.requests",
+	"message": "the runtime requires GPUs"
+}]`
+
+func TestValidateValidationRules(t *testing.T) {
+	annotationsPath := field.NewPath("metadata", "annotations")
+	cases := map[string]struct {
+		annotations map[string]string
+		wantErr     field.ErrorList
+	}{
+		"no validation rules": {
+			annotations: map[string]string{"key": "value"},
+		},
+		"valid validation rules": {
+			annotations: map[string]string{constants.AnnotationValidationRules: requiresGPURules},
+		},
+		"validation rules are not a JSON list": {
+			annotations: map[string]string{constants.AnnotationValidationRules: "requires GPUs"},
+			wantErr: field.ErrorList{
+				field.Invalid(annotationsPath.Key(constants.AnnotationValidationRules), "", ""),
+			},
+		},
+		"validation rule fails to compile": {
+			annotations: map[string]string{constants.AnnotationValidationRules: `[{"expression": "trainJob.spec.trainer ="}]`},
+			wantErr: field.ErrorList{
+				field.Invalid(annotationsPath.Key(constants.AnnotationValidationRules), "", ""),
+			},
+		},
+		"validation rule doesn't evaluate to bool": {
+			annotations: map[string]string{constants.AnnotationValidationRules: `[{"expression": "'gpu'"}]`},
+			wantErr: field.ErrorList{
+				field.Invalid(annotationsPath.Key(constants.AnnotationValidationRules), "", ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := ValidateValidationRules(tc.annotations, annotationsPath)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); len(diff) != 0 {
+				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateTrainJob(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		trainJob    *trainer.TrainJob
+		wantErr     field.ErrorList
+	}{
+		"no validation rules": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Obj(),
+		},
+		"TrainJob requesting GPUs passes the rule": {
+			annotations: map[string]string{constants.AnnotationValidationRules: requiresGPURules},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, corev1.ResourceList{
+						"nvidia.com/gpu": resource.MustParse("1"),
+					}).
+					Obj()).
+				Obj(),
+		},
+		"CPU-only TrainJob fails the rule": {
+			annotations: map[string]string{constants.AnnotationValidationRules: requiresGPURules},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Container("test:trainjob", nil, nil, corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("1"),
+					}).
+					Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec"), "the runtime requires GPUs"),
+			},
+		},
+		"TrainJob without resources fails the rule": {
+			annotations: map[string]string{constants.AnnotationValidationRules: requiresGPURules},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec"), "the runtime requires GPUs"),
+			},
+		},
+		"rule without message fails with the expression": {
+			annotations: map[string]string{constants.AnnotationValidationRules: `[{"expression": "trainJob.metadata.name == 'other'"}]`},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec"), "failed the runtime validation rule: trainJob.metadata.name == 'other'"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := ValidateTrainJob(tc.annotations, tc.trainJob)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "BadValue")); len(diff) != 0 {
+				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
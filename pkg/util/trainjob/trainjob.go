@@ -17,10 +17,14 @@ limitations under the License.
 package trainjob
 
 import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/utils/ptr"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
 )
 
 const (
@@ -34,6 +38,20 @@ func IsTrainJobFinished(trainJob *trainer.TrainJob) bool {
 		meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobFailed)
 }
 
+// IsCanaryPending returns true when the TrainJob requests the trainer canary,
+// and the canary has not succeeded yet.
+func IsCanaryPending(trainJob *trainer.TrainJob) bool {
+	return trainJob.Spec.Trainer != nil && ptr.Deref(trainJob.Spec.Trainer.Canary, false) &&
+		!meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobCanary)
+}
+
+// IsSpotActive returns true when the TrainJob places the trainer on the spot nodes,
+// and it has not fallen back to the on-demand nodes.
+func IsSpotActive(trainJob *trainer.TrainJob) bool {
+	return trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.Spot != nil &&
+		!meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobSpotFallback)
+}
+
 // IsManagedByExternalController returns true when the TrainJob is managed by an external
 // controller, i.e. it is not reconciled by the built-in TrainJob controller.
 func IsManagedByExternalController(trainJob *trainer.TrainJob) bool {
@@ -49,3 +67,51 @@ func RuntimeRefIsClusterTrainingRuntime(ref trainer.RuntimeRef) bool {
 	return ptr.Equal(ref.APIGroup, &trainer.GroupVersion.Group) &&
 		ptr.Equal(ref.Kind, ptr.To(trainer.ClusterTrainingRuntimeKind))
 }
+
+// RedactSensitiveEnv returns the error with the values of the sensitive envs set in the TrainJob
+// replaced, so the error can be surfaced in the logs and events. The original error remains
+// accessible via errors.Is and errors.As.
+func RedactSensitiveEnv(trainJob *trainer.TrainJob, err error) error {
+	if err == nil {
+		return nil
+	}
+	var envs []corev1.EnvVar
+	if trainJob.Spec.Trainer != nil {
+		envs = append(envs, trainJob.Spec.Trainer.Env...)
+	}
+	if trainJob.Spec.Initializer != nil {
+		if trainJob.Spec.Initializer.Dataset != nil {
+			envs = append(envs, trainJob.Spec.Initializer.Dataset.Env...)
+		}
+		if trainJob.Spec.Initializer.Model != nil {
+			envs = append(envs, trainJob.Spec.Initializer.Model.Env...)
+		}
+	}
+	var oldnew []string
+	for _, env := range envs {
+		if env.Value != "" && constants.SensitiveEnvNames.Has(env.Name) {
+			oldnew = append(oldnew, env.Value, constants.RedactedValue)
+		}
+	}
+	if len(oldnew) == 0 {
+		return err
+	}
+	message := strings.NewReplacer(oldnew...).Replace(err.Error())
+	if message == err.Error() {
+		return err
+	}
+	return &redactedError{err: err, message: message}
+}
+
+type redactedError struct {
+	err     error
+	message string
+}
+
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
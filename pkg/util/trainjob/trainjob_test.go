@@ -17,8 +17,12 @@ limitations under the License.
 package trainjob
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/go-logr/logr/funcr"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -271,3 +275,178 @@ func TestIsTrainJobFinished(t *testing.T) {
 		})
 	}
 }
+
+func TestIsCanaryPending(t *testing.T) {
+	cases := map[string]struct {
+		trainJob *trainer.TrainJob
+		want     bool
+	}{
+		"canary is not requested": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{},
+				},
+			},
+			want: false,
+		},
+		"canary is requested and has not succeeded yet": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{Canary: ptr.To(true)},
+				},
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   trainer.TrainJobCanary,
+							Status: metav1.ConditionFalse,
+							Reason: trainer.TrainJobCanaryRunningReason,
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		"canary is requested and has succeeded": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{Canary: ptr.To(true)},
+				},
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   trainer.TrainJobCanary,
+							Status: metav1.ConditionTrue,
+							Reason: trainer.TrainJobCanarySucceededReason,
+						},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCanaryPending(tc.trainJob)
+			if got != tc.want {
+				t.Errorf("IsCanaryPending(%v) = %v, want %v", tc.trainJob, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSpotActive(t *testing.T) {
+	cases := map[string]struct {
+		trainJob *trainer.TrainJob
+		want     bool
+	}{
+		"spot is not requested": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{},
+				},
+			},
+			want: false,
+		},
+		"spot is requested and has not fallen back": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{Spot: &trainer.Spot{NodeLabels: map[string]string{"spot": "true"}}},
+				},
+			},
+			want: true,
+		},
+		"spot is requested and has fallen back": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{Spot: &trainer.Spot{NodeLabels: map[string]string{"spot": "true"}}},
+				},
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   trainer.TrainJobSpotFallback,
+							Status: metav1.ConditionTrue,
+							Reason: trainer.TrainJobSpotSchedulingFailedReason,
+						},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSpotActive(tc.trainJob)
+			if got != tc.want {
+				t.Errorf("IsSpotActive(%v) = %v, want %v", tc.trainJob, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRedactSensitiveEnv(t *testing.T) {
+	errApply := errors.New(`JobSet.jobset.x-k8s.io "test" is invalid: env[0].value: Invalid value: "hf-secret", env[1].value: Invalid value: "aws-secret", env[2].value: Invalid value: "visible"`)
+	cases := map[string]struct {
+		trainJob     *trainer.TrainJob
+		err          error
+		wantMessage  string
+		wantRedacted []string
+	}{
+		"nil error": {
+			trainJob: &trainer.TrainJob{},
+		},
+		"no sensitive envs": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{Env: []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "visible"}}},
+				},
+			},
+			err:         errApply,
+			wantMessage: errApply.Error(),
+		},
+		"sensitive envs of the trainer and the initializers are redacted": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					Trainer: &trainer.Trainer{Env: []corev1.EnvVar{
+						{Name: "HF_TOKEN", Value: "hf-secret"},
+						{Name: "LOG_LEVEL", Value: "visible"},
+					}},
+					Initializer: &trainer.Initializer{
+						Dataset: &trainer.DatasetInitializer{Env: []corev1.EnvVar{{Name: "AWS_SECRET_ACCESS_KEY", Value: "aws-secret"}}},
+						Model:   &trainer.ModelInitializer{Env: []corev1.EnvVar{{Name: "ACCESS_TOKEN", Value: "model-secret"}}},
+					},
+				},
+			},
+			err:          errApply,
+			wantMessage:  `JobSet.jobset.x-k8s.io "test" is invalid: env[0].value: Invalid value: "[REDACTED]", env[1].value: Invalid value: "[REDACTED]", env[2].value: Invalid value: "visible"`,
+			wantRedacted: []string{"hf-secret", "aws-secret"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var logs strings.Builder
+			log := funcr.New(func(prefix, args string) {
+				logs.WriteString(args)
+			}, funcr.Options{})
+
+			got := RedactSensitiveEnv(tc.trainJob, tc.err)
+			if tc.err == nil {
+				if got != nil {
+					t.Errorf("RedactSensitiveEnv() = %v, want nil", got)
+				}
+				return
+			}
+			if !errors.Is(got, tc.err) {
+				t.Errorf("RedactSensitiveEnv() = %v, want wrapping %v", got, tc.err)
+			}
+			log.Error(got, "Reconciling TrainJob failed")
+			if got.Error() != tc.wantMessage {
+				t.Errorf("RedactSensitiveEnv() message = %q, want %q", got.Error(), tc.wantMessage)
+			}
+			for _, secret := range tc.wantRedacted {
+				if strings.Contains(logs.String(), secret) {
+					t.Errorf("Log output contains the sensitive value %q: %s", secret, logs.String())
+				}
+			}
+		})
+	}
+}
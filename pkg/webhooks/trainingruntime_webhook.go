@@ -20,20 +20,33 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/util/trainingruntime"
 )
 
 const (
 	rJobReplicasErrorMsg       = "always must be 1"
 	rJobContainerNamesErrorMsg = "must contain the required container for the ancestor: %s"
+	rJobNotFoundErrorMsg       = "must be the name of one of the replicatedJobs"
+	rJobTrainerMissingErrorMsg = "must contain exactly one replicatedJob with the ancestor: %s"
+	rJobTrainerDuplicateMsg    = "only one replicatedJob can have the ancestor: %s"
+
+	scheduleTimeoutNegativeErrorMsg = "must be greater than or equal to 0"
+
+	additionalPortTrainerPortErrorMsg   = "must not collide with the trainer port"
+	additionalPortContainerPortErrorMsg = "must not collide with the ports of the trainer node container"
 )
 
 var (
@@ -60,7 +73,108 @@ func setupWebhookForTrainingRuntime(mgr ctrl.Manager) error {
 func (w *TrainingRuntimeValidator) ValidateCreate(ctx context.Context, obj *trainer.TrainingRuntime) (admission.Warnings, error) {
 	log := ctrl.LoggerFrom(ctx).WithName("trainingruntime-webhook")
 	log.V(5).Info("Validating create", "trainingRuntime", klog.KObj(obj))
-	return nil, validateReplicatedJobs(obj.Spec.Template.Spec.ReplicatedJobs).ToAggregate()
+	return nil, validateRuntime(obj.ObjectMeta, obj.Spec).ToAggregate()
+}
+
+func validateRuntime(metadata metav1.ObjectMeta, spec trainer.TrainingRuntimeSpec) field.ErrorList {
+	allErrs := trainingruntime.ValidateValidationRules(metadata.Annotations, field.NewPath("metadata", "annotations"))
+	allErrs = append(allErrs, validatePodGroupPolicy(spec.PodGroupPolicy)...)
+	allErrs = append(allErrs, validateAdditionalPorts(spec)...)
+	return append(allErrs, validateJobSetSpec(spec.Template.Spec)...)
+}
+
+// validateAdditionalPorts validates that the additional ports are unique and do not collide with the trainer port
+// or the ports declared in the trainer node container, since they are all injected into the same container.
+func validateAdditionalPorts(spec trainer.TrainingRuntimeSpec) field.ErrorList {
+	if spec.MLPolicy == nil || len(spec.MLPolicy.AdditionalPorts) == 0 {
+		return nil
+	}
+	var containerPorts []corev1.ContainerPort
+	for _, rJob := range spec.Template.Spec.ReplicatedJobs {
+		if rJob.Template.Labels[constants.LabelTrainJobAncestor] != constants.AncestorTrainer {
+			continue
+		}
+		for _, container := range rJob.Template.Spec.Template.Spec.Containers {
+			if container.Name == constants.Node {
+				containerPorts = append(containerPorts, container.Ports...)
+			}
+		}
+	}
+	trainerPort := ptr.Deref(spec.MLPolicy.TrainerPort, constants.ContainerTrainerPort)
+	portsPath := field.NewPath("spec", "mlPolicy", "additionalPorts")
+	var allErrs field.ErrorList
+	seen := sets.New[int32]()
+	for idx, port := range spec.MLPolicy.AdditionalPorts {
+		portPath := portsPath.Index(idx)
+		if seen.Has(port.ContainerPort) {
+			allErrs = append(allErrs, field.Duplicate(portPath.Child("containerPort"), port.ContainerPort))
+		}
+		seen.Insert(port.ContainerPort)
+		if port.ContainerPort == trainerPort {
+			allErrs = append(allErrs, field.Invalid(portPath.Child("containerPort"), port.ContainerPort, additionalPortTrainerPortErrorMsg))
+		}
+		if spec.MLPolicy.TrainerPortName != nil && port.Name == *spec.MLPolicy.TrainerPortName {
+			allErrs = append(allErrs, field.Invalid(portPath.Child("name"), port.Name, additionalPortTrainerPortErrorMsg))
+		}
+		for _, containerPort := range containerPorts {
+			if containerPort.ContainerPort == port.ContainerPort {
+				allErrs = append(allErrs, field.Invalid(portPath.Child("containerPort"), port.ContainerPort, additionalPortContainerPortErrorMsg))
+			}
+			if len(containerPort.Name) != 0 && containerPort.Name == port.Name {
+				allErrs = append(allErrs, field.Invalid(portPath.Child("name"), port.Name, additionalPortContainerPortErrorMsg))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validatePodGroupPolicy validates that the coscheduling scheduling timeout is not negative
+// and the coscheduling queue is a valid label value, since it is set as the queue label.
+func validatePodGroupPolicy(policy *trainer.PodGroupPolicy) field.ErrorList {
+	if policy == nil || policy.Coscheduling == nil {
+		return nil
+	}
+	coschedulingPath := field.NewPath("spec", "podGroupPolicy", "coscheduling")
+	var allErrs field.ErrorList
+	if timeout := policy.Coscheduling.ScheduleTimeoutSeconds; timeout != nil && *timeout < 0 {
+		allErrs = append(allErrs, field.Invalid(coschedulingPath.Child("scheduleTimeoutSeconds"), *timeout, scheduleTimeoutNegativeErrorMsg))
+	}
+	if queue := policy.Coscheduling.Queue; len(queue) != 0 {
+		for _, msg := range validation.IsValidLabelValue(queue) {
+			allErrs = append(allErrs, field.Invalid(coschedulingPath.Child("queue"), queue, msg))
+		}
+	}
+	return allErrs
+}
+
+func validateJobSetSpec(spec jobsetv1alpha2.JobSetSpec) field.ErrorList {
+	allErrs := validateReplicatedJobs(spec.ReplicatedJobs)
+	allErrs = append(allErrs, validateContainerNames(spec.ReplicatedJobs)...)
+	return append(allErrs, validateFailurePolicy(spec)...)
+}
+
+// validateContainerNames validates that every container in the replicatedJobs has a name,
+// since the plugins resolve the containers by their names.
+func validateContainerNames(rJobs []jobsetv1alpha2.ReplicatedJob) field.ErrorList {
+	rJobsPath := field.NewPath("spec").
+		Child("template").
+		Child("spec").
+		Child("replicatedJobs")
+	var allErrs field.ErrorList
+	for idx, rJob := range rJobs {
+		podSpecPath := rJobsPath.Index(idx).Child("template").Child("spec").Child("template").Child("spec")
+		for cIdx, container := range rJob.Template.Spec.Template.Spec.InitContainers {
+			if len(container.Name) == 0 {
+				allErrs = append(allErrs, field.Required(podSpecPath.Child("initContainers").Index(cIdx).Child("name"), ""))
+			}
+		}
+		for cIdx, container := range rJob.Template.Spec.Template.Spec.Containers {
+			if len(container.Name) == 0 {
+				allErrs = append(allErrs, field.Required(podSpecPath.Child("containers").Index(cIdx).Child("name"), ""))
+			}
+		}
+	}
+	return allErrs
 }
 
 func validateReplicatedJobs(rJobs []jobsetv1alpha2.ReplicatedJob) field.ErrorList {
@@ -70,12 +184,25 @@ func validateReplicatedJobs(rJobs []jobsetv1alpha2.ReplicatedJob) field.ErrorLis
 		Child("spec").
 		Child("replicatedJobs")
 	var allErrs field.ErrorList
+	// The plugins resolve the trainer Pods by the trainer ancestor, so the runtime
+	// must have exactly one replicatedJob with the trainer ancestor.
+	hasTrainer := false
 	for idx, rJob := range rJobs {
 		if rJob.Template.Labels == nil {
 			continue
 		}
 
 		if labelAncestor, ok := rJob.Template.Labels[constants.LabelTrainJobAncestor]; ok && ancestors.Has(labelAncestor) {
+			if labelAncestor == constants.AncestorTrainer {
+				if hasTrainer {
+					allErrs = append(allErrs, field.Invalid(
+						rJobsPath.Index(idx).Child("template").Child("metadata").Child("labels").Key(constants.LabelTrainJobAncestor),
+						labelAncestor,
+						fmt.Sprintf(rJobTrainerDuplicateMsg, constants.AncestorTrainer),
+					))
+				}
+				hasTrainer = true
+			}
 			if rJob.Replicas != 1 {
 				allErrs = append(allErrs, field.Invalid(rJobsPath.Index(idx).Child("replicas"), rJob.Replicas, rJobReplicasErrorMsg))
 			}
@@ -101,11 +228,42 @@ func validateReplicatedJobs(rJobs []jobsetv1alpha2.ReplicatedJob) field.ErrorLis
 			}
 		}
 	}
+	if !hasTrainer {
+		allErrs = append(allErrs, field.Required(rJobsPath, fmt.Sprintf(rJobTrainerMissingErrorMsg, constants.AncestorTrainer)))
+	}
+	return allErrs
+}
+
+// validateFailurePolicy validates that the failure policy rules target the replicatedJobs in the runtime.
+// The RestartJob actions allow runtimes to recreate only the failed Job instead of the whole JobSet.
+func validateFailurePolicy(spec jobsetv1alpha2.JobSetSpec) field.ErrorList {
+	if spec.FailurePolicy == nil {
+		return nil
+	}
+	rJobNames := sets.New[string]()
+	for _, rJob := range spec.ReplicatedJobs {
+		rJobNames.Insert(rJob.Name)
+	}
+	rulesPath := field.NewPath("spec").
+		Child("template").
+		Child("spec").
+		Child("failurePolicy").
+		Child("rules")
+	var allErrs field.ErrorList
+	for ruleIdx, rule := range spec.FailurePolicy.Rules {
+		for targetIdx, target := range rule.TargetReplicatedJobs {
+			if !rJobNames.Has(target) {
+				allErrs = append(allErrs, field.Invalid(rulesPath.Index(ruleIdx).Child("targetReplicatedJobs").Index(targetIdx), target, rJobNotFoundErrorMsg))
+			}
+		}
+	}
 	return allErrs
 }
 
 func (w *TrainingRuntimeValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *trainer.TrainingRuntime) (admission.Warnings, error) {
-	return nil, nil
+	log := ctrl.LoggerFrom(ctx).WithName("trainingruntime-webhook")
+	log.V(5).Info("Validating update", "trainingRuntime", klog.KObj(newObj))
+	return nil, validateRuntime(newObj.ObjectMeta, newObj.Spec).ToAggregate()
 }
 
 func (w *TrainingRuntimeValidator) ValidateDelete(ctx context.Context, obj *trainer.TrainingRuntime) (admission.Warnings, error) {
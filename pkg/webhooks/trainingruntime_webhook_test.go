@@ -23,8 +23,10 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	testingutil "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
@@ -42,10 +44,32 @@ func TestValidateReplicatedJobs(t *testing.T) {
 		},
 		"valid replicatedJobs with unknown user-specified ancestor": {
 			rJobs: testingutil.MakeJobSetWrapper("ns", "valid").
-				ReplicatedJobLabel(constants.LabelTrainJobAncestor, "user-specified", constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
-				Replicas(2, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+				ReplicatedJobLabel(constants.LabelTrainJobAncestor, "user-specified", constants.DatasetInitializer, constants.ModelInitializer).
+				Replicas(2, constants.DatasetInitializer, constants.ModelInitializer).
+				Replicas(1, constants.Node).
 				Obj().Spec.ReplicatedJobs,
 		},
+		"missing replicatedJob with the trainer ancestor": {
+			rJobs: testingutil.MakeJobSetWrapper("ns", "valid").
+				ReplicatedJobLabel(constants.LabelTrainJobAncestor, "user-specified", constants.Node).
+				Replicas(1, constants.DatasetInitializer, constants.ModelInitializer).
+				Obj().Spec.ReplicatedJobs,
+			wantError: field.ErrorList{
+				field.Required(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs"), ""),
+			},
+		},
+		"duplicate replicatedJobs with the trainer ancestor": {
+			rJobs: testingutil.MakeJobSetWrapper("ns", "valid").
+				LauncherReplica().
+				Replicas(1, constants.Launcher, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+				ReplicatedJobLabel(constants.LabelTrainJobAncestor, constants.AncestorTrainer, constants.Launcher).
+				ReplaceContainer(constants.Launcher, constants.Launcher, constants.Node, "", []string{}, []string{}, corev1.ResourceList{}).
+				Obj().Spec.ReplicatedJobs,
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(3).
+					Child("template").Child("metadata").Child("labels").Key(constants.LabelTrainJobAncestor), "", ""),
+			},
+		},
 		"invalid replicas": {
 			rJobs: testingutil.MakeJobSetWrapper("ns", "valid").
 				LauncherReplica().
@@ -86,3 +110,236 @@ func TestValidateReplicatedJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFailurePolicy(t *testing.T) {
+	cases := map[string]struct {
+		spec      jobsetv1alpha2.JobSetSpec
+		wantError field.ErrorList
+	}{
+		"no failure policy": {
+			spec: testingutil.MakeJobSetWrapper("ns", "valid").
+				Obj().Spec,
+		},
+		"failure policy restarts only the failed trainer Job": {
+			spec: testingutil.MakeJobSetWrapper("ns", "valid").
+				FailurePolicy(&jobsetv1alpha2.FailurePolicy{
+					MaxRestarts: 3,
+					Rules: []jobsetv1alpha2.FailurePolicyRule{{
+						Name:                 "restartFailedNode",
+						Action:               jobsetv1alpha2.RestartJob,
+						TargetReplicatedJobs: []string{constants.Node},
+					}},
+				}).
+				Obj().Spec,
+		},
+		"failure policy targets unknown replicatedJobs": {
+			spec: testingutil.MakeJobSetWrapper("ns", "valid").
+				FailurePolicy(&jobsetv1alpha2.FailurePolicy{
+					Rules: []jobsetv1alpha2.FailurePolicyRule{
+						{
+							Name:   "failJobSet",
+							Action: jobsetv1alpha2.FailJobSet,
+						},
+						{
+							Name:                 "restartFailedNode",
+							Action:               jobsetv1alpha2.RestartJob,
+							TargetReplicatedJobs: []string{constants.Node, "unknown"},
+						},
+					},
+				}).
+				Obj().Spec,
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("failurePolicy").Child("rules").Index(1).Child("targetReplicatedJobs").Index(1),
+					"unknown", ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateFailurePolicy(tc.spec)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); len(diff) != 0 {
+				t.Errorf("validateFailurePolicy() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateContainerNames(t *testing.T) {
+	cases := map[string]struct {
+		rJobs     []jobsetv1alpha2.ReplicatedJob
+		wantError field.ErrorList
+	}{
+		"all containers have names": {
+			rJobs: testingutil.MakeJobSetWrapper("ns", "valid").
+				InitContainer(constants.Node, "init", "test").
+				Obj().Spec.ReplicatedJobs,
+		},
+		"containers without names": {
+			rJobs: testingutil.MakeJobSetWrapper("ns", "invalid").
+				InitContainer(constants.Node, "", "test").
+				ReplaceContainer(constants.Node, constants.Node, "", "test", []string{}, []string{}, corev1.ResourceList{}).
+				Obj().Spec.ReplicatedJobs,
+			wantError: field.ErrorList{
+				field.Required(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(2).Child("template").Child("spec").Child("template").Child("spec").Child("initContainers").Index(0).Child("name"), ""),
+				field.Required(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(2).Child("template").Child("spec").Child("template").Child("spec").Child("containers").Index(0).Child("name"), ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateContainerNames(tc.rJobs)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); len(diff) != 0 {
+				t.Errorf("validateContainerNames() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatePodGroupPolicy(t *testing.T) {
+	cases := map[string]struct {
+		policy    *trainer.PodGroupPolicy
+		wantError field.ErrorList
+	}{
+		"no podGroupPolicy": {},
+		"zero coscheduling scheduleTimeoutSeconds": {
+			policy: &trainer.PodGroupPolicy{
+				PodGroupPolicySource: trainer.PodGroupPolicySource{
+					Coscheduling: &trainer.CoschedulingPodGroupPolicySource{
+						ScheduleTimeoutSeconds: ptr.To[int32](0),
+					},
+				},
+			},
+		},
+		"negative coscheduling scheduleTimeoutSeconds": {
+			policy: &trainer.PodGroupPolicy{
+				PodGroupPolicySource: trainer.PodGroupPolicySource{
+					Coscheduling: &trainer.CoschedulingPodGroupPolicySource{
+						ScheduleTimeoutSeconds: ptr.To[int32](-1),
+					},
+				},
+			},
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("podGroupPolicy").Child("coscheduling").Child("scheduleTimeoutSeconds"), int32(-1), ""),
+			},
+		},
+		"valid coscheduling queue": {
+			policy: &trainer.PodGroupPolicy{
+				PodGroupPolicySource: trainer.PodGroupPolicySource{
+					Coscheduling: &trainer.CoschedulingPodGroupPolicySource{
+						Queue: "team-a.queue_1",
+					},
+				},
+			},
+		},
+		"coscheduling queue with the invalid label value": {
+			policy: &trainer.PodGroupPolicy{
+				PodGroupPolicySource: trainer.PodGroupPolicySource{
+					Coscheduling: &trainer.CoschedulingPodGroupPolicySource{
+						Queue: "team-a/queue",
+					},
+				},
+			},
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("podGroupPolicy").Child("coscheduling").Child("queue"), "team-a/queue", ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validatePodGroupPolicy(tc.policy)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); len(diff) != 0 {
+				t.Errorf("validatePodGroupPolicy() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateAdditionalPorts(t *testing.T) {
+	portsPath := field.NewPath("spec").Child("mlPolicy").Child("additionalPorts")
+	cases := map[string]struct {
+		spec      trainer.TrainingRuntimeSpec
+		wantError field.ErrorList
+	}{
+		"no mlPolicy": {
+			spec: testingutil.MakeTrainingRuntimeWrapper("ns", "no-mlpolicy").Obj().Spec,
+		},
+		"unique additional ports": {
+			spec: testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper("ns", "unique").Obj().Spec).
+				WithMLPolicy(testingutil.MakeMLPolicyWrapper().
+					WithTrainerPortName("trainer").
+					WithAdditionalPorts(
+						trainer.NamedPort{Name: "metrics", ContainerPort: 9090},
+						trainer.NamedPort{Name: "profiler", ContainerPort: 9091},
+					).
+					Obj()).
+				Obj(),
+		},
+		"additional ports with the same containerPort": {
+			spec: testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper("ns", "duplicate").Obj().Spec).
+				WithMLPolicy(testingutil.MakeMLPolicyWrapper().
+					WithAdditionalPorts(
+						trainer.NamedPort{Name: "metrics", ContainerPort: 9090},
+						trainer.NamedPort{Name: "profiler", ContainerPort: 9090},
+					).
+					Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Duplicate(portsPath.Index(1).Child("containerPort"), int32(9090)),
+			},
+		},
+		"additional port collides with the default trainer port and the trainer port name": {
+			spec: testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper("ns", "trainer-port").Obj().Spec).
+				WithMLPolicy(testingutil.MakeMLPolicyWrapper().
+					WithTrainerPortName("trainer").
+					WithAdditionalPorts(trainer.NamedPort{Name: "trainer", ContainerPort: constants.ContainerTrainerPort}).
+					Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(portsPath.Index(0).Child("containerPort"), constants.ContainerTrainerPort, ""),
+				field.Invalid(portsPath.Index(0).Child("name"), "trainer", ""),
+			},
+		},
+		"additional port collides with the configured trainer port": {
+			spec: testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper("ns", "configured-trainer-port").Obj().Spec).
+				WithMLPolicy(testingutil.MakeMLPolicyWrapper().
+					WithTrainerPort(30000).
+					WithAdditionalPorts(
+						trainer.NamedPort{Name: "metrics", ContainerPort: constants.ContainerTrainerPort},
+						trainer.NamedPort{Name: "profiler", ContainerPort: 30000},
+					).
+					Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(portsPath.Index(1).Child("containerPort"), int32(30000), ""),
+			},
+		},
+		"additional port collides with the ports of the trainer node container": {
+			spec: testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper("ns", "container-ports").Obj().Spec).
+				JobSetSpec(testingutil.MakeJobSetWrapper("ns", "container-ports").
+					ContainerTrainerPorts([]corev1.ContainerPort{
+						{Name: "metrics", ContainerPort: 8080},
+						{ContainerPort: 9091},
+					}).
+					Obj().Spec).
+				WithMLPolicy(testingutil.MakeMLPolicyWrapper().
+					WithAdditionalPorts(
+						trainer.NamedPort{Name: "metrics", ContainerPort: 9090},
+						trainer.NamedPort{Name: "profiler", ContainerPort: 9091},
+					).
+					Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(portsPath.Index(0).Child("name"), "metrics", ""),
+				field.Invalid(portsPath.Index(1).Child("containerPort"), int32(9091), ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateAdditionalPorts(tc.spec)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); len(diff) != 0 {
+				t.Errorf("validateAdditionalPorts() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
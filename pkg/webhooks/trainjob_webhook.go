@@ -23,10 +23,14 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
@@ -95,6 +99,7 @@ func (d *TrainJobDefaulter) Default(ctx context.Context, trainJob *trainer.Train
 
 // TrainJobValidator validates TrainJobs
 type TrainJobValidator struct {
+	client   client.Client
 	runtimes map[string]runtime.Runtime
 }
 
@@ -103,7 +108,7 @@ var _ admission.Validator[*trainer.TrainJob] = (*TrainJobValidator)(nil)
 func setupWebhookForTrainJob(mgr ctrl.Manager, run map[string]runtime.Runtime) error {
 	return ctrl.NewWebhookManagedBy(mgr, &trainer.TrainJob{}).
 		WithDefaulter(&TrainJobDefaulter{clock: clock.RealClock{}}).
-		WithValidator(&TrainJobValidator{runtimes: run}).
+		WithValidator(&TrainJobValidator{client: mgr.GetClient(), runtimes: run}).
 		Complete()
 }
 
@@ -111,6 +116,10 @@ func (w *TrainJobValidator) ValidateCreate(ctx context.Context, obj *trainer.Tra
 	log := ctrl.LoggerFrom(ctx).WithName("trainJob-webhook")
 	log.V(5).Info("Validating create", "TrainJob", klog.KObj(obj))
 
+	if errs := w.validateRuntimeRef(ctx, obj); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind).GroupKind(), obj.Name, errs)
+	}
+
 	runtimeRefGK := runtime.RuntimeRefToRuntimeRegistryKey(obj.Spec.RuntimeRef)
 	runtime, ok := w.runtimes[runtimeRefGK]
 	if !ok {
@@ -120,10 +129,43 @@ func (w *TrainJobValidator) ValidateCreate(ctx context.Context, obj *trainer.Tra
 	return warnings, errors.ToAggregate()
 }
 
+// validateRuntimeRef validates that the TrainingRuntime or ClusterTrainingRuntime referenced by the TrainJob exists,
+// so that the TrainJob with the mistyped runtimeRef is rejected instead of being accepted and never running.
+func (w *TrainJobValidator) validateRuntimeRef(ctx context.Context, trainJob *trainer.TrainJob) field.ErrorList {
+	runtimeRef := trainJob.Spec.RuntimeRef
+	if runtimeRef.APIGroup != nil && *runtimeRef.APIGroup != trainer.GroupVersion.Group {
+		return nil
+	}
+	var (
+		obj client.Object
+		key client.ObjectKey
+	)
+	switch ptr.Deref(runtimeRef.Kind, trainer.ClusterTrainingRuntimeKind) {
+	case trainer.TrainingRuntimeKind:
+		obj, key = &trainer.TrainingRuntime{}, client.ObjectKey{Namespace: trainJob.Namespace, Name: runtimeRef.Name}
+	case trainer.ClusterTrainingRuntimeKind:
+		obj, key = &trainer.ClusterTrainingRuntime{}, client.ObjectKey{Name: runtimeRef.Name}
+	default:
+		return nil
+	}
+	namePath := field.NewPath("spec", "runtimeRef", "name")
+	if err := w.client.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return field.ErrorList{field.NotFound(namePath, runtimeRef.Name)}
+		}
+		return field.ErrorList{field.InternalError(namePath, err)}
+	}
+	return nil
+}
+
 func (w *TrainJobValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *trainer.TrainJob) (admission.Warnings, error) {
 	log := ctrl.LoggerFrom(ctx).WithName("trainJob-webhook")
 	log.V(5).Info("Validating update", "TrainJob", klog.KObj(newObj))
 
+	if errs := validateTrainerUpdate(oldObj, newObj); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind).GroupKind(), newObj.Name, errs)
+	}
+
 	runtimeRefGK := runtime.RuntimeRefToRuntimeRegistryKey(newObj.Spec.RuntimeRef)
 	runtime, ok := w.runtimes[runtimeRefGK]
 	if !ok {
@@ -133,6 +175,25 @@ func (w *TrainJobValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *
 	return warnings, errors.ToAggregate()
 }
 
+// validateTrainerUpdate reports which trainer change is not allowed.
+// The CRD schema already rejects any trainer change while the TrainJob is not suspended,
+// so this only refines the message and narrows the changes to numNodes, e.g. by Kueue partial admission.
+func validateTrainerUpdate(oldObj, newObj *trainer.TrainJob) field.ErrorList {
+	if oldObj.Spec.Trainer == nil || newObj.Spec.Trainer == nil {
+		return nil
+	}
+	trainerPath := field.NewPath("spec", "trainer")
+	oldTrainer, newTrainer := oldObj.Spec.Trainer.DeepCopy(), newObj.Spec.Trainer.DeepCopy()
+	oldTrainer.NumNodes, newTrainer.NumNodes = nil, nil
+	if !equality.Semantic.DeepEqual(oldTrainer, newTrainer) {
+		return field.ErrorList{field.Forbidden(trainerPath, "field is immutable except numNodes")}
+	}
+	if !ptr.Equal(oldObj.Spec.Trainer.NumNodes, newObj.Spec.Trainer.NumNodes) && !ptr.Deref(oldObj.Spec.Suspend, false) {
+		return field.ErrorList{field.Invalid(trainerPath.Child("numNodes"), newObj.Spec.Trainer.NumNodes, "can only be changed while the TrainJob is suspended")}
+	}
+	return nil
+}
+
 func (w *TrainJobValidator) ValidateDelete(ctx context.Context, obj *trainer.TrainJob) (admission.Warnings, error) {
 	return nil, nil
 }
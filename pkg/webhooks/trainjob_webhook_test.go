@@ -23,8 +23,8 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -315,7 +315,7 @@ func TestValidateCreate(t *testing.T) {
 	cases := map[string]struct {
 		obj                    *trainer.TrainJob
 		clusterTrainingRuntime *trainer.ClusterTrainingRuntime
-		wantError              field.ErrorList
+		wantError              error
 		wantWarnings           admission.Warnings
 	}{
 		"valid trainjob name compliant with RFC 1035": {
@@ -331,22 +331,29 @@ func TestValidateCreate(t *testing.T) {
 			wantError:    nil,
 			wantWarnings: nil,
 		},
-		"unsupported runtime": {
+		"missing runtime": {
 			obj: testingutil.MakeTrainJobWrapper("default", "valid-job-name").
-				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "unsupported-runtime").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "missing-runtime").
 				Obj(),
 			// clusterTrainingRuntime: nil (no such runtime exists)
-			wantError: field.ErrorList{
-				&field.Error{
-					Type:  field.ErrorTypeInvalid,
-					Field: "spec.RuntimeRef",
-					BadValue: trainer.RuntimeRef{
-						Name:     "unsupported-runtime",
-						APIGroup: ptr.To(trainer.SchemeGroupVersion.Group),
-						Kind:     ptr.To(trainer.ClusterTrainingRuntimeKind),
+			wantError: apierrors.NewInvalid(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind).GroupKind(), "valid-job-name", field.ErrorList{
+				field.NotFound(field.NewPath("spec", "runtimeRef", "name"), "missing-runtime"),
+			}),
+			wantWarnings: nil,
+		},
+		"missing namespaced runtime": {
+			obj: testingutil.MakeTrainJobWrapper("default", "valid-job-name").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj(),
+			clusterTrainingRuntime: testingutil.MakeClusterTrainingRuntimeWrapper("test-runtime").
+				RuntimeSpec(trainer.TrainingRuntimeSpec{
+					Template: trainer.JobSetTemplateSpec{
+						Spec: testingutil.MakeJobSetWrapper("", "").Obj().Spec,
 					},
-				},
-			},
+				}).Obj(),
+			wantError: apierrors.NewInvalid(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind).GroupKind(), "valid-job-name", field.ErrorList{
+				field.NotFound(field.NewPath("spec", "runtimeRef", "name"), "test-runtime"),
+			}),
 			wantWarnings: nil,
 		},
 		"deprecated runtime referenced": {
@@ -384,12 +391,14 @@ func TestValidateCreate(t *testing.T) {
 				clientBuilder = clientBuilder.WithObjects(tc.clusterTrainingRuntime)
 			}
 
-			runtimes, err := runtimecore.New(context.Background(), clientBuilder.Build(), testingutil.AsIndex(clientBuilder), nil)
+			cli := clientBuilder.Build()
+			runtimes, err := runtimecore.New(context.Background(), cli, testingutil.AsIndex(clientBuilder), nil)
 			if err != nil {
 				t.Fatal(err)
 			}
 
 			validator := &TrainJobValidator{
+				client:   cli,
 				runtimes: runtimes,
 			}
 
@@ -397,9 +406,80 @@ func TestValidateCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.wantWarnings, warnings); len(diff) != 0 {
 				t.Errorf("Unexpected warnings from ValidateCreate (-want, +got): %s", diff)
 			}
-			if diff := cmp.Diff(tc.wantError.ToAggregate(), err, cmpopts.IgnoreFields(field.Error{}, "Detail")); len(diff) != 0 {
+			if diff := cmp.Diff(tc.wantError, err); len(diff) != 0 {
 				t.Errorf("Unexpected error from ValidateCreate (-want, +got): %s", diff)
 			}
 		})
 	}
 }
+
+func TestValidateUpdate(t *testing.T) {
+	clusterTrainingRuntime := testingutil.MakeClusterTrainingRuntimeWrapper("test-runtime").
+		RuntimeSpec(trainer.TrainingRuntimeSpec{
+			Template: trainer.JobSetTemplateSpec{
+				Spec: testingutil.MakeJobSetWrapper("", "").Obj().Spec,
+			},
+		}).Obj()
+	makeTrainJob := func(suspend bool, numNodes int32, image string) *trainer.TrainJob {
+		return testingutil.MakeTrainJobWrapper("default", "test-job").
+			RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "test-runtime").
+			Suspend(suspend).
+			Trainer(testingutil.MakeTrainJobTrainerWrapper().
+				NumNodes(numNodes).
+				Container(image, []string{"trainjob"}, []string{"trainjob"}, nil).
+				Obj()).
+			Obj()
+	}
+
+	cases := map[string]struct {
+		oldObj    *trainer.TrainJob
+		newObj    *trainer.TrainJob
+		wantError error
+	}{
+		"numNodes is changed while the TrainJob is suspended": {
+			oldObj: makeTrainJob(true, 2, "test:trainjob"),
+			newObj: makeTrainJob(true, 4, "test:trainjob"),
+		},
+		"numNodes is changed while the TrainJob is running": {
+			oldObj: makeTrainJob(false, 2, "test:trainjob"),
+			newObj: makeTrainJob(false, 4, "test:trainjob"),
+			wantError: apierrors.NewInvalid(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind).GroupKind(), "test-job", field.ErrorList{
+				field.Invalid(field.NewPath("spec", "trainer", "numNodes"), ptr.To[int32](4), "can only be changed while the TrainJob is suspended"),
+			}),
+		},
+		"image is changed while the TrainJob is suspended": {
+			oldObj: makeTrainJob(true, 2, "test:trainjob"),
+			newObj: makeTrainJob(true, 2, "new-image"),
+			wantError: apierrors.NewInvalid(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind).GroupKind(), "test-job", field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "trainer"), "field is immutable except numNodes"),
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+
+			clientBuilder := testingutil.NewClientBuilder().WithObjects(clusterTrainingRuntime.DeepCopy())
+			cli := clientBuilder.Build()
+			runtimes, err := runtimecore.New(context.Background(), cli, testingutil.AsIndex(clientBuilder), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			validator := &TrainJobValidator{
+				client:   cli,
+				runtimes: runtimes,
+			}
+
+			_, err = validator.ValidateUpdate(ctx, tc.oldObj, tc.newObj)
+			if diff := cmp.Diff(tc.wantError, err); len(diff) != 0 {
+				t.Errorf("Unexpected error from ValidateUpdate (-want, +got): %s", diff)
+			}
+		})
+	}
+}
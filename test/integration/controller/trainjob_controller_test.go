@@ -18,11 +18,15 @@ package controller
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,9 +35,12 @@ import (
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	jobsetconsts "sigs.k8s.io/jobset/pkg/constants"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/features"
 	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
 	testingutil "github.com/kubeflow/trainer/v2/pkg/util/testing"
 	"github.com/kubeflow/trainer/v2/test/integration/framework"
@@ -148,10 +155,12 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(jobSet).Should(gomega.BeComparableTo(
 						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
 							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
 							Suspend(true).
 							Label("testingKey", "testingVal").
 							Annotation("testingKey", "testingVal").
+							ReplicatedJobAnnotation("testingKey", "testingVal", constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 							PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, trainJobKey.Name).
 							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
 							Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
@@ -176,6 +185,19 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 								}...,
 							).
 							Container(constants.Node, constants.Node, "test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
+							Env(constants.Node, constants.Node,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.EnvDatasetMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.DatasetMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+									{
+										Name:  jobsetplgconsts.EnvModelMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.ModelMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+								}...,
+							).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
 							Obj(),
 						util.IgnoreObjectMetadata))
 					pg := &schedulerpluginsv1alpha1.PodGroup{}
@@ -194,6 +216,308 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
+			ginkgo.It("Should succeed to create the Volcano PodGroup with the aggregated minResources", func() {
+				ginkgo.By("Creating TrainingRuntime with the Volcano PodGroupPolicy and TrainJob")
+				trainingRuntime.Spec = testingutil.MakeTrainingRuntimeSpecWrapper(trainingRuntime.Spec).
+					PodGroupPolicyVolcano(&trainer.VolcanoPodGroupPolicySource{}).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the Volcano PodGroup is created instead of the scheduler-plugins PodGroup")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						g.Expect(rJob.Template.Spec.Template.Annotations).Should(
+							gomega.HaveKeyWithValue(volcanov1beta1.KubeGroupNameAnnotationKey, trainJobKey.Name))
+					}
+					pg := &volcanov1beta1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					g.Expect(pg).Should(gomega.BeComparableTo(
+						testingutil.MakeVolcanoPodGroup(ns.Name, trainJobKey.Name).
+							MinMember(102). // 102 replicas = 100 Trainer nodes + 2 Initializers.
+							MinResources(&corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("102"), // 100 CPUs for Trainer + 2 CPUs for Initializer.
+								corev1.ResourceMemory: resource.MustParse("408Gi"),
+							}).
+							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
+							Obj(),
+						util.IgnoreObjectMetadata))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Get(ctx, trainJobKey, &schedulerpluginsv1alpha1.PodGroup{})).Should(testingutil.BeNotFoundError())
+			})
+
+			ginkgo.It("Should report the total resources of the TrainJob matching the PodGroup minResources", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob status totalResources match the PodGroup minResources")
+				gomega.Eventually(func(g gomega.Gomega) {
+					pg := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.TotalResources).Should(gomega.BeComparableTo(pg.Spec.MinResources))
+					g.Expect(gotTrainJob.Status.TotalResources).Should(gomega.BeComparableTo(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("102"), // 100 CPUs for Trainer + 2 CPUs for Initializer.
+						corev1.ResourceMemory: resource.MustParse("408Gi"),
+					}))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should set the coscheduling queue label on the PodGroup and the TrainJob Pods", func() {
+				ginkgo.By("Creating TrainingRuntime with the coscheduling queue and TrainJob")
+				trainingRuntime.Spec = testingutil.MakeTrainingRuntimeSpecWrapper(trainingRuntime.Spec).
+					PodGroupPolicyCoschedulingQueue("team-a").
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the PodGroup and the JobSet Pod templates have the queue label")
+				gomega.Eventually(func(g gomega.Gomega) {
+					pg := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					g.Expect(pg.Labels).Should(gomega.HaveKeyWithValue(constants.LabelPodGroupQueue, "team-a"))
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						g.Expect(rJob.Template.Spec.Template.Labels).Should(gomega.And(
+							gomega.HaveKeyWithValue(schedulerpluginsv1alpha1.PodGroupLabel, trainJobKey.Name),
+							gomega.HaveKeyWithValue(constants.LabelPodGroupQueue, "team-a"),
+						))
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should scale the PodGroup down to zero while the started TrainJob is suspended", func() {
+				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Unsuspending the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, &schedulerpluginsv1alpha1.PodGroup{})).Should(gomega.Succeed())
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(meta.IsStatusConditionFalse(gotTrainJob.Status.Conditions, trainer.TrainJobSuspended)).Should(gomega.BeTrue())
+					pg := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					g.Expect(pg.Spec.MinMember).Should(gomega.Equal(int32(102)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Suspending the running TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Suspend = ptr.To(true)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the PodGroup minMember and minResources are scaled down to zero")
+				gomega.Eventually(func(g gomega.Gomega) {
+					pg := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					g.Expect(pg.Spec.MinMember).Should(gomega.BeZero())
+					g.Expect(pg.Spec.MinResources).Should(gomega.BeEmpty())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Resuming the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the PodGroup minMember and minResources are restored")
+				gomega.Eventually(func(g gomega.Gomega) {
+					pg := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					g.Expect(pg.Spec.MinMember).Should(gomega.Equal(int32(102)))
+					g.Expect(pg.Spec.MinResources).Should(gomega.BeComparableTo(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("102"), // 100 CPUs for Trainer + 2 CPUs for Initializer.
+						corev1.ResourceMemory: resource.MustParse("408Gi"),
+					}))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should emit the events on the suspend and resume transitions", func() {
+				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				countEvents := func(g gomega.Gomega, reason string) int {
+					events := &eventsv1.EventList{}
+					g.Expect(k8sClient.List(ctx, events, client.InNamespace(ns.Name))).Should(gomega.Succeed())
+					count := 0
+					for _, e := range events.Items {
+						if e.Regarding.Name != trainJobKey.Name || e.Type != corev1.EventTypeNormal || e.Reason != reason {
+							continue
+						}
+						// The recorder aggregates the repeated events into a series.
+						if e.Series != nil {
+							count += int(e.Series.Count)
+						} else {
+							count++
+						}
+					}
+					return count
+				}
+
+				ginkgo.By("Checking if the Suspended event is emitted")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(countEvents(g, trainer.TrainJobSuspendedReason)).Should(gomega.Equal(1))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Resuming the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the Resumed event is emitted")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(countEvents(g, trainer.TrainJobResumedReason)).Should(gomega.Equal(1))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Suspending the TrainJob again")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Suspend = ptr.To(true)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the Suspended event is emitted for the second suspension")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(countEvents(g, trainer.TrainJobSuspendedReason)).Should(gomega.Equal(2))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should set the JobSetConflict condition when the JobSet with the same name is owned by another TrainJob", func() {
+				ginkgo.By("Creating the lingering JobSet owned by the previous TrainJob with the same name")
+				lingeringJobSet := testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, "previous-trainjob-uid").
+					Suspend(true).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, lingeringJobSet)).Should(gomega.Succeed())
+
+				ginkgo.By("Creating TrainingRuntime and TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the JobSetConflict condition is set and the JobSet is not adopted")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobJobSetConflict)).Should(gomega.BeComparableTo(&metav1.Condition{
+						Type:    trainer.TrainJobJobSetConflict,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobJobSetOwnedByOtherReason,
+						Message: constants.TrainJobJobSetConflictMessage,
+					}, util.IgnoreConditions))
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(metav1.GetControllerOf(jobSet).UID).Should(gomega.BeEquivalentTo("previous-trainjob-uid"))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should keep the TrainJob suspended when its requests exceed the cluster capacity with the scheduling preflight", func() {
+				gomega.Expect(features.SetEnable(features.SchedulingPreflight, true)).Should(gomega.Succeed())
+				ginkgo.DeferCleanup(func() {
+					gomega.Expect(features.SetEnable(features.SchedulingPreflight, false)).Should(gomega.Succeed())
+				})
+
+				ginkgo.By("Creating a Node with the allocatable resources lower than the TrainJob requests")
+				node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "preflight-node"}}
+				gomega.Expect(k8sClient.Create(ctx, node)).Should(gomega.Succeed())
+				ginkgo.DeferCleanup(func() {
+					gomega.Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, node))).Should(gomega.Succeed())
+				})
+				node.Status.Allocatable = corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: resource.MustParse("1Ti"),
+				}
+				gomega.Expect(k8sClient.Status().Update(ctx, node)).Should(gomega.Succeed())
+
+				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, &jobsetv1alpha2.JobSet{})).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Resuming the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob has the Unschedulable condition explaining the insufficient cluster capacity")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.ContainElement(gomega.BeComparableTo(metav1.Condition{
+						Type:    trainer.TrainJobUnschedulable,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobInsufficientClusterCapacityReason,
+						Message: fmt.Sprintf("%s: cpu (requested 102, allocatable 8)", constants.TrainJobUnschedulableMessage),
+					}, util.IgnoreConditions)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the JobSet is kept suspended")
+				gomega.Consistently(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(ptr.Deref(jobSet.Spec.Suspend, false)).Should(gomega.BeTrue())
+				}, util.ConsistentDuration, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Increasing the allocatable resources of the Node to fit the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(node), node)).Should(gomega.Succeed())
+					node.Status.Allocatable[corev1.ResourceCPU] = resource.MustParse("128")
+					g.Expect(k8sClient.Status().Update(ctx, node)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the JobSet is resumed without any other TrainJob event")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(ptr.Deref(jobSet.Spec.Suspend, false)).Should(gomega.BeFalse())
+					g.Expect(jobSet.Annotations).ShouldNot(gomega.HaveKey(constants.AnnotationSchedulingPreflightFailed))
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobUnschedulable)).Should(gomega.BeNil())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
 			ginkgo.It("Should not reconcile TrainJob managed by an external controller", func() {
 				ginkgo.By("Creating TrainingRuntime and a TrainJob managed by MultiKueue")
 				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
@@ -265,10 +589,12 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(jobSet).Should(gomega.BeComparableTo(
 						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
 							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
 							Suspend(true).
 							Label("testingKey", "testingVal").
 							Annotation("testingKey", "testingVal").
+							ReplicatedJobAnnotation("testingKey", "testingVal", constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
 							PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, trainJobKey.Name).
 							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
 							Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
@@ -293,7 +619,20 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 								}...,
 							).
 							Container(constants.Node, constants.Node, "test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
+							Env(constants.Node, constants.Node,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.EnvDatasetMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.DatasetMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+									{
+										Name:  jobsetplgconsts.EnvModelMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.ModelMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+								}...,
+							).
 							NodeSelector(constants.Node, updatedSelector).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
 							Obj(),
 						util.IgnoreObjectMetadata))
 					pg := &schedulerpluginsv1alpha1.PodGroup{}
@@ -318,75 +657,1291 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					g.Expect(k8sClient.Update(ctx, trainJob)).Should(testingutil.BeInvalidError())
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
-			ginkgo.It("Should propagate terminationGracePeriodSeconds from RuntimePatches to JobSet pods", func() {
-				ginkgo.By("Creating a TrainingRuntime and TrainJob with terminationGracePeriodSeconds patch")
-				gracePeriodRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha-grace").
-					RuntimeSpec(
-						testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha-grace").Spec).
-							WithMLPolicy(
-								testingutil.MakeMLPolicyWrapper().
-									WithNumNodes(1).
-									Obj(),
-							).
-							Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
-							Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
-							Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
-							Obj()).
+			ginkgo.It("Should recompute the PodGroup when numNodes is changed while TrainJob is suspended", func() {
+				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the PodGroup is created with the runtime numNodes")
+				gomega.Eventually(func(g gomega.Gomega) {
+					pg := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					g.Expect(pg.Spec.MinMember).Should(gomega.Equal(int32(102)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Updating suspended TrainJob numNodes")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Trainer.NumNodes = ptr.To[int32](50)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the PodGroup minMember and minResources are recomputed")
+				gomega.Eventually(func(g gomega.Gomega) {
+					pg := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					g.Expect(pg.Spec.MinMember).Should(gomega.Equal(int32(52))) // 52 replicas = 50 Trainer nodes + 2 Initializers.
+					g.Expect(pg.Spec.MinResources).Should(gomega.BeComparableTo(corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("52"), // 50 CPUs for Trainer + 2 CPUs for Initializer.
+						corev1.ResourceMemory: resource.MustParse("208Gi"),
+					}))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should defer JobSet updates while TrainJob is running", func() {
+				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if JobSet is created")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, &jobsetv1alpha2.JobSet{})).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Unsuspending the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(ptr.Deref(jobSet.Spec.Suspend, false)).Should(gomega.BeFalse())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Updating TrainingRuntime node selector while TrainJob is running")
+				updatedSelector := map[string]string{"updated": "selector"}
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+					for i := range trainingRuntime.Spec.Template.Spec.ReplicatedJobs {
+						if trainingRuntime.Spec.Template.Spec.ReplicatedJobs[i].Name == constants.Node {
+							trainingRuntime.Spec.Template.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.NodeSelector = updatedSelector
+						}
+					}
+					g.Expect(k8sClient.Update(ctx, trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Triggering TrainJob reconcile with a label update")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					metav1.SetMetaDataLabel(&trainJob.ObjectMeta, "reconcile", "trigger")
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the running JobSet is not updated")
+				gomega.Consistently(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						g.Expect(rJob.Template.Spec.Template.Spec.NodeSelector).Should(gomega.BeEmpty())
+					}
+				}, util.ConsistentDuration, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Suspending the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, trainJob)).Should(gomega.Succeed())
+					trainJob.Spec.Suspend = ptr.To(true)
+					g.Expect(k8sClient.Update(ctx, trainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the deferred changes are applied to the suspended JobSet")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(ptr.Deref(jobSet.Spec.Suspend, false)).Should(gomega.BeTrue())
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						if rJob.Name == constants.Node {
+							g.Expect(rJob.Template.Spec.Template.Spec.NodeSelector).Should(gomega.Equal(updatedSelector))
+						}
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should propagate terminationGracePeriodSeconds from RuntimePatches to JobSet pods", func() {
+				ginkgo.By("Creating a TrainingRuntime and TrainJob with terminationGracePeriodSeconds patch")
+				gracePeriodRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha-grace").
+					RuntimeSpec(
+						testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha-grace").Spec).
+							WithMLPolicy(
+								testingutil.MakeMLPolicyWrapper().
+									WithNumNodes(1).
+									Obj(),
+							).
+							Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Obj()).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, gracePeriodRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(gracePeriodRuntime), gracePeriodRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				gracePeriod := int64(300)
+				graceJob := testingutil.MakeTrainJobWrapper(ns.Name, "grace-period-job").
+					Suspend(true).
+					RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "alpha-grace").
+					RuntimePatches([]trainer.RuntimePatch{{
+						Manager: "test.io/manager",
+						TrainingRuntimeSpec: &trainer.TrainingRuntimeSpecPatch{
+							Template: &trainer.JobSetTemplatePatch{
+								Spec: &trainer.JobSetSpecPatch{
+									ReplicatedJobs: []trainer.ReplicatedJobPatch{{
+										Name: constants.Node,
+										Template: &trainer.JobTemplatePatch{
+											Spec: &trainer.JobSpecPatch{
+												Template: &trainer.PodTemplatePatch{
+													Spec: &trainer.PodSpecPatch{
+														TerminationGracePeriodSeconds: &gracePeriod,
+													},
+												},
+											},
+										},
+									}},
+								},
+							},
+						},
+					}}).
+					Trainer(
+						testingutil.MakeTrainJobTrainerWrapper().
+							Container("test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
+							Obj()).
+					Obj()
+				graceJobKey := client.ObjectKeyFromObject(graceJob)
+				gomega.Expect(k8sClient.Create(ctx, graceJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking that JobSet node pods have terminationGracePeriodSeconds set to 300")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, graceJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet).Should(gomega.BeComparableTo(
+						testingutil.MakeJobSetWrapper(ns.Name, graceJobKey.Name).
+							InitializerDependsOn(constants.Node).
+							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), graceJobKey.Name, string(graceJob.UID)).
+							Suspend(true).
+							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+							Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
+							Completions(1, constants.DatasetInitializer, constants.ModelInitializer).
+							NumNodes(1).
+							Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Container(constants.Node, constants.Node, "test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
+							TerminationGracePeriodSeconds(constants.Node, gracePeriod).
+							TrainJobIdentityEnv(constants.Node, graceJob.UID).
+							Obj(),
+						util.IgnoreObjectMetadata))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should propagate activeDeadlineSeconds from RuntimePatches to the initializer Job", func() {
+				ginkgo.By("Creating a TrainingRuntime and TrainJob with activeDeadlineSeconds patch")
+				deadlineRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha-deadline").
+					RuntimeSpec(
+						testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha-deadline").Spec).
+							WithMLPolicy(
+								testingutil.MakeMLPolicyWrapper().
+									WithNumNodes(1).
+									Obj(),
+							).
+							Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Obj()).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, deadlineRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(deadlineRuntime), deadlineRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				deadlineJob := testingutil.MakeTrainJobWrapper(ns.Name, "active-deadline-job").
+					Suspend(true).
+					RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "alpha-deadline").
+					RuntimePatches([]trainer.RuntimePatch{{
+						Manager: "test.io/manager",
+						TrainingRuntimeSpec: &trainer.TrainingRuntimeSpecPatch{
+							Template: &trainer.JobSetTemplatePatch{
+								Spec: &trainer.JobSetSpecPatch{
+									ReplicatedJobs: []trainer.ReplicatedJobPatch{{
+										Name: constants.DatasetInitializer,
+										Template: &trainer.JobTemplatePatch{
+											Spec: &trainer.JobSpecPatch{
+												ActiveDeadlineSeconds: ptr.To[int64](600),
+											},
+										},
+									}},
+								},
+							},
+						},
+					}}).
+					Trainer(
+						testingutil.MakeTrainJobTrainerWrapper().
+							Container("test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
+							Obj()).
+					Obj()
+				deadlineJobKey := client.ObjectKeyFromObject(deadlineJob)
+				gomega.Expect(k8sClient.Create(ctx, deadlineJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking that only the dataset initializer Job has activeDeadlineSeconds set to 600")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, deadlineJobKey, jobSet)).Should(gomega.Succeed())
+					deadlines := make(map[string]*int64, len(jobSet.Spec.ReplicatedJobs))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						deadlines[rJob.Name] = rJob.Template.Spec.ActiveDeadlineSeconds
+					}
+					g.Expect(deadlines).Should(gomega.BeComparableTo(map[string]*int64{
+						constants.DatasetInitializer: ptr.To[int64](600),
+						constants.ModelInitializer:   nil,
+						constants.Node:               nil,
+					}))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should propagate annotations to the Job templates", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with Job template annotations")
+				trainJob.Spec.RuntimePatches = append(trainJob.Spec.RuntimePatches, trainer.RuntimePatch{
+					Manager: "test.io/node-manager",
+					TrainingRuntimeSpec: &trainer.TrainingRuntimeSpecPatch{
+						Template: &trainer.JobSetTemplatePatch{
+							Spec: &trainer.JobSetSpecPatch{
+								ReplicatedJobs: []trainer.ReplicatedJobPatch{{
+									Name: constants.Node,
+									Template: &trainer.JobTemplatePatch{
+										Metadata: &metav1.ObjectMeta{
+											Annotations: map[string]string{"testingKey": "nodeVal"},
+										},
+									},
+								}},
+							},
+						},
+					},
+				})
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the Job templates have the annotations")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Spec.ReplicatedJobs).Should(gomega.HaveLen(3))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						wantValue := "testingVal"
+						if rJob.Name == constants.Node {
+							wantValue = "nodeVal"
+						}
+						g.Expect(rJob.Template.Annotations).Should(gomega.HaveKeyWithValue("testingKey", wantValue))
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should override the runtime trainer command and args with the TrainJob ones", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with the trainer command and args")
+				trainJob.Spec.Trainer.Command = []string{"python", "train.py"}
+				trainJob.Spec.Trainer.Args = []string{"--epochs", "3"}
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if only the trainer container has the overridden command and args")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Spec.ReplicatedJobs).Should(gomega.HaveLen(3))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						containers := rJob.Template.Spec.Template.Spec.Containers
+						g.Expect(containers).Should(gomega.HaveLen(1))
+						if rJob.Name == constants.Node {
+							g.Expect(containers[0].Command).Should(gomega.Equal([]string{"python", "train.py"}))
+							g.Expect(containers[0].Args).Should(gomega.Equal([]string{"--epochs", "3"}))
+						} else {
+							g.Expect(containers[0].Command).Should(gomega.Equal([]string{"runtime"}))
+							g.Expect(containers[0].Args).Should(gomega.Equal([]string{"runtime"}))
+						}
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should merge the TrainJob nodeSelector and tolerations into the trainer Pod spec", func() {
+				ginkgo.By("Creating TrainingRuntime with the trainer nodeSelector and tolerations")
+				for i, rJob := range trainingRuntime.Spec.Template.Spec.ReplicatedJobs {
+					if rJob.Name != constants.Node {
+						continue
+					}
+					podSpec := &trainingRuntime.Spec.Template.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec
+					podSpec.NodeSelector = map[string]string{
+						"node-pool":   "runtime",
+						"accelerator": "nvidia-a100",
+					}
+					podSpec.Tolerations = []corev1.Toleration{
+						{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+						{Key: "node-pool", Operator: corev1.TolerationOpEqual, Value: "runtime", Effect: corev1.TaintEffectNoSchedule},
+					}
+				}
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Creating TrainJob with the trainer nodeSelector and tolerations")
+				trainJob.Spec.Trainer.NodeSelector = map[string]string{"node-pool": "h100"}
+				trainJob.Spec.Trainer.Tolerations = []corev1.Toleration{
+					{Key: "node-pool", Operator: corev1.TolerationOpEqual, Value: "h100", Effect: corev1.TaintEffectNoSchedule},
+				}
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the trainer Pod spec has the merged nodeSelector and tolerations")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Spec.ReplicatedJobs).Should(gomega.HaveLen(3))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						podSpec := rJob.Template.Spec.Template.Spec
+						if rJob.Name != constants.Node {
+							g.Expect(podSpec.NodeSelector).Should(gomega.BeEmpty())
+							g.Expect(podSpec.Tolerations).Should(gomega.BeEmpty())
+							continue
+						}
+						g.Expect(podSpec.NodeSelector).Should(gomega.Equal(map[string]string{
+							"node-pool":   "h100",
+							"accelerator": "nvidia-a100",
+						}))
+						g.Expect(podSpec.Tolerations).Should(gomega.Equal([]corev1.Toleration{
+							{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+							{Key: "node-pool", Operator: corev1.TolerationOpEqual, Value: "h100", Effect: corev1.TaintEffectNoSchedule},
+						}))
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should add the node affinity on the requested GPU product to the trainer Pod spec", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with the trainer gpuProduct")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				trainJob.Spec.Trainer.GPUProduct = ptr.To("NVIDIA-H100-80GB-HBM3")
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the trainer Pod spec requires the nodes with the GPU product")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Spec.ReplicatedJobs).Should(gomega.HaveLen(3))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						affinity := rJob.Template.Spec.Template.Spec.Affinity
+						if rJob.Name != constants.Node {
+							g.Expect(affinity).Should(gomega.BeNil())
+							continue
+						}
+						g.Expect(affinity).Should(gomega.Equal(&corev1.Affinity{
+							NodeAffinity: &corev1.NodeAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+									NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+										MatchExpressions: []corev1.NodeSelectorRequirement{{
+											Key:      constants.GPUProductLabel,
+											Operator: corev1.NodeSelectorOpIn,
+											Values:   []string{"NVIDIA-H100-80GB-HBM3"},
+										}},
+									}},
+								},
+							},
+						}))
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should place the trainer on the spot nodes and fall back to the on-demand nodes after the scheduling failures", func() {
+				spotAffinity := func(operator corev1.NodeSelectorOperator) *corev1.Affinity {
+					return &corev1.Affinity{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+									MatchExpressions: []corev1.NodeSelectorRequirement{{
+										Key:      "cloud.google.com/gke-spot",
+										Operator: operator,
+										Values:   []string{"true"},
+									}},
+								}},
+							},
+						},
+					}
+				}
+
+				ginkgo.By("Creating TrainingRuntime and TrainJob with the trainer spot")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				trainJob.Spec.Suspend = ptr.To(false)
+				trainJob.Spec.Trainer.Spot = &trainer.Spot{
+					NodeLabels:                      map[string]string{"cloud.google.com/gke-spot": "true"},
+					FallbackAfterSchedulingFailures: ptr.To[int32](1),
+				}
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the trainer Pod spec requires the spot nodes")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Annotations).Should(gomega.HaveKeyWithValue(constants.AnnotationSpot, "true"))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						if rJob.Name == constants.Node {
+							g.Expect(rJob.Template.Spec.Template.Spec.Affinity).Should(gomega.Equal(spotAffinity(corev1.NodeSelectorOpIn)))
+						}
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Creating the trainer Pod failing to be scheduled on the spot nodes")
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-%s-0-0", trainJob.Name, constants.Node),
+						Namespace: ns.Name,
+						Labels: map[string]string{
+							jobsetv1alpha2.JobSetNameKey:        trainJob.Name,
+							jobsetv1alpha2.ReplicatedJobNameKey: constants.Node,
+						},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  constants.Node,
+							Image: "test:trainjob",
+						}},
+					},
+				}
+				gomega.Expect(k8sClient.Create(ctx, pod)).Should(gomega.Succeed())
+				pod.Status = corev1.PodStatus{
+					Phase: corev1.PodPending,
+					Conditions: []corev1.PodCondition{{
+						Type:    corev1.PodScheduled,
+						Status:  corev1.ConditionFalse,
+						Reason:  corev1.PodReasonUnschedulable,
+						Message: "0/3 nodes are available: 3 node(s) didn't match Pod's node affinity/selector.",
+					}},
+				}
+				gomega.Expect(k8sClient.Status().Update(ctx, pod)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob has SpotFallback=True [SpotSchedulingFailed] condition")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobSpotFallback)).Should(gomega.BeComparableTo(&metav1.Condition{
+						Type:    trainer.TrainJobSpotFallback,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobSpotSchedulingFailedReason,
+						Message: constants.TrainJobSpotFallbackMessage,
+					}, util.IgnoreConditions))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the spot JobSet is deleted and removing its finalizers in place of the garbage collector")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.DeletionTimestamp).ShouldNot(gomega.BeNil())
+					jobSet.Finalizers = nil
+					g.Expect(k8sClient.Update(ctx, jobSet)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the trainer Pod spec excludes the spot nodes after the fallback")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.DeletionTimestamp).Should(gomega.BeNil())
+					g.Expect(jobSet.Annotations).ShouldNot(gomega.HaveKey(constants.AnnotationSpot))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						if rJob.Name == constants.Node {
+							g.Expect(rJob.Template.Spec.Template.Spec.Affinity).Should(gomega.Equal(spotAffinity(corev1.NodeSelectorOpNotIn)))
+						}
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should download the checkpoint by the model initializer and expose it to the trainer", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with the checkpoint")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				trainJob.Spec.Checkpoint = &trainer.CheckpointConfig{
+					StorageUri: ptr.To("s3://checkpoints/step-1000"),
+				}
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the checkpoint envs and volumeMounts are set in the model initializer and trainer containers")
+				checkpointVolumeMount := corev1.VolumeMount{
+					Name:      jobsetplgconsts.VolumeNameInitializer,
+					MountPath: constants.CheckpointMountPath,
+					SubPath:   jobsetplgconsts.CheckpointSubPath,
+				}
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Spec.ReplicatedJobs).Should(gomega.HaveLen(3))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						for _, c := range rJob.Template.Spec.Template.Spec.Containers {
+							switch c.Name {
+							case constants.ModelInitializer:
+								g.Expect(c.Env).Should(gomega.ContainElement(corev1.EnvVar{
+									Name:  jobsetplgconsts.InitializerEnvCheckpointStorageUri,
+									Value: "s3://checkpoints/step-1000",
+								}))
+								g.Expect(c.VolumeMounts).Should(gomega.ContainElement(checkpointVolumeMount))
+							case constants.Node:
+								g.Expect(c.Env).Should(gomega.ContainElement(corev1.EnvVar{
+									Name:  jobsetplgconsts.EnvCheckpointPath,
+									Value: constants.CheckpointMountPath,
+								}))
+								g.Expect(c.VolumeMounts).Should(gomega.ContainElement(checkpointVolumeMount))
+							default:
+								g.Expect(c.Env).ShouldNot(gomega.ContainElement(gomega.HaveField("Name", jobsetplgconsts.EnvCheckpointPath)))
+							}
+						}
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should create the dataset initializer Job per dataset and mount the datasets in the trainer", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with two datasets")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				trainJob.Spec.Initializer.Dataset = testingutil.MakeTrainJobDatasetInitializerWrapper().
+					Datasets(
+						trainer.DatasetSource{StorageUri: "hf://trainjob-dataset-train", MountPath: "/data/train"},
+						trainer.DatasetSource{StorageUri: "hf://trainjob-dataset-eval", MountPath: "/data/eval"},
+					).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the JobSet has the two dataset initializer Jobs and the trainer mounts the datasets and their metadata")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					storageUris := map[string]string{}
+					var (
+						trainerMounts []corev1.VolumeMount
+						trainerEnv    []corev1.EnvVar
+					)
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						for _, c := range rJob.Template.Spec.Template.Spec.Containers {
+							switch c.Name {
+							case constants.DatasetInitializer:
+								for _, env := range c.Env {
+									if env.Name == jobsetplgconsts.InitializerEnvStorageUri {
+										storageUris[rJob.Name] = env.Value
+									}
+								}
+							case constants.Node:
+								trainerMounts = c.VolumeMounts
+								trainerEnv = c.Env
+							}
+						}
+					}
+					g.Expect(storageUris).Should(gomega.Equal(map[string]string{
+						constants.DatasetInitializer + "-0": "hf://trainjob-dataset-train",
+						constants.DatasetInitializer + "-1": "hf://trainjob-dataset-eval",
+					}))
+					g.Expect(trainerMounts).Should(gomega.ContainElements(
+						corev1.VolumeMount{Name: jobsetplgconsts.VolumeNameInitializer, MountPath: "/data/train", SubPath: "dataset-0"},
+						corev1.VolumeMount{Name: jobsetplgconsts.VolumeNameInitializer, MountPath: "/data/eval", SubPath: "dataset-1"},
+					))
+					g.Expect(trainerEnv).Should(gomega.ContainElement(corev1.EnvVar{
+						Name:  jobsetplgconsts.EnvDatasetMetadataPath,
+						Value: "/data/train/.metadata.json,/data/eval/.metadata.json",
+					}))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should merge the TrainJob imagePullSecrets into the trainer Pod spec", func() {
+				ginkgo.By("Creating TrainingRuntime with the trainer imagePullSecrets")
+				for i, rJob := range trainingRuntime.Spec.Template.Spec.ReplicatedJobs {
+					if rJob.Name != constants.Node {
+						continue
+					}
+					trainingRuntime.Spec.Template.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+						{Name: "runtime-registry"},
+					}
+				}
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Creating TrainJob with the trainer imagePullSecrets")
+				trainJob.Spec.Trainer.ImagePullSecrets = []corev1.LocalObjectReference{
+					{Name: "runtime-registry"},
+					{Name: "private-registry"},
+				}
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the trainer Pod spec has the merged imagePullSecrets")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Spec.ReplicatedJobs).Should(gomega.HaveLen(3))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						podSpec := rJob.Template.Spec.Template.Spec
+						if rJob.Name != constants.Node {
+							g.Expect(podSpec.ImagePullSecrets).Should(gomega.BeEmpty())
+							continue
+						}
+						g.Expect(podSpec.ImagePullSecrets).Should(gomega.Equal([]corev1.LocalObjectReference{
+							{Name: "runtime-registry"},
+							{Name: "private-registry"},
+						}))
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should propagate the failure policy restarting only the failed Job to JobSet", func() {
+				ginkgo.By("Creating TrainingRuntime with the RestartJob failure policy and TrainJob")
+				failurePolicy := &jobsetv1alpha2.FailurePolicy{
+					MaxRestarts:     3,
+					RestartStrategy: jobsetv1alpha2.Recreate,
+					Rules: []jobsetv1alpha2.FailurePolicyRule{{
+						Name:                 "restartFailedNode",
+						Action:               jobsetv1alpha2.RestartJob,
+						TargetReplicatedJobs: []string{constants.Node},
+					}},
+				}
+				trainingRuntime.Spec = testingutil.MakeTrainingRuntimeSpecWrapper(trainingRuntime.Spec).
+					FailurePolicy(failurePolicy).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the JobSet restarts only the failed trainer Job")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Spec.FailurePolicy).Should(gomega.BeComparableTo(failurePolicy))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should propagate the trainer podFailurePolicy ignoring the preemption exits to JobSet", func() {
+				ginkgo.By("Creating TrainingRuntime with the trainer podFailurePolicy and TrainJob")
+				podFailurePolicy := &batchv1.PodFailurePolicy{
+					Rules: []batchv1.PodFailurePolicyRule{{
+						Action: batchv1.PodFailurePolicyActionIgnore,
+						OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+							ContainerName: ptr.To(constants.Node),
+							Operator:      batchv1.PodFailurePolicyOnExitCodesOpIn,
+							Values:        []int32{143},
+						},
+					}},
+				}
+				trainingRuntime.Spec = testingutil.MakeTrainingRuntimeSpecWrapper(trainingRuntime.Spec).
+					PodFailurePolicy(constants.Node, podFailurePolicy).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if only the trainer Job template has the podFailurePolicy")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						if rJob.Name != constants.Node {
+							g.Expect(rJob.Template.Spec.PodFailurePolicy).Should(gomega.BeNil())
+							continue
+						}
+						g.Expect(rJob.Template.Spec.PodFailurePolicy).Should(gomega.BeComparableTo(podFailurePolicy))
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should run the single-node canary before scaling the trainer to numNodes", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with the trainer canary")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				trainJob.Spec.Suspend = ptr.To(false)
+				trainJob.Spec.Trainer.Canary = ptr.To(true)
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the canary JobSet runs the trainer with a single node")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Annotations).Should(gomega.HaveKeyWithValue(constants.AnnotationCanary, "true"))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						if rJob.Name == constants.Node {
+							g.Expect(rJob.Template.Spec.Parallelism).Should(gomega.Equal(ptr.To[int32](1)))
+							g.Expect(rJob.Template.Spec.Completions).Should(gomega.Equal(ptr.To[int32](1)))
+						}
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the PodGroup of the canary gangs the trainer with a single node")
+				gomega.Eventually(func(g gomega.Gomega) {
+					podGroup := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, podGroup)).Should(gomega.Succeed())
+					g.Expect(podGroup.Annotations).Should(gomega.HaveKeyWithValue(constants.AnnotationCanary, "true"))
+					g.Expect(podGroup.Spec.MinMember).Should(gomega.Equal(int32(3)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob has Canary=False [CanaryRunning] condition")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobCanary)).Should(gomega.BeComparableTo(&metav1.Condition{
+						Type:    trainer.TrainJobCanary,
+						Status:  metav1.ConditionFalse,
+						Reason:  trainer.TrainJobCanaryRunningReason,
+						Message: constants.TrainJobCanaryRunningMessage,
+					}, util.IgnoreConditions))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Updating the canary JobSet conditions with successful completion")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					meta.SetStatusCondition(&jobSet.Status.Conditions, metav1.Condition{
+						Type:    string(jobsetv1alpha2.JobSetCompleted),
+						Reason:  jobsetconsts.AllJobsCompletedReason,
+						Message: jobsetconsts.AllJobsCompletedMessage,
+						Status:  metav1.ConditionTrue,
+					})
+					g.Expect(k8sClient.Status().Update(ctx, jobSet)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob has Canary=True [CanarySucceeded] condition without completing")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobCanary)).Should(gomega.BeComparableTo(&metav1.Condition{
+						Type:    trainer.TrainJobCanary,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobCanarySucceededReason,
+						Message: constants.TrainJobCanarySucceededMessage,
+					}, util.IgnoreConditions))
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobComplete)).Should(gomega.BeNil())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the canary JobSet is deleted and removing its finalizers in place of the garbage collector")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.DeletionTimestamp).ShouldNot(gomega.BeNil())
+					jobSet.Finalizers = nil
+					g.Expect(k8sClient.Update(ctx, jobSet)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the JobSet runs the trainer with numNodes after the canary succeeded")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.DeletionTimestamp).Should(gomega.BeNil())
+					g.Expect(jobSet.Annotations).ShouldNot(gomega.HaveKey(constants.AnnotationCanary))
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						if rJob.Name == constants.Node {
+							g.Expect(rJob.Template.Spec.Parallelism).Should(gomega.Equal(ptr.To[int32](100)))
+							g.Expect(rJob.Template.Spec.Completions).Should(gomega.Equal(ptr.To[int32](100)))
+						}
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the PodGroup is recomputed with numNodes after the canary succeeded")
+				gomega.Eventually(func(g gomega.Gomega) {
+					podGroup := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, podGroup)).Should(gomega.Succeed())
+					g.Expect(podGroup.Annotations).ShouldNot(gomega.HaveKey(constants.AnnotationCanary))
+					g.Expect(podGroup.Spec.MinMember).Should(gomega.Equal(int32(102)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should propagate envFrom sources from the Initializer to the initializer containers", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with initializer envFrom sources")
+				datasetEnvFrom := corev1.EnvFromSource{
+					SecretRef: &corev1.SecretEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "dataset-credentials"},
+					},
+				}
+				modelEnvFrom := corev1.EnvFromSource{
+					Prefix: "MODEL_",
+					ConfigMapRef: &corev1.ConfigMapEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "model-config"},
+					},
+				}
+				trainJob.Spec.Initializer = testingutil.MakeTrainJobInitializerWrapper().
+					DatasetInitializer(
+						testingutil.MakeTrainJobDatasetInitializerWrapper().
+							StorageUri("hf://trainjob-dataset").
+							EnvFrom(datasetEnvFrom).
+							Obj(),
+					).
+					ModelInitializer(
+						testingutil.MakeTrainJobModelInitializerWrapper().
+							StorageUri("hf://trainjob-model").
+							EnvFrom(modelEnvFrom).
+							Obj(),
+					).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the initializer containers have the envFrom sources")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet).Should(gomega.BeComparableTo(
+						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
+							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
+							Suspend(true).
+							Label("testingKey", "testingVal").
+							Annotation("testingKey", "testingVal").
+							ReplicatedJobAnnotation("testingKey", "testingVal", constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
+							PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, trainJobKey.Name).
+							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+							Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
+							Completions(1, constants.DatasetInitializer, constants.ModelInitializer).
+							NumNodes(100).
+							Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Env(constants.DatasetInitializer, constants.DatasetInitializer,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.InitializerEnvStorageUri,
+										Value: "hf://trainjob-dataset",
+									},
+								}...,
+							).
+							EnvFrom(constants.DatasetInitializer, constants.DatasetInitializer, datasetEnvFrom).
+							Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Env(constants.ModelInitializer, constants.ModelInitializer,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.InitializerEnvStorageUri,
+										Value: "hf://trainjob-model",
+									},
+								}...,
+							).
+							EnvFrom(constants.ModelInitializer, constants.ModelInitializer, modelEnvFrom).
+							Container(constants.Node, constants.Node, "test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
+							Env(constants.Node, constants.Node,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.EnvDatasetMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.DatasetMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+									{
+										Name:  jobsetplgconsts.EnvModelMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.ModelMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+								}...,
+							).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
+							Obj(),
+						util.IgnoreObjectMetadata))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should propagate the env valueFrom and envFrom sources from the Trainer to the trainer container", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with the trainer env sourced from Secret and ConfigMap")
+				trainerEnv := corev1.EnvVar{
+					Name: "HF_TOKEN",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "hf-secret"},
+							Key:                  "token",
+						},
+					},
+				}
+				trainerEnvFrom := corev1.EnvFromSource{
+					ConfigMapRef: &corev1.ConfigMapEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "hyperparameters"},
+					},
+				}
+				trainJob.Spec.Trainer.Env = []corev1.EnvVar{trainerEnv}
+				trainJob.Spec.Trainer.EnvFrom = []corev1.EnvFromSource{trainerEnvFrom}
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the trainer container has the env valueFrom and envFrom sources")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					var found bool
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						if rJob.Name != constants.Node {
+							continue
+						}
+						for _, container := range rJob.Template.Spec.Template.Spec.Containers {
+							if container.Name == constants.Node {
+								found = true
+								g.Expect(container.Env).Should(gomega.ContainElement(trainerEnv))
+								g.Expect(container.EnvFrom).Should(gomega.ContainElement(trainerEnvFrom))
+							}
+						}
+					}
+					g.Expect(found).Should(gomega.BeTrue())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should propagate the dataset initializer parallelism to the dataset initializer Job", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with the dataset initializer parallelism")
+				trainJob.Spec.Initializer = testingutil.MakeTrainJobInitializerWrapper().
+					DatasetInitializer(
+						testingutil.MakeTrainJobDatasetInitializerWrapper().
+							StorageUri("hf://trainjob-dataset").
+							Parallelism(4).
+							Obj(),
+					).
+					ModelInitializer(
+						testingutil.MakeTrainJobModelInitializerWrapper().
+							StorageUri("hf://trainjob-model").
+							Obj(),
+					).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the dataset initializer Job has the parallelism and completions")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet).Should(gomega.BeComparableTo(
+						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
+							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
+							Suspend(true).
+							Label("testingKey", "testingVal").
+							Annotation("testingKey", "testingVal").
+							ReplicatedJobAnnotation("testingKey", "testingVal", constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
+							PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, trainJobKey.Name).
+							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+							Parallelism(4, constants.DatasetInitializer).
+							Completions(4, constants.DatasetInitializer).
+							Parallelism(1, constants.ModelInitializer).
+							Completions(1, constants.ModelInitializer).
+							NumNodes(100).
+							Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Env(constants.DatasetInitializer, constants.DatasetInitializer,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.InitializerEnvStorageUri,
+										Value: "hf://trainjob-dataset",
+									},
+								}...,
+							).
+							Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Env(constants.ModelInitializer, constants.ModelInitializer,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.InitializerEnvStorageUri,
+										Value: "hf://trainjob-model",
+									},
+								}...,
+							).
+							Container(constants.Node, constants.Node, "test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
+							Env(constants.Node, constants.Node,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.EnvDatasetMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.DatasetMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+									{
+										Name:  jobsetplgconsts.EnvModelMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.ModelMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+								}...,
+							).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
+							Obj(),
+						util.IgnoreObjectMetadata))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the PodGroup accounts for the dataset initializer Pods")
+				gomega.Eventually(func(g gomega.Gomega) {
+					pg := &schedulerpluginsv1alpha1.PodGroup{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, pg)).Should(gomega.Succeed())
+					// 105 members = 100 Trainer nodes + 4 dataset initializer Pods + 1 model initializer Pod.
+					g.Expect(pg.Spec.MinMember).Should(gomega.Equal(int32(105)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should start the trainer once the initializers are ready with the Ready trainerDependency", func() {
+				ginkgo.By("Creating TrainingRuntime with the trainer depending on the initializers completion")
+				trainingRuntime.Spec = testingutil.MakeTrainingRuntimeSpecWrapper(trainingRuntime.Spec).
+					DependsOn(constants.Node,
+						jobsetv1alpha2.DependsOn{
+							Name:   constants.DatasetInitializer,
+							Status: jobsetv1alpha2.DependencyComplete,
+						},
+						jobsetv1alpha2.DependsOn{
+							Name:   constants.ModelInitializer,
+							Status: jobsetv1alpha2.DependencyComplete,
+						},
+					).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Creating TrainJob with the Ready trainerDependency")
+				trainJob.Spec.Initializer.TrainerDependency = ptr.To(trainer.InitializerDependencyReady)
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the trainer Job depends on the initializers Ready status")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Spec.ReplicatedJobs).Should(gomega.ContainElement(gomega.And(
+						gomega.HaveField("Name", constants.Node),
+						gomega.HaveField("DependsOn", gomega.Equal([]jobsetv1alpha2.DependsOn{
+							{
+								Name:   constants.DatasetInitializer,
+								Status: jobsetv1alpha2.DependencyReady,
+							},
+							{
+								Name:   constants.ModelInitializer,
+								Status: jobsetv1alpha2.DependencyReady,
+							},
+						})),
+					)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Unsuspending the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					gotTrainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(ptr.Deref(jobSet.Spec.Suspend, false)).Should(gomega.BeFalse())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Updating JobSet ReplicatedJobsStatus to simulate the trainer running alongside the ready initializers")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					jobSet.Status.ReplicatedJobsStatus = []jobsetv1alpha2.ReplicatedJobStatus{
+						{
+							Name:   constants.DatasetInitializer,
+							Ready:  1,
+							Active: 1,
+						},
+						{
+							Name:   constants.ModelInitializer,
+							Ready:  1,
+							Active: 1,
+						},
+						{
+							Name:   constants.Node,
+							Ready:  1,
+							Active: 1,
+						},
+					}
+					g.Expect(k8sClient.Status().Update(ctx, jobSet)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob is resumed with the initializers and the trainer active")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobSuspended,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobResumedReason,
+							Message: constants.TrainJobResumedMessage,
+						},
+					}, util.IgnoreConditions))
+					g.Expect(gotTrainJob.Status.JobsStatus).Should(gomega.BeComparableTo([]trainer.JobStatus{
+						{
+							Name:      constants.DatasetInitializer,
+							Ready:     ptr.To[int32](1),
+							Succeeded: ptr.To[int32](0),
+							Failed:    ptr.To[int32](0),
+							Active:    ptr.To[int32](1),
+							Suspended: ptr.To[int32](0),
+						},
+						{
+							Name:      constants.ModelInitializer,
+							Ready:     ptr.To[int32](1),
+							Succeeded: ptr.To[int32](0),
+							Failed:    ptr.To[int32](0),
+							Active:    ptr.To[int32](1),
+							Suspended: ptr.To[int32](0),
+						},
+						{
+							Name:      constants.Node,
+							Ready:     ptr.To[int32](1),
+							Succeeded: ptr.To[int32](0),
+							Failed:    ptr.To[int32](0),
+							Active:    ptr.To[int32](1),
+							Suspended: ptr.To[int32](0),
+						},
+					}))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should make the trainer depend on the completion of the initializers not declared in the runtime", func() {
+				ginkgo.By("Creating TrainingRuntime without the trainer dependencies and TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the trainer Job depends on the initializers Complete status")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						if rJob.Name == constants.Node {
+							g.Expect(rJob.DependsOn).Should(gomega.Equal([]jobsetv1alpha2.DependsOn{
+								{
+									Name:   constants.DatasetInitializer,
+									Status: jobsetv1alpha2.DependencyComplete,
+								},
+								{
+									Name:   constants.ModelInitializer,
+									Status: jobsetv1alpha2.DependencyComplete,
+								},
+							}))
+						} else {
+							g.Expect(rJob.DependsOn).Should(gomega.BeEmpty())
+						}
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should source the AWS credentials from the initializer secretRef for s3 storageUris", func() {
+				ginkgo.By("Creating the credentials Secret, TrainingRuntime and TrainJob with s3 storageUris")
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "s3-credentials", Namespace: ns.Name},
+					Data: map[string][]byte{
+						jobsetplgconsts.InitializerEnvAccessKeyID:     []byte("access-key-id"),
+						jobsetplgconsts.InitializerEnvSecretAccessKey: []byte("secret-access-key"),
+					},
+				}
+				gomega.Expect(k8sClient.Create(ctx, secret)).Should(gomega.Succeed())
+				trainJob.Spec.Initializer = testingutil.MakeTrainJobInitializerWrapper().
+					DatasetInitializer(
+						testingutil.MakeTrainJobDatasetInitializerWrapper().
+							StorageUri("s3://bucket/dataset").
+							SecretRef(corev1.LocalObjectReference{Name: secret.Name}).
+							Obj(),
+					).
+					ModelInitializer(
+						testingutil.MakeTrainJobModelInitializerWrapper().
+							StorageUri("s3://bucket/model").
+							SecretRef(corev1.LocalObjectReference{Name: secret.Name}).
+							Obj(),
+					).
 					Obj()
-				gomega.Expect(k8sClient.Create(ctx, gracePeriodRuntime)).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
 				gomega.Eventually(func(g gomega.Gomega) {
-					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(gracePeriodRuntime), gracePeriodRuntime)).Should(gomega.Succeed())
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
 
-				gracePeriod := int64(300)
-				graceJob := testingutil.MakeTrainJobWrapper(ns.Name, "grace-period-job").
-					Suspend(true).
-					RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "alpha-grace").
-					RuntimePatches([]trainer.RuntimePatch{{
-						Manager: "test.io/manager",
-						TrainingRuntimeSpec: &trainer.TrainingRuntimeSpecPatch{
-							Template: &trainer.JobSetTemplatePatch{
-								Spec: &trainer.JobSetSpecPatch{
-									ReplicatedJobs: []trainer.ReplicatedJobPatch{{
-										Name: constants.Node,
-										Template: &trainer.JobTemplatePatch{
-											Spec: &trainer.JobSpecPatch{
-												Template: &trainer.PodTemplatePatch{
-													Spec: &trainer.PodSpecPatch{
-														TerminationGracePeriodSeconds: &gracePeriod,
-													},
-												},
-											},
-										},
-									}},
-								},
+				secretKeyEnv := func(name, key string) corev1.EnvVar {
+					return corev1.EnvVar{
+						Name: name,
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+								Key:                  key,
+								Optional:             ptr.To(true),
 							},
 						},
-					}}).
-					Trainer(
-						testingutil.MakeTrainJobTrainerWrapper().
-							Container("test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
-							Obj()).
-					Obj()
-				graceJobKey := client.ObjectKeyFromObject(graceJob)
-				gomega.Expect(k8sClient.Create(ctx, graceJob)).Should(gomega.Succeed())
+					}
+				}
+				secretEnvFrom := corev1.EnvFromSource{
+					SecretRef: &corev1.SecretEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+					},
+				}
 
-				ginkgo.By("Checking that JobSet node pods have terminationGracePeriodSeconds set to 300")
+				ginkgo.By("Checking if the initializer containers have the storageUri and AWS credential envs")
 				gomega.Eventually(func(g gomega.Gomega) {
 					jobSet := &jobsetv1alpha2.JobSet{}
-					g.Expect(k8sClient.Get(ctx, graceJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(jobSet).Should(gomega.BeComparableTo(
-						testingutil.MakeJobSetWrapper(ns.Name, graceJobKey.Name).
-							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), graceJobKey.Name, string(graceJob.UID)).
+						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
+							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
 							Suspend(true).
+							Label("testingKey", "testingVal").
+							Annotation("testingKey", "testingVal").
+							ReplicatedJobAnnotation("testingKey", "testingVal", constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
+							PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, trainJobKey.Name).
 							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
 							Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
 							Completions(1, constants.DatasetInitializer, constants.ModelInitializer).
-							NumNodes(1).
+							NumNodes(100).
 							Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Env(constants.DatasetInitializer, constants.DatasetInitializer,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.InitializerEnvStorageUri,
+										Value: "s3://bucket/dataset",
+									},
+									secretKeyEnv(jobsetplgconsts.InitializerEnvAWSAccessKeyID, jobsetplgconsts.InitializerEnvAccessKeyID),
+									secretKeyEnv(jobsetplgconsts.InitializerEnvAWSSecretAccessKey, jobsetplgconsts.InitializerEnvSecretAccessKey),
+								}...,
+							).
+							EnvFrom(constants.DatasetInitializer, constants.DatasetInitializer, secretEnvFrom).
 							Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+							Env(constants.ModelInitializer, constants.ModelInitializer,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.InitializerEnvStorageUri,
+										Value: "s3://bucket/model",
+									},
+									secretKeyEnv(jobsetplgconsts.InitializerEnvAWSAccessKeyID, jobsetplgconsts.InitializerEnvAccessKeyID),
+									secretKeyEnv(jobsetplgconsts.InitializerEnvAWSSecretAccessKey, jobsetplgconsts.InitializerEnvSecretAccessKey),
+								}...,
+							).
+							EnvFrom(constants.ModelInitializer, constants.ModelInitializer, secretEnvFrom).
 							Container(constants.Node, constants.Node, "test:trainjob", []string{"trainjob"}, []string{"trainjob"}, resRequests).
-							TerminationGracePeriodSeconds(constants.Node, gracePeriod).
+							Env(constants.Node, constants.Node,
+								[]corev1.EnvVar{
+									{
+										Name:  jobsetplgconsts.EnvDatasetMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.DatasetMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+									{
+										Name:  jobsetplgconsts.EnvModelMetadataPath,
+										Value: fmt.Sprintf("%s/%s", constants.ModelMountPath, jobsetplgconsts.InitializerMetadataFileName),
+									},
+								}...,
+							).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
 							Obj(),
 						util.IgnoreObjectMetadata))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
@@ -433,6 +1988,7 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(jobSet).Should(gomega.BeComparableTo(
 						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
 							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
 							Suspend(false).
 							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
@@ -463,6 +2019,10 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 											},
 										},
 									},
+									{
+										Name:  constants.TorchEnvWorldSize,
+										Value: "100",
+									},
 									{
 										Name:  constants.TorchEnvMasterAddr,
 										Value: fmt.Sprintf("alpha-%s-0-0.alpha", constants.Node),
@@ -473,6 +2033,7 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 									},
 								}...,
 							).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
 							Obj(),
 						util.IgnoreObjectMetadata))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
@@ -506,6 +2067,19 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					}, util.IgnoreConditions))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
+				ginkgo.By("Checking if the TrainJob has the JobSet creation timestamp and Suspended phase")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.JobSetStatus).Should(gomega.BeComparableTo(&trainer.JobSetStatus{
+						CreationTimestamp: &jobSet.CreationTimestamp,
+						Phase:             trainer.JobSetPhaseSuspended,
+					}))
+					g.Expect(gotTrainJob.Status.StartTime).Should(gomega.BeNil())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
 				ginkgo.By("Checking if the TrainJob has Suspended=False [Resumed] condition after unsuspended")
 				gomega.Eventually(func(g gomega.Gomega) {
 					gotTrainJob := &trainer.TrainJob{}
@@ -523,6 +2097,14 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					}, util.IgnoreConditions))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
+				ginkgo.By("Checking if the TrainJob has the startTime after resumed")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.StartTime).ShouldNot(gomega.BeNil())
+					g.Expect(gotTrainJob.Status.CompletionTime).Should(gomega.BeNil())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
 				ginkgo.By("Updating the JobSet conditions and ReplicatedJobsStatus with successful completion")
 				gomega.Eventually(func(g gomega.Gomega) {
 					jobSet := &jobsetv1alpha2.JobSet{}
@@ -607,6 +2189,20 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 							Suspended: ptr.To(int32(0)),
 						},
 					}))
+					g.Expect(gotTrainJob.Status.JobSetStatus).ShouldNot(gomega.BeNil())
+					g.Expect(gotTrainJob.Status.JobSetStatus.Phase).Should(gomega.Equal(trainer.JobSetPhaseCompleted))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob has the completionTime of the JobSet Completed condition")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					completed := meta.FindStatusCondition(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetCompleted))
+					g.Expect(completed).ShouldNot(gomega.BeNil())
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.StartTime).ShouldNot(gomega.BeNil())
+					g.Expect(gotTrainJob.Status.CompletionTime).Should(gomega.BeComparableTo(&completed.LastTransitionTime))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
@@ -732,6 +2328,240 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
+			ginkgo.It("Should surface the failed Pod termination details in the Failed condition", func() {
+				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if JobSet is created")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, &jobsetv1alpha2.JobSet{})).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Unsuspending the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					gotTrainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Creating the trainer Pod with the OOMKilled container")
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-%s-0-0", trainJob.Name, constants.Node),
+						Namespace: ns.Name,
+						Labels: map[string]string{
+							jobsetv1alpha2.JobSetNameKey: trainJob.Name,
+						},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  constants.Node,
+							Image: "test:trainjob",
+						}},
+					},
+				}
+				gomega.Expect(k8sClient.Create(ctx, pod)).Should(gomega.Succeed())
+				pod.Status = corev1.PodStatus{
+					Phase: corev1.PodFailed,
+					ContainerStatuses: []corev1.ContainerStatus{{
+						Name: constants.Node,
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode: 137,
+								Reason:   "OOMKilled",
+							},
+						},
+					}},
+				}
+				gomega.Expect(k8sClient.Status().Update(ctx, pod)).Should(gomega.Succeed())
+
+				ginkgo.By("Updating the JobSet conditions with the Failed condition")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					meta.SetStatusCondition(&jobSet.Status.Conditions, metav1.Condition{
+						Type:    string(jobsetv1alpha2.JobSetFailed),
+						Reason:  jobsetconsts.FailedJobsReason,
+						Message: jobsetconsts.FailedJobsMessage,
+						Status:  metav1.ConditionTrue,
+					})
+					g.Expect(k8sClient.Status().Update(ctx, jobSet)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob Failed condition has the OOMKilled reason and the container exit code")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.ContainElement(gomega.BeComparableTo(metav1.Condition{
+						Type:   trainer.TrainJobFailed,
+						Status: metav1.ConditionTrue,
+						Reason: trainer.TrainJobOOMKilledReason,
+						Message: fmt.Sprintf("%s: %s: container %s in pod %s terminated with reason OOMKilled and exit code 137; %s",
+							jobsetconsts.FailedJobsReason, jobsetconsts.FailedJobsMessage, constants.Node, pod.Name, constants.TrainJobOOMKilledHintMessage),
+					}, util.IgnoreConditions)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should surface the image pull failures of the Pods in the ImagePullFailed condition", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Unsuspending the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					gotTrainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(ptr.Deref(jobSet.Spec.Suspend, false)).Should(gomega.BeFalse())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Creating the trainer Pod backing off the image pull")
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-%s-0-0", trainJob.Name, constants.Node),
+						Namespace: ns.Name,
+						Labels: map[string]string{
+							jobsetv1alpha2.JobSetNameKey: trainJob.Name,
+						},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  constants.Node,
+							Image: "test:missing",
+						}},
+					},
+				}
+				gomega.Expect(k8sClient.Create(ctx, pod)).Should(gomega.Succeed())
+				pod.Status = corev1.PodStatus{
+					Phase: corev1.PodPending,
+					ContainerStatuses: []corev1.ContainerStatus{{
+						Name:  constants.Node,
+						Image: "test:missing",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{
+								Reason:  trainer.TrainJobImagePullBackOffReason,
+								Message: `Back-off pulling image "test:missing"`,
+							},
+						},
+					}},
+				}
+				gomega.Expect(k8sClient.Status().Update(ctx, pod)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob has the ImagePullFailed condition with the image name")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.ContainElement(gomega.BeComparableTo(metav1.Condition{
+						Type:   trainer.TrainJobImagePullFailed,
+						Status: metav1.ConditionTrue,
+						Reason: trainer.TrainJobImagePullBackOffReason,
+						Message: fmt.Sprintf(`container %s in pod %s failed to pull image test:missing: Back-off pulling image "test:missing"`,
+							constants.Node, pod.Name),
+					}, util.IgnoreConditions)))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Updating the trainer Pod to be running once the image is pulled")
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).Should(gomega.Succeed())
+					pod.Status.Phase = corev1.PodRunning
+					pod.Status.ContainerStatuses[0].State = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+					g.Expect(k8sClient.Status().Update(ctx, pod)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the ImagePullFailed condition is removed")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobImagePullFailed)).Should(gomega.BeNil())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should surface the JobSet restarts in the TrainJob status", func() {
+				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Unsuspending the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					gotTrainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(ptr.Deref(jobSet.Spec.Suspend, false)).Should(gomega.BeFalse())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Updating the JobSet restart count")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					jobSet.Status.Restarts = 2
+					g.Expect(k8sClient.Status().Update(ctx, jobSet)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob has the restart count and Restarting=True condition")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.RestartCount).Should(gomega.Equal(ptr.To[int32](2)))
+					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobSuspended,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobResumedReason,
+							Message: constants.TrainJobResumedMessage,
+						},
+						{
+							Type:    trainer.TrainJobRestarting,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobRestartedReason,
+							Message: constants.TrainJobRestartingMessage,
+						},
+					}, util.IgnoreConditions))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Updating the JobSet ReplicatedJobsStatus once the recreated Jobs are ready")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					jobSet.Status.ReplicatedJobsStatus = nil
+					for _, rJob := range jobSet.Spec.ReplicatedJobs {
+						jobSet.Status.ReplicatedJobsStatus = append(jobSet.Status.ReplicatedJobsStatus, jobsetv1alpha2.ReplicatedJobStatus{
+							Name:  rJob.Name,
+							Ready: rJob.Replicas,
+						})
+					}
+					g.Expect(k8sClient.Status().Update(ctx, jobSet)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the Restarting condition is removed and the restart count is kept")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.RestartCount).Should(gomega.Equal(ptr.To[int32](2)))
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobRestarting)).Should(gomega.BeNil())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
 			ginkgo.It("Should synchronize JobsStatus from JobSet ReplicatedJobsStatus", func() {
 				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
 				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
@@ -983,6 +2813,7 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(jobSet).Should(gomega.BeComparableTo(
 						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
 							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
 							Suspend(false).
 							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
@@ -1032,6 +2863,10 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 											},
 										},
 									},
+									{
+										Name:  constants.TorchEnvWorldSize,
+										Value: "100",
+									},
 									{
 										Name:  constants.TorchEnvMasterAddr,
 										Value: fmt.Sprintf("alpha-%s-0-0.alpha", constants.Node),
@@ -1042,6 +2877,7 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 									},
 								}...,
 							).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
 							Obj(),
 						util.IgnoreObjectMetadata))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
@@ -1110,6 +2946,7 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(jobSet).Should(gomega.BeComparableTo(
 						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
 							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
 							Suspend(false).
 							LauncherReplica().
@@ -1227,6 +3064,7 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 									Value: "value",
 								},
 							).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
 							Obj(),
 						util.IgnoreObjectMetadata))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
@@ -1265,6 +3103,121 @@ alpha-node-0-1.alpha slots=8
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
+			ginkgo.It("Should use the configured launcher binary in the launcher container command", func() {
+				ginkgo.By("Creating OpenMPI TrainingRuntime with the launcher binary and TrainJob")
+				trainJob = testingutil.MakeTrainJobWrapper(ns.Name, "alpha").
+					RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "alpha").
+					Trainer(
+						testingutil.MakeTrainJobTrainerWrapper().
+							NumNodes(2).
+							Obj()).
+					Obj()
+				trainJobKey = client.ObjectKeyFromObject(trainJob)
+				trainingRuntime = testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha").
+					RuntimeSpec(
+						testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha").Spec).
+							LauncherReplica().
+							Replicas(1, constants.Launcher).
+							WithMLPolicy(
+								testingutil.MakeMLPolicyWrapper().
+									WithNumNodes(1).
+									WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+										MPIPolicy(ptr.To[int32](8), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(false)).
+										MPIPolicyWithLauncherBinary("mpiexec").
+										Obj(),
+									).
+									Obj(),
+							).
+							Obj()).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the launcher container command uses the configured launcher binary")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					idx := slices.IndexFunc(jobSet.Spec.ReplicatedJobs, func(rJob jobsetv1alpha2.ReplicatedJob) bool {
+						return rJob.Name == constants.Launcher
+					})
+					g.Expect(idx).ShouldNot(gomega.Equal(-1))
+					containers := jobSet.Spec.ReplicatedJobs[idx].Template.Spec.Template.Spec.Containers
+					g.Expect(containers).Should(gomega.HaveLen(1))
+					g.Expect(containers[0].Command).Should(gomega.Equal([]string{"mpiexec"}))
+					g.Expect(containers[0].Args).Should(gomega.Equal([]string{"echo.sh"}))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should mount the hostfile on the trainer nodes when mountHostfileOnNodes is enabled", func() {
+				ginkgo.By("Creating OpenMPI TrainingRuntime mounting the hostfile on the nodes and TrainJob")
+				trainJob = testingutil.MakeTrainJobWrapper(ns.Name, "alpha").
+					RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "alpha").
+					Trainer(
+						testingutil.MakeTrainJobTrainerWrapper().
+							NumNodes(2).
+							Obj()).
+					Obj()
+				trainJobKey = client.ObjectKeyFromObject(trainJob)
+				trainingRuntime = testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha").
+					RuntimeSpec(
+						testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha").Spec).
+							LauncherReplica().
+							Replicas(1, constants.Launcher).
+							WithMLPolicy(
+								testingutil.MakeMLPolicyWrapper().
+									WithNumNodes(1).
+									WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+										MPIPolicy(ptr.To[int32](8), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(false)).
+										MPIPolicyWithMountHostfileOnNodes(true).
+										Obj(),
+									).
+									Obj(),
+							).
+							Obj()).
+					Obj()
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the hostfile volume is mounted on the launcher and the trainer nodes")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					for _, rJobName := range []string{constants.Launcher, constants.Node} {
+						idx := slices.IndexFunc(jobSet.Spec.ReplicatedJobs, func(rJob jobsetv1alpha2.ReplicatedJob) bool {
+							return rJob.Name == rJobName
+						})
+						g.Expect(idx).ShouldNot(gomega.Equal(-1))
+						podSpec := jobSet.Spec.ReplicatedJobs[idx].Template.Spec.Template.Spec
+						g.Expect(podSpec.Volumes).Should(gomega.ContainElement(corev1.Volume{
+							Name: constants.MPIHostfileVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: fmt.Sprintf("%s%s", trainJob.Name, constants.MPIHostfileConfigMapSuffix),
+									},
+									Items: []corev1.KeyToPath{{
+										Key:  constants.MPIHostfileName,
+										Path: constants.MPIHostfileName,
+										Mode: ptr.To[int32](0444),
+									}},
+								},
+							},
+						}))
+						g.Expect(podSpec.Containers).Should(gomega.HaveLen(1))
+						g.Expect(podSpec.Containers[0].VolumeMounts).Should(gomega.ContainElement(corev1.VolumeMount{
+							Name:      constants.MPIHostfileVolumeName,
+							MountPath: constants.MPIHostfileDir,
+						}))
+					}
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
 			ginkgo.It("Should succeeded to reconcile TrainJob conditions with Complete condition", func() {
 				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
 				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
@@ -1664,6 +3617,78 @@ alpha-node-0-1.alpha slots=8
 			})
 		})
 
+		ginkgo.Context("Integration tests for the TrainJob cleanPodPolicy", func() {
+			createPod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-%s", trainJob.Name, name),
+						Namespace: ns.Name,
+						Labels: map[string]string{
+							jobsetv1alpha2.JobSetNameKey: trainJob.Name,
+						},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  constants.Node,
+							Image: "test:trainjob",
+						}},
+					},
+				}
+				gomega.Expect(k8sClient.Create(ctx, pod)).Should(gomega.Succeed())
+				pod.Status = corev1.PodStatus{Phase: phase}
+				gomega.Expect(k8sClient.Status().Update(ctx, pod)).Should(gomega.Succeed())
+				return pod
+			}
+
+			ginkgo.DescribeTable("Should clean up the Pods per the cleanPodPolicy once the TrainJob is finished",
+				func(policy trainer.CleanPodPolicy, wantRunningDeleted, wantSucceededDeleted bool) {
+					// We must create the referenced ClusterTrainingRuntime so the webhook passes
+					runtime := testingutil.MakeClusterTrainingRuntimeWrapper("mock-mpi").Obj()
+					gomega.Expect(client.IgnoreAlreadyExists(k8sClient.Create(ctx, runtime))).Should(gomega.Succeed())
+
+					trainJob = testingutil.MakeTrainJobWrapper(ns.Name, fmt.Sprintf("clean-pod-policy-%s", strings.ToLower(string(policy)))).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "mock-mpi").
+						ActiveDeadlineSeconds(1).
+						CleanPodPolicy(policy).
+						Obj()
+					trainJobKey = client.ObjectKeyFromObject(trainJob)
+
+					ginkgo.By("Creating the running and succeeded Pods")
+					runningPod := createPod("running", corev1.PodRunning)
+					succeededPod := createPod("succeeded", corev1.PodSucceeded)
+
+					ginkgo.By("Creating the TrainJob which fails due to the deadline")
+					gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+					gomega.Eventually(func(g gomega.Gomega) {
+						gotTrainJob := &trainer.TrainJob{}
+						g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+						g.Expect(gotTrainJob.Status.Conditions).Should(gomega.ContainElement(gomega.HaveField("Reason", trainer.TrainJobDeadlineExceededReason)))
+					}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+					ginkgo.By("Checking if the Pods are deleted or retained per the cleanPodPolicy")
+					checkPod := func(g gomega.Gomega, pod *corev1.Pod, wantDeleted bool) {
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+						if wantDeleted {
+							g.Expect(err).Should(testingutil.BeNotFoundError())
+						} else {
+							g.Expect(err).Should(gomega.Succeed())
+						}
+					}
+					gomega.Eventually(func(g gomega.Gomega) {
+						checkPod(g, runningPod, wantRunningDeleted)
+						checkPod(g, succeededPod, wantSucceededDeleted)
+					}, util.Timeout, util.Interval).Should(gomega.Succeed())
+					gomega.Consistently(func(g gomega.Gomega) {
+						checkPod(g, runningPod, wantRunningDeleted)
+						checkPod(g, succeededPod, wantSucceededDeleted)
+					}, util.ConsistentDuration, util.Interval).Should(gomega.Succeed())
+				},
+				ginkgo.Entry("All deletes all the Pods", trainer.CleanPodPolicyAll, true, true),
+				ginkgo.Entry("Running deletes only the running Pods", trainer.CleanPodPolicyRunning, true, false),
+				ginkgo.Entry("None retains all the Pods", trainer.CleanPodPolicyNone, false, false),
+			)
+		})
+
 		ginkgo.Context("Integration Tests for the Jax Runtime", func() {
 			ginkgo.It("Should succeed to create TrainJob with Jax TrainingRuntime", func() {
 				ginkgo.By("Creating Jax TrainingRuntime and TrainJob")
@@ -1705,6 +3730,7 @@ alpha-node-0-1.alpha slots=8
 					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(jobSet).Should(gomega.BeComparableTo(
 						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
 							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
 							Suspend(false).
 							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
@@ -1731,6 +3757,7 @@ alpha-node-0-1.alpha slots=8
 								},
 							).
 							ContainerTrainerPorts([]corev1.ContainerPort{{Protocol: corev1.ProtocolTCP, ContainerPort: constants.ContainerTrainerPort}}).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
 							Obj(),
 						util.IgnoreObjectMetadata))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
@@ -2028,6 +4055,7 @@ alpha-node-0-1.alpha slots=8
 					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(jobSet).Should(gomega.BeComparableTo(
 						testingutil.MakeJobSetWrapper(ns.Name, trainJobKey.Name).
+							InitializerDependsOn(constants.Node).
 							ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), trainJobKey.Name, string(trainJob.UID)).
 							Suspend(false).
 							Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
@@ -2058,6 +4086,7 @@ alpha-node-0-1.alpha slots=8
 								},
 							).
 							ContainerTrainerPorts([]corev1.ContainerPort{{Protocol: corev1.ProtocolTCP, ContainerPort: constants.ContainerTrainerPort}}).
+							TrainJobIdentityEnv(constants.Node, trainJob.UID).
 							Obj(),
 						util.IgnoreObjectMetadata))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
@@ -2473,6 +4502,25 @@ alpha-node-0-1.alpha slots=8
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
+			ginkgo.It("Should record the trainer command wrapped by Flux as the effective command", func() {
+				ginkgo.By("Creating Flux TrainingRuntime and TrainJob")
+				makeFluxObjects(false)
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob status captures the wrapped trainer command")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status.EffectiveCommand).Should(gomega.Equal(
+						[]string{"/bin/bash", "/etc/flux-config/entrypoint.sh", "trainjob trainjob"},
+					))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
 			ginkgo.It("Should succeed to reconcile TrainJob conditions with Complete condition", func() {
 				ginkgo.By("Creating Flux TrainingRuntime and suspended TrainJob")
 				makeFluxObjects(true)
@@ -2648,7 +4696,7 @@ alpha-node-0-1.alpha slots=8
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
-			ginkgo.It("Should use the runtime snapshot during reconciliation rather than the current runtime contents", func() {
+			ginkgo.It("Should keep using the runtime snapshot while the TrainJob is running", func() {
 				const (
 					originalLabelValue = "snapshot-v1"
 					updatedLabelValue  = "snapshot-v2"
@@ -2685,24 +4733,29 @@ alpha-node-0-1.alpha slots=8
 					g.Expect(jobSet.Labels).Should(gomega.HaveKeyWithValue("snapshot-test-marker", originalLabelValue))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
-				ginkgo.By("Updating the TrainingRuntime with a different label value")
-				gomega.Eventually(func(g gomega.Gomega) {
-					updatedRuntime := &trainer.TrainingRuntime{}
-					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), updatedRuntime)).Should(gomega.Succeed())
-					updatedRuntime.Spec.Template.Labels["snapshot-test-marker"] = updatedLabelValue
-					g.Expect(k8sClient.Update(ctx, updatedRuntime)).Should(gomega.Succeed())
-				}, util.Timeout, util.Interval).Should(gomega.Succeed())
-
-				ginkgo.By("Triggering reconciliation by unsuspending the TrainJob")
+				ginkgo.By("Resuming the TrainJob")
 				gomega.Eventually(func(g gomega.Gomega) {
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					gotTrainJob.Spec.Suspend = ptr.To(false)
 					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(*jobSet.Spec.Suspend).Should(gomega.BeFalse())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
-				ginkgo.By("Verifying JobSet suspend is updated but labels are not updated")
+				ginkgo.By("Updating the TrainingRuntime with a different label value")
 				gomega.Eventually(func(g gomega.Gomega) {
+					updatedRuntime := &trainer.TrainingRuntime{}
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), updatedRuntime)).Should(gomega.Succeed())
+					updatedRuntime.Spec.Template.Labels["snapshot-test-marker"] = updatedLabelValue
+					g.Expect(k8sClient.Update(ctx, updatedRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Verifying JobSet labels are not updated")
+				gomega.Consistently(func(g gomega.Gomega) {
 					jobSet := &jobsetv1alpha2.JobSet{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
 					g.Expect(*jobSet.Spec.Suspend).Should(gomega.BeFalse())
@@ -2712,13 +4765,111 @@ alpha-node-0-1.alpha slots=8
 					// Explicitly verify it's NOT the updated value
 					g.Expect(jobSet.Labels["snapshot-test-marker"]).ShouldNot(gomega.Equal(updatedLabelValue),
 						"JobSet should not pick up the updated runtime label")
-				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				}, util.ConsistentDuration, util.Interval).Should(gomega.Succeed())
 				ginkgo.By("Verifying snapshot ConfigMap is unchanged")
-				gomega.Eventually(func(g gomega.Gomega) {
+				gomega.Consistently(func(g gomega.Gomega) {
 					cm := &corev1.ConfigMap{}
 					g.Expect(k8sClient.Get(ctx, snapshotKey, cm)).Should(gomega.Succeed())
 					g.Expect(cm.Data["runtime"]).Should(gomega.ContainSubstring(originalLabelValue))
 					g.Expect(cm.Data["runtime"]).ShouldNot(gomega.ContainSubstring(updatedLabelValue))
+				}, util.ConsistentDuration, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should re-render the JobSet of the suspended TrainJob once the runtime is updated", func() {
+				const (
+					originalLabelValue = "snapshot-v1"
+					updatedLabelValue  = "snapshot-v2"
+				)
+
+				ginkgo.By("Creating TrainingRuntime with a discriminating label and suspended TrainJob")
+				trainingRuntime.Spec.Template.Labels = map[string]string{
+					"snapshot-test-marker": originalLabelValue,
+				}
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(jobSet.Labels).Should(gomega.HaveKeyWithValue("snapshot-test-marker", originalLabelValue))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Updating the TrainingRuntime with a different label value")
+				gomega.Eventually(func(g gomega.Gomega) {
+					updatedRuntime := &trainer.TrainingRuntime{}
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), updatedRuntime)).Should(gomega.Succeed())
+					updatedRuntime.Spec.Template.Labels["snapshot-test-marker"] = updatedLabelValue
+					g.Expect(k8sClient.Update(ctx, updatedRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the suspended JobSet and the snapshot pick up the updated label without touching the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(*jobSet.Spec.Suspend).Should(gomega.BeTrue())
+					g.Expect(jobSet.Labels).Should(gomega.HaveKeyWithValue("snapshot-test-marker", updatedLabelValue))
+					cm := &corev1.ConfigMap{}
+					g.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: trainJob.Name + "-runtime-snapshot", Namespace: trainJob.Namespace}, cm)).Should(gomega.Succeed())
+					g.Expect(cm.Data["runtime"]).Should(gomega.ContainSubstring(updatedLabelValue))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+			})
+
+			ginkgo.It("Should defer the force-rerender of the running JobSet until the TrainJob is suspended", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Resuming the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					gotTrainJob.Spec.Suspend = ptr.To(false)
+					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(*jobSet.Spec.Suspend).Should(gomega.BeFalse())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Setting the force-rerender annotation on the running TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					if gotTrainJob.Annotations == nil {
+						gotTrainJob.Annotations = make(map[string]string)
+					}
+					gotTrainJob.Annotations[constants.AnnotationForceRerender] = "hotfix-1"
+					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the running JobSet is not re-rendered")
+				gomega.Consistently(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(*jobSet.Spec.Suspend).Should(gomega.BeFalse())
+					g.Expect(jobSet.Annotations).ShouldNot(gomega.HaveKey(constants.AnnotationForceRerender))
+				}, util.ConsistentDuration, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Suspending the TrainJob")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					gotTrainJob.Spec.Suspend = ptr.To(true)
+					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the suspended JobSet is re-rendered with the force-rerender annotation")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					g.Expect(*jobSet.Spec.Suspend).Should(gomega.BeTrue())
+					g.Expect(jobSet.Annotations).Should(gomega.HaveKeyWithValue(constants.AnnotationForceRerender, "hotfix-1"))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
@@ -2771,3 +4922,78 @@ alpha-node-0-1.alpha slots=8
 
 	})
 })
+
+var _ = ginkgo.Describe("TrainJob controller with trainer pod labels configuration", ginkgo.Ordered, func() {
+	var ns *corev1.Namespace
+
+	trainerPodLabels := map[string]string{
+		"network.example.com/policy": "trainer",
+	}
+
+	ginkgo.BeforeAll(func() {
+		fwk = &framework.Framework{
+			Config: &configapi.Configuration{
+				TrainerPodLabels: trainerPodLabels,
+			},
+		}
+		cfg = fwk.Init()
+		ctx, k8sClient = fwk.RunManager(cfg, true)
+	})
+	ginkgo.AfterAll(func() {
+		fwk.Teardown()
+	})
+
+	ginkgo.BeforeEach(func() {
+		ns = &corev1.Namespace{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "Namespace",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "trainjob-pod-labels-",
+			},
+		}
+		gomega.Expect(k8sClient.Create(ctx, ns)).To(gomega.Succeed())
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(k8sClient.DeleteAllOf(ctx, &trainer.TrainJob{}, client.InNamespace(ns.Name))).Should(gomega.Succeed())
+	})
+
+	ginkgo.It("Should apply the configured labels to the trainer pods", func() {
+		trainingRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha").
+			RuntimeSpec(
+				testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(ns.Name, "alpha").Spec).
+					Obj()).
+			Obj()
+		trainJob := testingutil.MakeTrainJobWrapper(ns.Name, "alpha").
+			RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "alpha").
+			Obj()
+
+		ginkgo.By("Creating TrainingRuntime and TrainJob")
+		gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+		gomega.Eventually(func(g gomega.Gomega) {
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+		}, util.Timeout, util.Interval).Should(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+		ginkgo.By("Checking if the trainer pod template has the configured labels")
+		gomega.Eventually(func(g gomega.Gomega) {
+			jobSet := &jobsetv1alpha2.JobSet{}
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainJob), jobSet)).Should(gomega.Succeed())
+			g.Expect(jobSet.Spec.ReplicatedJobs).ShouldNot(gomega.BeEmpty())
+			for _, rJob := range jobSet.Spec.ReplicatedJobs {
+				podLabels := rJob.Template.Spec.Template.Labels
+				if rJob.Template.Labels[constants.LabelTrainJobAncestor] == constants.AncestorTrainer {
+					for key, value := range trainerPodLabels {
+						g.Expect(podLabels).Should(gomega.HaveKeyWithValue(key, value))
+					}
+				} else {
+					for key := range trainerPodLabels {
+						g.Expect(podLabels).ShouldNot(gomega.HaveKey(key))
+					}
+				}
+			}
+		}, util.Timeout, util.Interval).Should(gomega.Succeed())
+	})
+})
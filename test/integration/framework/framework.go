@@ -42,13 +42,18 @@ import (
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/config"
 	"github.com/kubeflow/trainer/v2/pkg/controller"
 	runtimecore "github.com/kubeflow/trainer/v2/pkg/runtime/core"
 	kubeflowwebhooks "github.com/kubeflow/trainer/v2/pkg/webhooks"
 )
 
 type Framework struct {
+	// Config is the controller manager configuration passed to the runtimes.
+	Config *configapi.Configuration
+
 	testEnv *envtest.Environment
 	cancel  context.CancelFunc
 }
@@ -89,6 +94,7 @@ func (f *Framework) RunManager(cfg *rest.Config, startControllers bool) (context
 	f.cancel = cancel
 	mgr, err := ctrl.NewManager(cfg, manager.Options{
 		Scheme: scheme.Scheme,
+		Cache:  config.CacheOptions(),
 		Metrics: metricsserver.Options{
 			BindAddress: "0", // disable metrics to avoid conflicts between packages.
 		},
@@ -101,12 +107,12 @@ func (f *Framework) RunManager(cfg *rest.Config, startControllers bool) (context
 	})
 	gomega.ExpectWithOffset(1, err).NotTo(gomega.HaveOccurred(), "failed to create manager")
 
-	runtimes, err := runtimecore.New(ctx, mgr.GetClient(), mgr.GetFieldIndexer(), nil)
+	runtimes, err := runtimecore.New(ctx, mgr.GetClient(), mgr.GetFieldIndexer(), f.Config)
 	gomega.ExpectWithOffset(1, err).NotTo(gomega.HaveOccurred())
 	gomega.ExpectWithOffset(1, runtimes).NotTo(gomega.BeNil())
 
 	if startControllers {
-		failedCtrlName, err := controller.SetupControllers(mgr, runtimes, ctrlpkg.Options{
+		failedCtrlName, err := controller.SetupControllers(mgr, runtimes, f.Config, ctrlpkg.Options{
 			// controller-runtime v0.19+ validates controller names are unique, to make sure
 			// exported Prometheus metrics for each controller do not conflict. The current check
 			// relies on static state that's not compatible with testing execution model.
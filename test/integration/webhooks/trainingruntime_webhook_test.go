@@ -76,6 +76,113 @@ var _ = ginkgo.Describe("TrainingRuntime Webhook", ginkgo.Ordered, func() {
 						Obj()
 				}),
 		)
+
+		ginkgo.It("Should fail to create TrainingRuntime with an unnamed container", func() {
+			baseRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, trainingRuntimeName)
+			runtime := baseRuntime.
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(baseRuntime.Spec).
+						Obj()).
+				Obj()
+			for i, rJob := range runtime.Spec.Template.Spec.ReplicatedJobs {
+				if rJob.Name == constants.Node {
+					runtime.Spec.Template.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers = append(
+						runtime.Spec.Template.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers,
+						corev1.Container{Image: "test:sidecar"},
+					)
+				}
+			}
+			gomega.Expect(k8sClient.Create(ctx, runtime)).Should(testingutil.BeForbiddenError())
+		})
+
+		ginkgo.It("Should fail to create TrainingRuntime with a negative coscheduling scheduleTimeoutSeconds", func() {
+			baseRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, trainingRuntimeName)
+			runtime := baseRuntime.
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(baseRuntime.Spec).
+						PodGroupPolicyCoschedulingSchedulingTimeout(-1).
+						Obj()).
+				Obj()
+			gomega.Expect(k8sClient.Create(ctx, runtime)).Should(testingutil.BeForbiddenError())
+		})
+
+		ginkgo.It("Should fail to create TrainingRuntime with a coscheduling queue which is not a valid label value", func() {
+			baseRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, trainingRuntimeName)
+			runtime := baseRuntime.
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(baseRuntime.Spec).
+						PodGroupPolicyCoschedulingQueue("team-a/queue").
+						Obj()).
+				Obj()
+			gomega.Expect(k8sClient.Create(ctx, runtime)).Should(testingutil.BeForbiddenError())
+		})
+
+		ginkgo.It("Should fail to create TrainingRuntime with an additional port colliding with the trainer port", func() {
+			baseRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, trainingRuntimeName)
+			runtime := baseRuntime.
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(baseRuntime.Spec).
+						WithMLPolicy(testingutil.MakeMLPolicyWrapper().
+							WithAdditionalPorts(trainer.NamedPort{Name: "metrics", ContainerPort: constants.ContainerTrainerPort}).
+							Obj()).
+						Obj()).
+				Obj()
+			gomega.Expect(k8sClient.Create(ctx, runtime)).Should(testingutil.BeForbiddenError())
+		})
+
+		ginkgo.DescribeTable("Should fail to create TrainingRuntime without exactly one trainer replicatedJob", func(rJobName, ancestor, containerName string) {
+			baseRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, trainingRuntimeName)
+			runtime := baseRuntime.
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(baseRuntime.Spec).
+						Obj()).
+				Obj()
+			for i, rJob := range runtime.Spec.Template.Spec.ReplicatedJobs {
+				if rJob.Name == rJobName {
+					runtime.Spec.Template.Spec.ReplicatedJobs[i].Template.Labels[constants.LabelTrainJobAncestor] = ancestor
+					runtime.Spec.Template.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.Containers[0].Name = containerName
+				}
+			}
+			gomega.Expect(k8sClient.Create(ctx, runtime)).Should(testingutil.BeForbiddenError())
+		},
+			ginkgo.Entry("the trainer replicatedJob is missing",
+				constants.Node, "user-specified", constants.Node),
+			ginkgo.Entry("the trainer replicatedJob is duplicated",
+				constants.DatasetInitializer, constants.AncestorTrainer, constants.Node),
+		)
+	})
+
+	ginkgo.When("Updating TrainingRuntime", func() {
+		ginkgo.It("Should fail to update TrainingRuntime with an invalid validation rule", func() {
+			baseRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, trainingRuntimeName)
+			runtime := baseRuntime.
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(baseRuntime.Spec).
+						Obj()).
+				Obj()
+			gomega.Expect(k8sClient.Create(ctx, runtime)).Should(gomega.Succeed())
+			gomega.Eventually(func(g gomega.Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(runtime), runtime)).Should(gomega.Succeed())
+				metav1.SetMetaDataAnnotation(&runtime.ObjectMeta, constants.AnnotationValidationRules, `[{"expression": "trainJob.spec.trainer ="}]`)
+				g.Expect(k8sClient.Update(ctx, runtime)).Should(testingutil.BeForbiddenError())
+			}, util.Timeout, util.Interval).Should(gomega.Succeed())
+		})
+
+		ginkgo.It("Should fail to update TrainingRuntime with a negative coscheduling scheduleTimeoutSeconds", func() {
+			baseRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, trainingRuntimeName)
+			runtime := baseRuntime.
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(baseRuntime.Spec).
+						PodGroupPolicyCoschedulingSchedulingTimeout(120).
+						Obj()).
+				Obj()
+			gomega.Expect(k8sClient.Create(ctx, runtime)).Should(gomega.Succeed())
+			gomega.Eventually(func(g gomega.Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(runtime), runtime)).Should(gomega.Succeed())
+				runtime.Spec.PodGroupPolicy.Coscheduling.ScheduleTimeoutSeconds = ptr.To[int32](-1)
+				g.Expect(k8sClient.Update(ctx, runtime)).Should(testingutil.BeForbiddenError())
+			}, util.Timeout, util.Interval).Should(gomega.Succeed())
+		})
 	})
 })
 
@@ -204,6 +311,60 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 					return runtime
 				},
 				testingutil.BeInvalidError()),
+			ginkgo.Entry("Should succeed to create trainingRuntime with torch.rdzvBackend=etcd-v2 and rdzvEndpoint",
+				func() *trainer.TrainingRuntime {
+					return testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").
+						RuntimeSpec(testingutil.MakeTrainingRuntimeSpecWrapper(
+							testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").Obj().Spec).
+							WithMLPolicy(
+								testingutil.MakeMLPolicyWrapper().
+									WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+										TorchPolicyWithRdzv(trainer.RdzvBackendEtcdV2, ptr.To("etcd.example.com:2379")).
+										Obj(),
+									).
+									Obj(),
+							).
+							Obj()).
+						Obj()
+				},
+				gomega.Succeed(),
+			),
+			ginkgo.Entry("Should fail to create trainingRuntime with torch.rdzvBackend=etcd-v2 and without rdzvEndpoint",
+				func() *trainer.TrainingRuntime {
+					return testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").
+						RuntimeSpec(testingutil.MakeTrainingRuntimeSpecWrapper(
+							testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").Obj().Spec).
+							WithMLPolicy(
+								testingutil.MakeMLPolicyWrapper().
+									WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+										TorchPolicyWithRdzv(trainer.RdzvBackendEtcdV2, nil).
+										Obj(),
+									).
+									Obj(),
+							).
+							Obj()).
+						Obj()
+				},
+				testingutil.BeInvalidError(),
+			),
+			ginkgo.Entry("Should fail to create trainingRuntime with torch.rdzvBackend=etcd-v2 and empty rdzvEndpoint",
+				func() *trainer.TrainingRuntime {
+					return testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").
+						RuntimeSpec(testingutil.MakeTrainingRuntimeSpecWrapper(
+							testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").Obj().Spec).
+							WithMLPolicy(
+								testingutil.MakeMLPolicyWrapper().
+									WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+										TorchPolicyWithRdzv(trainer.RdzvBackendEtcdV2, ptr.To("")).
+										Obj(),
+									).
+									Obj(),
+							).
+							Obj()).
+						Obj()
+				},
+				testingutil.BeInvalidError(),
+			),
 			ginkgo.Entry("Should fail to create trainingRuntime with non-supported mpi.mpiImplementation",
 				func() *trainer.TrainingRuntime {
 					return testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").
@@ -22,6 +22,7 @@ import (
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
@@ -103,7 +104,7 @@ var _ = ginkgo.Describe("TrainJob Webhook", ginkgo.Ordered, func() {
 						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "invalid").
 						Obj()
 				},
-				testingutil.BeForbiddenError()),
+				testingutil.BeInvalidError()),
 			ginkgo.Entry("Should succeed in creating trainJob with namespace scoped trainingRuntime",
 				func() *trainer.TrainJob {
 					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
@@ -111,6 +112,23 @@ var _ = ginkgo.Describe("TrainJob Webhook", ginkgo.Ordered, func() {
 						Obj()
 				},
 				gomega.Succeed()),
+			ginkgo.Entry("Should fail in creating CPU-only trainJob when the trainingRuntime validation rule requires GPUs",
+				func() *trainer.TrainJob {
+					metav1.SetMetaDataAnnotation(&trainingRuntime.ObjectMeta, constants.AnnotationValidationRules,
+						`[{"expression": "has(trainJob.spec.trainer) && has(trainJob.spec.trainer.resourcesPerNode) && has(trainJob.spec.trainer.resourcesPerNode.requests) && 'nvidia.com/gpu' in trainJob.spec.trainer.resourcesPerNode.requests", "message": "the runtime requires GPUs"}]`)
+					gomega.Expect(k8sClient.Update(ctx, trainingRuntime)).To(gomega.Succeed())
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), runtimeName).
+						Trainer(
+							testingutil.MakeTrainJobTrainerWrapper().
+								Container("test:trainjob", nil, nil, corev1.ResourceList{
+									corev1.ResourceCPU: resource.MustParse("1"),
+								}).
+								Obj(),
+						).
+						Obj()
+				},
+				testingutil.BeForbiddenError()),
 			ginkgo.Entry("Should fail in creating trainJob with pre-trained model config when referencing a trainingRuntime without an initializer",
 				func() *trainer.TrainJob {
 					newContainers := []corev1.Container{}
@@ -198,7 +216,79 @@ var _ = ginkgo.Describe("TrainJob Webhook", ginkgo.Ordered, func() {
 						Obj()
 				},
 				testingutil.BeForbiddenError()),
+			ginkgo.Entry("Should fail in creating trainJob with numNodes=0",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), runtimeName).
+						Trainer(&trainer.Trainer{NumNodes: ptr.To[int32](0)}).
+						Obj()
+				},
+				testingutil.BeForbiddenError()),
+			ginkgo.Entry("Should succeed in creating trainJob with numNodes=0 in the MPI launcher-only mode",
+				func() *trainer.TrainJob {
+					mpiRuntime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, "mpi-launcher-only").
+						RuntimeSpec(
+							testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(ns.Name, "mpi-launcher-only").Spec).
+								LauncherReplica().
+								WithMLPolicy(
+									testingutil.MakeMLPolicyWrapper().
+										WithNumNodes(1).
+										WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+											MPIPolicy(ptr.To[int32](1), trainer.MPIImplementationOpenMPI, ptr.To("/root/.ssh"), ptr.To(true)).
+											Obj(),
+										).
+										Obj(),
+								).
+								Obj(),
+						).
+						Obj()
+					gomega.Expect(k8sClient.Create(ctx, mpiRuntime)).To(gomega.Succeed())
+					gomega.Eventually(func(g gomega.Gomega) {
+						g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mpiRuntime), mpiRuntime)).Should(gomega.Succeed())
+					}, util.Timeout, util.Interval).Should(gomega.Succeed())
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), mpiRuntime.Name).
+						Trainer(&trainer.Trainer{NumNodes: ptr.To[int32](0)}).
+						Obj()
+				},
+				gomega.Succeed()),
+			ginkgo.Entry("Should succeed in creating trainJob with numNodes=1",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), runtimeName).
+						Trainer(&trainer.Trainer{NumNodes: ptr.To[int32](1)}).
+						Obj()
+				},
+				gomega.Succeed()),
 
+			ginkgo.Entry("Should fail in creating trainJob with fractional GPU requests",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), runtimeName).
+						Trainer(
+							testingutil.MakeTrainJobTrainerWrapper().
+								Container("test:trainjob", nil, nil, corev1.ResourceList{
+									"nvidia.com/gpu": resource.MustParse("0.5"),
+								}).
+								Obj(),
+						).
+						Obj()
+				},
+				testingutil.BeForbiddenError()),
+			ginkgo.Entry("Should succeed in creating trainJob with whole GPU requests",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), runtimeName).
+						Trainer(
+							testingutil.MakeTrainJobTrainerWrapper().
+								Container("test:trainjob", nil, nil, corev1.ResourceList{
+									"nvidia.com/gpu": resource.MustParse("1"),
+								}).
+								Obj(),
+						).
+						Obj()
+				},
+				gomega.Succeed()),
 			ginkgo.Entry("Should fail in creating TrainJob with Flux numProcPerNode < 1",
 				func() *trainer.TrainJob {
 					trainingRuntime.Spec.MLPolicy = &trainer.MLPolicy{
@@ -345,6 +435,70 @@ var _ = ginkgo.Describe("TrainJob Webhook", ginkgo.Ordered, func() {
 				},
 				gomega.Succeed(),
 			),
+			ginkgo.Entry("Should fail in creating trainJob with dataset initializer secretRef referencing a missing secret",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), runtimeName).
+						Initializer(
+							testingutil.MakeTrainJobInitializerWrapper().
+								DatasetInitializer(
+									testingutil.MakeTrainJobDatasetInitializerWrapper().
+										StorageUri("hf://trainjob-dataset").
+										SecretRef(corev1.LocalObjectReference{Name: "missing-secret"}).
+										Obj(),
+								).
+								Obj(),
+						).
+						Obj()
+				},
+				testingutil.BeForbiddenError()),
+			ginkgo.Entry("Should fail in creating trainJob with model initializer secretRef referencing a secret without the expected key",
+				func() *trainer.TrainJob {
+					secret := &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "model-secret", Namespace: ns.Name},
+						Data:       map[string][]byte{"TOKEN": []byte("token")},
+					}
+					gomega.Expect(k8sClient.Create(ctx, secret)).To(gomega.Succeed())
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), runtimeName).
+						Initializer(
+							testingutil.MakeTrainJobInitializerWrapper().
+								ModelInitializer(
+									testingutil.MakeTrainJobModelInitializerWrapper().
+										StorageUri("hf://trainjob-model").
+										SecretRef(corev1.LocalObjectReference{Name: "model-secret"}).
+										Obj(),
+								).
+								Obj(),
+						).
+						Obj()
+				},
+				testingutil.BeForbiddenError()),
+			ginkgo.Entry("Should succeed in creating trainJob with model initializer secretRef referencing an existing secret",
+				func() *trainer.TrainJob {
+					secret := &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "model-secret", Namespace: ns.Name},
+						Data:       map[string][]byte{"ACCESS_TOKEN": []byte("token")},
+					}
+					gomega.Expect(k8sClient.Create(ctx, secret)).To(gomega.Succeed())
+					gomega.Eventually(func(g gomega.Gomega) {
+						g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).Should(gomega.Succeed())
+					}, util.Timeout, util.Interval).Should(gomega.Succeed())
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), runtimeName).
+						Initializer(
+							testingutil.MakeTrainJobInitializerWrapper().
+								ModelInitializer(
+									testingutil.MakeTrainJobModelInitializerWrapper().
+										StorageUri("hf://trainjob-model").
+										SecretRef(corev1.LocalObjectReference{Name: "model-secret"}).
+										Obj(),
+								).
+								Obj(),
+						).
+						Obj()
+				},
+				gomega.Succeed()),
 			ginkgo.Entry("Should succeed to create TrainJob with trainer command item at the max length",
 				func() *trainer.TrainJob {
 					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
@@ -523,6 +677,20 @@ var _ = ginkgo.Describe("TrainJob marker validations and defaulting", ginkgo.Ord
 						Obj()
 				},
 				testingutil.BeInvalidError()),
+			ginkgo.Entry("Should fail to create TrainJob with runtimeRef referencing the missing TrainingRuntime",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, "dangling-runtime-ref").
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), "missing").
+						Obj()
+				},
+				testingutil.BeInvalidError()),
+			ginkgo.Entry("Should fail to create TrainJob with runtimeRef referencing the missing ClusterTrainingRuntime",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, "dangling-cluster-runtime-ref").
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "missing").
+						Obj()
+				},
+				testingutil.BeInvalidError()),
 			ginkgo.Entry("Should succeed to create trainJob with runtimePatches containing two replicated job patches",
 				func() *trainer.TrainJob {
 					return testingutil.MakeTrainJobWrapper(ns.Name, "two-rjob-patches").
@@ -833,6 +1001,51 @@ var _ = ginkgo.Describe("TrainJob marker validations and defaulting", ginkgo.Ord
 					return job
 				},
 				testingutil.BeInvalidError()),
+			ginkgo.Entry("Should fail to update trainer numNodes when suspend is false",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, "running-trainer-num-nodes").
+						RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "testing").
+						Suspend(false).
+						Trainer(&trainer.Trainer{
+							NumNodes: ptr.To[int32](2),
+						}).
+						Obj()
+				},
+				func(job *trainer.TrainJob) *trainer.TrainJob {
+					job.Spec.Trainer.NumNodes = ptr.To[int32](4)
+					return job
+				},
+				testingutil.BeInvalidError()),
+			ginkgo.Entry("Should succeed to update trainer numNodes when suspend is true",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, "suspended-trainer-num-nodes").
+						RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "testing").
+						Suspend(true).
+						Trainer(&trainer.Trainer{
+							NumNodes: ptr.To[int32](2),
+						}).
+						Obj()
+				},
+				func(job *trainer.TrainJob) *trainer.TrainJob {
+					job.Spec.Trainer.NumNodes = ptr.To[int32](4)
+					return job
+				},
+				gomega.Succeed()),
+			ginkgo.Entry("Should fail to update trainer image when suspend is true",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, "suspended-trainer-image").
+						RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "testing").
+						Suspend(true).
+						Trainer(&trainer.Trainer{
+							Image: ptr.To("test-image"),
+						}).
+						Obj()
+				},
+				func(job *trainer.TrainJob) *trainer.TrainJob {
+					job.Spec.Trainer.Image = ptr.To("forbidden-update")
+					return job
+				},
+				testingutil.BeInvalidError()),
 			ginkgo.Entry("Should succeed to update runtimePatches when suspend is true",
 				func() *trainer.TrainJob {
 					return testingutil.MakeTrainJobWrapper(ns.Name, "valid-runtimepatches").